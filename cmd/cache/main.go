@@ -2,15 +2,19 @@ package main
 
 import (
 	"context"
+	"encoding/json"
 	"errors"
 	"fmt"
 	"log"
+	"mime"
 	"net"
 	"net/http"
+	"strings"
 	"time"
 
 	"github.com/kelseyhightower/envconfig"
 	"github.com/prometheus/client_golang/prometheus/promhttp"
+	"go.opentelemetry.io/contrib/instrumentation/net/http/otelhttp"
 	"go.uber.org/zap"
 	"go.uber.org/zap/zapcore"
 	goahttp "goa.design/goa/v3/http"
@@ -28,9 +32,13 @@ import (
 	"github.com/eclipse-xfsc/redis-cache-service/internal/clients/event"
 	"github.com/eclipse-xfsc/redis-cache-service/internal/clients/redis"
 	"github.com/eclipse-xfsc/redis-cache-service/internal/config"
+	"github.com/eclipse-xfsc/redis-cache-service/internal/metrics"
 	"github.com/eclipse-xfsc/redis-cache-service/internal/service"
 	"github.com/eclipse-xfsc/redis-cache-service/internal/service/cache"
 	"github.com/eclipse-xfsc/redis-cache-service/internal/service/health"
+	"github.com/eclipse-xfsc/redis-cache-service/internal/tracing"
+	"github.com/eclipse-xfsc/redis-cache-service/internal/worker/expiry"
+	"github.com/eclipse-xfsc/redis-cache-service/internal/worker/outbox"
 )
 
 var Version = "0.0.0+development"
@@ -49,24 +57,94 @@ func main() {
 
 	logger.Info("start cache service", zap.String("version", Version), zap.String("goa", goa.Version()))
 
+	// configure tracing
+	shutdownTracing, err := tracing.New(context.Background(), cfg.Tracing.Exporter, cfg.Tracing.OTLPEndpoint, cfg.Tracing.OTLPInsecure, cfg.Tracing.SampleRatio, Version)
+	if err != nil {
+		log.Fatalf("failed to configure tracing: %v", err)
+	}
+	defer shutdownTracing(context.Background()) //nolint:errcheck
+
+	// limit namespace labels of the metrics to the configured namespaces
+	metrics.SetNamespaces(cfg.Metrics.Namespaces)
+
 	// create redis client
 	redis := redis.New(cfg.Redis.Addr, cfg.Redis.User, cfg.Redis.Pass, cfg.Redis.DB, cfg.Redis.TTL, cfg.Redis.Cluster)
 
 	// create event client
-	events, err := event.New(cfg.Nats.Addr, cfg.Nats.Subject)
+	payloadModes, err := event.ParsePayloadModes(cfg.Events.Payload)
+	if err != nil {
+		log.Fatalf("invalid events configuration: %v", err)
+	}
+	transport, err := event.ParseTransport(cfg.Events.Transport)
+	if err != nil {
+		log.Fatalf("invalid events configuration: %v", err)
+	}
+	eventOpts := []event.Option{event.WithPayloadModes(payloadModes)}
+	if cfg.Nats.JetStream {
+		eventOpts = append(eventOpts, event.WithJetStream(event.JetStreamConfig{
+			Stream:       cfg.Nats.Stream,
+			CreateStream: cfg.Nats.CreateStream,
+			AckTimeout:   cfg.Nats.AckTimeout,
+		}))
+	}
+	var events *event.Client
+	switch transport {
+	case event.TransportNATS:
+		events, err = event.NewNATS(cfg.Nats.Addr, cfg.Nats.Subject, eventOpts...)
+	case event.TransportHTTP:
+		events, err = event.NewHTTP(event.HTTPConfig{
+			URL:     cfg.Webhook.URL,
+			Timeout: cfg.Webhook.Timeout,
+			Headers: cfg.Webhook.Headers,
+		}, eventOpts...)
+	case event.TransportKafka:
+		events, err = event.NewKafka(cfg.Kafka.Brokers, cfg.Kafka.Topic, eventOpts...)
+	case event.TransportNone:
+		events = event.NewNone(eventOpts...)
+	}
 	if err != nil {
 		log.Fatalf("failed to create events client: %v", err)
 	}
 	defer events.CLose(context.Background())
 
+	keyFormat, err := cache.ParseKeyFormat(cfg.Cache.KeyFormat)
+	if err != nil {
+		log.Fatalf("invalid cache configuration: %v", err)
+	}
+
+	var ttlPolicies cache.TTLPolicies
+	if cfg.Cache.TTLPolicyFile != "" {
+		ttlPolicies, err = cache.LoadTTLPolicies(cfg.Cache.TTLPolicyFile)
+		if err != nil {
+			log.Fatalf("failed to load ttl policies: %v", err)
+		}
+	}
+
 	// create services
 	var (
 		cacheSvc  goacache.Service
 		healthSvc goahealth.Service
 	)
 	{
-		cacheSvc = cache.New(redis, events, logger)
-		healthSvc = health.New(Version)
+		cacheSvc = cache.New(redis, events, logger,
+			cache.WithKeyFormat(keyFormat, cfg.Cache.LegacyKeyFallback),
+			cache.WithSlidingTTL(cfg.Cache.SlidingTTL),
+			cache.WithTTLPolicies(ttlPolicies),
+		)
+		checkers := map[string]health.Checker{
+			"redis": health.CheckerFunc(redis.Ping),
+		}
+		// events are published from the outbox once the broker is back,
+		// so the broker may be reported without failing readiness
+		optional := map[string]health.Checker{}
+		if transport != event.TransportNone {
+			if cfg.Events.BrokerOptional {
+				optional[string(transport)] = health.CheckerFunc(events.Ping)
+			} else {
+				checkers[string(transport)] = health.CheckerFunc(events.Ping)
+			}
+		}
+		healthSvc = health.New(Version, checkers, logger, health.WithOptionalCheckers(optional))
 	}
 
 	// create endpoints
@@ -81,12 +159,16 @@ func main() {
 		openapiEndpoints = openapi.NewEndpoints(nil)
 	}
 
+	// record request metrics of the cache service methods
+	cacheEndpoints.Use(metrics.Endpoint)
+	cacheEndpoints.Use(tracing.Endpoint)
+
 	// Provide the transport specific request decoder and response encoder.
 	// The goa http package has built-in support for JSON, XML and gob.
 	// Other encodings can be used by providing the corresponding functions,
 	// see goa.design/implement/encoding.
 	var (
-		dec = goahttp.RequestDecoder
+		dec = requestDecoder
 		enc = goahttp.ResponseEncoder
 	)
 
@@ -101,7 +183,9 @@ func main() {
 	)
 	{
 		cacheServer = goacachesrv.New(cacheEndpoints, mux, dec, enc, nil, errFormatter)
-		healthServer = goahealthsrv.New(healthEndpoints, mux, dec, enc, nil, errFormatter)
+		// the health server uses the default goa formatter, so that
+		// not ready responses are encoded with the dependency checks
+		healthServer = goahealthsrv.New(healthEndpoints, mux, dec, enc, nil, nil)
 		openapiServer = goaopenapisrv.New(openapiEndpoints, mux, dec, enc, nil, errFormatter, nil, nil)
 	}
 
@@ -123,6 +207,7 @@ func main() {
 	go exposeMetrics(cfg.Metrics.Addr, logger)
 
 	var handler http.Handler = mux
+	handler = otelhttp.NewHandler(handler, "cache")
 	srv := &http.Server{
 		Addr:         cfg.HTTP.Host + ":" + cfg.HTTP.Port,
 		Handler:      handler,
@@ -139,6 +224,33 @@ func main() {
 		}
 		return errors.New("server stopped successfully")
 	})
+	if transport == event.TransportNone {
+		logger.Warn("events are disabled, external input is rejected")
+	} else {
+		// publish the events of external input from the outbox in the background
+		relay := outbox.New(redis, events, outbox.Config{
+			Interval:      cfg.Events.OutboxInterval,
+			SweepInterval: cfg.Events.OutboxSweepInterval,
+			MaxBackoff:    cfg.Events.OutboxMaxBackoff,
+		}, logger)
+		g.Go(func() error {
+			if err := relay.Run(ctx); err != nil {
+				logger.Error("outbox relay stopped", zap.Error(err))
+			}
+			return nil
+		})
+	}
+	if cfg.Cache.ExpiredEvents && transport != event.TransportNone {
+		// publish events for expired entries in the background,
+		// the server keeps running if the subscription fails
+		worker := expiry.New(redis, events, logger)
+		g.Go(func() error {
+			if err := worker.Run(ctx); err != nil {
+				logger.Error("expiry worker stopped", zap.Error(err))
+			}
+			return nil
+		})
+	}
 	if err := g.Wait(); err != nil {
 		logger.Error("run group stopped", zap.Error(err))
 	}
@@ -163,6 +275,16 @@ func createLogger(logLevel string, opts ...zap.Option) (*zap.Logger, error) {
 	return config.Build(opts...)
 }
 
+// requestDecoder extends the goa request decoder with JSON based
+// media types such as application/merge-patch+json.
+func requestDecoder(r *http.Request) goahttp.Decoder {
+	mediaType, _, err := mime.ParseMediaType(r.Header.Get("Content-Type"))
+	if err == nil && strings.HasSuffix(mediaType, "+json") {
+		return json.NewDecoder(r.Body)
+	}
+	return goahttp.RequestDecoder(r)
+}
+
 func errFormatter(ctx context.Context, e error) goahttp.Statuser {
 	return service.NewErrorResponse(ctx, e)
 }
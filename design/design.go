@@ -30,9 +30,11 @@ var _ = Service("health", func() {
 	Method("Readiness", func() {
 		Payload(Empty)
 		Result(HealthResponse)
+		Error("not_ready", HealthResponse, "Service dependencies are not available.")
 		HTTP(func() {
 			GET("/readiness")
 			Response(StatusOK)
+			Response("not_ready", StatusServiceUnavailable)
 		})
 	})
 })
@@ -44,7 +46,8 @@ var _ = Service("cache", func() {
 		Description("Get JSON value from the cache.")
 
 		Payload(CacheGetRequest)
-		Result(Any)
+		Result(CacheGetResult)
+		Error("not_modified", CacheNotModified, "Cache entry has not been modified.")
 
 		HTTP(func() {
 			GET("/v1/cache")
@@ -62,11 +65,35 @@ var _ = Service("cache", func() {
 			Header("strategy:x-cache-flatten-strategy", String, "Flatten strategy.", func() {
 				Example("first key value only", "first")
 				Example("last key value only", "last")
+				Example("recursive merge in scope order", "deep")
+				Example("values by scope", "scoped")
 				Example("default", "merge")
 			})
+			Header("arrays:x-cache-array-merge", String, "Merge of arrays by the deep strategy: concat (default) or dedupe", func() {
+				Example("dedupe")
+			})
+			Header("scopePriority:x-cache-scope-priority", String, "Scopes which take precedence over the order of x-cache-scope, highest first", func() {
+				Example("user")
+			})
+			Header("ifNoneMatch:If-None-Match", String, "Only return the value if its ETag does not match", func() {
+				Example(`"9f86d081884c7d659a2feaa0c55ad015a3bf4f1b"`)
+			})
+			Header("touch:x-cache-touch", Int, "Reset the TTL of the returned entries to the given number of seconds (sliding expiration)", func() {
+				Example(1800)
+			})
+			Header("fields:x-cache-fields", String, "Only return the selected fields: a comma separated list of dotted field paths or a JSONPath expression", func() {
+				Example("dotted fields", "name,address.city")
+				Example("JSONPath", "$.items[*].id")
+			})
 
 			Response(StatusOK, func() {
 				ContentType("application/json")
+				Header("etag:ETag")
+				Body("data")
+			})
+			Response("not_modified", StatusNotModified, func() {
+				Header("etag:ETag")
+				Body(Empty)
 			})
 		})
 	})
@@ -92,6 +119,15 @@ var _ = Service("cache", func() {
 			Header("ttl:x-cache-ttl", Int, "Cache entry TTL in seconds", func() {
 				Example(60)
 			})
+			Header("condition:x-cache-condition", String, "Only set the entry if it does not exist (nx) or if it already exists (xx)", func() {
+				Example("nx")
+			})
+			Header("ifMatch:If-Match", String, "Only set the entry if its current ETag matches, otherwise 409 Conflict is returned", func() {
+				Example(`"9f86d081884c7d659a2feaa0c55ad015a3bf4f1b"`)
+			})
+			Header("tags:x-cache-tags", String, "Comma separated tags by which the entry can be invalidated", func() {
+				Example("issuer:did:web:foo,schema:v2")
+			})
 			Body("data")
 
 			Response(StatusCreated)
@@ -119,11 +155,193 @@ var _ = Service("cache", func() {
 			Header("ttl:x-cache-ttl", Int, "Cache entry TTL in seconds", func() {
 				Example(60)
 			})
+			Header("condition:x-cache-condition", String, "Only set the entry if it does not exist (nx) or if it already exists (xx)", func() {
+				Example("nx")
+			})
+			Header("ifMatch:If-Match", String, "Only set the entry if its current ETag matches, otherwise 409 Conflict is returned", func() {
+				Example(`"9f86d081884c7d659a2feaa0c55ad015a3bf4f1b"`)
+			})
+			Header("tags:x-cache-tags", String, "Comma separated tags by which the entry can be invalidated", func() {
+				Example("issuer:did:web:foo,schema:v2")
+			})
 			Body("data")
 
 			Response(StatusOK)
 		})
 	})
+
+	Method("Patch", func() {
+		Description("Update a JSON value in the cache with a JSON merge patch (RFC 7386) or a JSON patch (RFC 6902). The TTL of the entry is kept.")
+
+		Payload(CachePatchRequest)
+		Result(CacheGetResult)
+
+		HTTP(func() {
+			PATCH("/v1/cache")
+
+			Header("key:x-cache-key", String, "Cache entry key", func() {
+				Example("did:web:example.com")
+			})
+			Header("namespace:x-cache-namespace", String, "Cache entry namespace", func() {
+				Example("Login")
+			})
+			Header("scope:x-cache-scope", String, "Cache entry scope", func() {
+				Example("administration")
+			})
+			Header("contentType:Content-Type", String, "Patch format: application/merge-patch+json or application/json-patch+json", func() {
+				Example("application/merge-patch+json")
+			})
+			Body("patch")
+
+			Response(StatusOK, func() {
+				ContentType("application/json")
+				Header("etag:ETag")
+				Body("data")
+			})
+		})
+	})
+
+	Method("Delete", func() {
+		Description("Delete a value from the cache.")
+
+		Payload(CacheDeleteRequest)
+		Result(Empty)
+
+		HTTP(func() {
+			DELETE("/v1/cache")
+
+			Header("key:x-cache-key", String, "Cache entry key", func() {
+				Example("did:web:example.com")
+			})
+			Header("namespace:x-cache-namespace", String, "Cache entry namespace", func() {
+				Example("Login")
+			})
+			Header("scope:x-cache-scope", String, "Cache entry scope", func() {
+				Example("administration")
+			})
+
+			Response(StatusOK)
+		})
+	})
+
+	Method("Meta", func() {
+		Description("Get metadata of a cache entry without its value.")
+
+		Payload(CacheMetaRequest)
+		Result(CacheMetaResponse)
+
+		HTTP(func() {
+			GET("/v1/cache/meta")
+
+			Header("key:x-cache-key", String, "Cache entry key", func() {
+				Example("did:web:example.com")
+			})
+			Header("namespace:x-cache-namespace", String, "Cache entry namespace", func() {
+				Example("Login")
+			})
+			Header("scope:x-cache-scope", String, "Cache entry scope", func() {
+				Example("administration")
+			})
+
+			Response(StatusOK)
+		})
+	})
+
+	Method("Keys", func() {
+		Description("List the keys stored under a namespace. Keys are iterated with SCAN and returned page by page.")
+
+		Payload(CacheKeysRequest)
+		Result(CacheKeysResult)
+
+		HTTP(func() {
+			GET("/v1/cache/keys")
+
+			Param("namespace", String, "Namespace of the listed entries", func() {
+				Example("Login")
+			})
+			Param("scope", String, "Only list entries of this scope", func() {
+				Example("administration")
+			})
+			Param("prefix", String, "Only list entries whose key starts with the prefix", func() {
+				Example("did:web:")
+			})
+			Param("cursor", String, "Cursor returned by the previous page")
+			Param("limit", Int, "Number of keys per page, a hint: a page ends with the SCAN call which reaches it, so it may contain more or fewer keys", func() {
+				Example(100)
+			})
+
+			Response(StatusOK)
+		})
+	})
+
+	Method("DeleteNamespace", func() {
+		Description("Delete all entries of a namespace, or of a namespace and scope, in a background job.")
+
+		Payload(CacheDeleteNamespaceRequest)
+		Result(CacheJob)
+
+		HTTP(func() {
+			DELETE("/v1/cache/namespaces/{namespace}")
+
+			Param("scope", String, "Only delete entries of this scope", func() {
+				Example("administration")
+			})
+
+			Response(StatusAccepted)
+		})
+	})
+
+	Method("Job", func() {
+		Description("Get the status of a background job.")
+
+		Payload(CacheJobRequest)
+		Result(CacheJob)
+
+		HTTP(func() {
+			GET("/v1/cache/jobs/{id}")
+
+			Response(StatusOK)
+		})
+	})
+
+	Method("DeleteTag", func() {
+		Description("Delete all entries which have been set with the tag.")
+
+		Payload(CacheDeleteTagRequest)
+		Result(CacheDeleteTagResult)
+
+		HTTP(func() {
+			DELETE("/v1/cache/tags/{tag}")
+
+			Response(StatusOK)
+		})
+	})
+
+	Method("BatchGet", func() {
+		Description("Get multiple JSON values from the cache. Each item is looked up separately and has its own status.")
+
+		Payload(CacheBatchGetRequest)
+		Result(ArrayOf(CacheBatchGetResult))
+
+		HTTP(func() {
+			POST("/v1/cache/batch/get")
+
+			Response(StatusOK)
+		})
+	})
+
+	Method("BatchSet", func() {
+		Description("Set multiple JSON values in the cache. Each item is stored separately and has its own status.")
+
+		Payload(CacheBatchSetRequest)
+		Result(ArrayOf(CacheBatchSetResult))
+
+		HTTP(func() {
+			POST("/v1/cache/batch/set")
+
+			Response(StatusOK)
+		})
+	})
 })
 
 var _ = Service("openapi", func() {
@@ -7,22 +7,210 @@ var CacheGetRequest = Type("CacheGetRequest", func() {
 	Field(1, "key", String)
 	Field(2, "namespace", String)
 	Field(3, "scope", String)
-	Field(4, "strategy", String)
+	Field(4, "strategy", String, func() {
+		Enum("merge", "first", "last", "deep", "scoped")
+	})
+	Field(5, "ifNoneMatch", String)
+	Field(6, "touch", Int, func() {
+		Minimum(1)
+	})
+	Field(7, "arrays", String, func() {
+		Enum("concat", "dedupe")
+	})
+	Field(8, "scopePriority", String)
+	Field(9, "fields", String)
 	Required("key")
 })
 
+var CacheGetResult = Type("CacheGetResult", func() {
+	Field(1, "data", Any, "Cached JSON value.")
+	Field(2, "etag", String, "Entity tag of the cached value.")
+	Required("data")
+})
+
+var CacheNotModified = Type("CacheNotModified", func() {
+	Field(1, "etag", String, "Entity tag of the cached value.")
+	Required("etag")
+})
+
 var CacheSetRequest = Type("CacheSetRequest", func() {
 	Field(1, "data", Any)
 	Field(2, "key", String)
 	Field(3, "namespace", String)
 	Field(4, "scope", String) // Initial implementation with a single scope
-	Field(5, "ttl", Int)
+	Field(5, "ttl", Int, func() {
+		Minimum(0)
+	})
+	Field(6, "condition", String, func() {
+		Enum("nx", "xx")
+	})
+	Field(7, "ifMatch", String)
+	Field(8, "tags", String)
 	Required("data", "key")
 })
 
+var CachePatchRequest = Type("CachePatchRequest", func() {
+	Field(1, "patch", Any)
+	Field(2, "key", String)
+	Field(3, "namespace", String)
+	Field(4, "scope", String)
+	Field(5, "contentType", String)
+	Required("patch", "key")
+})
+
+var CacheDeleteRequest = Type("CacheDeleteRequest", func() {
+	Field(1, "key", String)
+	Field(2, "namespace", String)
+	Field(3, "scope", String)
+	Required("key")
+})
+
+var CacheMetaRequest = Type("CacheMetaRequest", func() {
+	Field(1, "key", String)
+	Field(2, "namespace", String)
+	Field(3, "scope", String)
+	Required("key")
+})
+
+var CacheMetaResponse = Type("CacheMetaResponse", func() {
+	Field(1, "exists", Boolean, "Whether the entry exists in the cache.")
+	Field(2, "key", String, "Storage key of the entry in Redis.")
+	Field(3, "ttl", Int64, "Remaining time to live in seconds, not set if the entry does not expire.")
+	Field(4, "size", Int64, "Size of the stored value in bytes.")
+	Required("exists", "key")
+})
+
+var CacheKeysRequest = Type("CacheKeysRequest", func() {
+	Field(1, "namespace", String, func() {
+		MinLength(1)
+	})
+	Field(2, "scope", String)
+	Field(3, "prefix", String)
+	Field(4, "cursor", String)
+	Field(5, "limit", Int, func() {
+		Minimum(1)
+		Maximum(1000)
+		Default(100)
+	})
+	Required("namespace")
+})
+
+var CacheKeysItem = Type("CacheKeysItem", func() {
+	Field(1, "key", String, "Cache entry key.")
+	Field(2, "scope", String, "Cache entry scope.")
+	Required("key")
+})
+
+var CacheKeysResult = Type("CacheKeysResult", func() {
+	Field(1, "keys", ArrayOf(CacheKeysItem), "Entries of the page.")
+	Field(2, "cursor", String, "Opaque cursor of the next page, not set if the listing is complete.")
+	Required("keys")
+})
+
+var CacheDeleteNamespaceRequest = Type("CacheDeleteNamespaceRequest", func() {
+	Field(1, "namespace", String, "Namespace of the deleted entries.", func() {
+		Example("Login")
+	})
+	Field(2, "scope", String)
+	Required("namespace")
+})
+
+var CacheJobRequest = Type("CacheJobRequest", func() {
+	Field(1, "id", String, "Job ID.")
+	Required("id")
+})
+
+var CacheJob = Type("CacheJob", func() {
+	Field(1, "id", String, "Job ID.")
+	Field(2, "namespace", String, "Namespace of the deleted entries.")
+	Field(3, "scope", String, "Scope of the deleted entries.")
+	Field(4, "status", String, "Job status.", func() {
+		Enum("running", "completed", "failed")
+	})
+	Field(5, "deleted", Int64, "Number of deleted entries.")
+	Field(6, "error", String, "Error message if the job has failed.")
+	Field(7, "startedAt", String, "Start time of the job.", func() {
+		Format(FormatDateTime)
+	})
+	Field(8, "finishedAt", String, "End time of the job.", func() {
+		Format(FormatDateTime)
+	})
+	Required("id", "namespace", "status", "deleted", "startedAt")
+})
+
+var CacheDeleteTagRequest = Type("CacheDeleteTagRequest", func() {
+	Field(1, "tag", String, "Tag of the deleted entries.", func() {
+		Example("schema:v2")
+	})
+	Required("tag")
+})
+
+var CacheDeleteTagResult = Type("CacheDeleteTagResult", func() {
+	Field(1, "deleted", Int64, "Number of deleted entries.")
+	Required("deleted")
+})
+
+var CacheBatchGetItem = Type("CacheBatchGetItem", func() {
+	Field(1, "key", String, "Cache entry key.")
+	Field(2, "namespace", String, "Cache entry namespace.")
+	Field(3, "scope", String, "Cache entry scope.")
+	Required("key")
+})
+
+var CacheBatchGetRequest = Type("CacheBatchGetRequest", func() {
+	Field(1, "items", ArrayOf(CacheBatchGetItem), "Cache entries to get.", func() {
+		MinLength(1)
+		MaxLength(100)
+	})
+	Required("items")
+})
+
+var CacheBatchGetResult = Type("CacheBatchGetResult", func() {
+	Field(1, "key", String, "Cache entry key.")
+	Field(2, "namespace", String, "Cache entry namespace.")
+	Field(3, "scope", String, "Cache entry scope.")
+	Field(4, "status", Int, "HTTP status code of the item.", func() {
+		Example(200)
+	})
+	Field(5, "data", Any, "Cached JSON value.")
+	Field(6, "error", String, "Error message if the value could not be retrieved.")
+	Required("key", "status")
+})
+
+var CacheBatchSetItem = Type("CacheBatchSetItem", func() {
+	Field(1, "key", String, "Cache entry key.")
+	Field(2, "namespace", String, "Cache entry namespace.")
+	Field(3, "scope", String, "Cache entry scope.")
+	Field(4, "data", Any, "JSON value to store.")
+	Field(5, "ttl", Int, "Cache entry TTL in seconds.", func() {
+		Minimum(0)
+	})
+	Required("key", "data")
+})
+
+var CacheBatchSetRequest = Type("CacheBatchSetRequest", func() {
+	Field(1, "items", ArrayOf(CacheBatchSetItem), "Cache entries to set.", func() {
+		MinLength(1)
+		MaxLength(100)
+	})
+	Required("items")
+})
+
+var CacheBatchSetResult = Type("CacheBatchSetResult", func() {
+	Field(1, "key", String, "Cache entry key.")
+	Field(2, "namespace", String, "Cache entry namespace.")
+	Field(3, "scope", String, "Cache entry scope.")
+	Field(4, "status", Int, "HTTP status code of the item.", func() {
+		Example(201)
+	})
+	Field(5, "error", String, "Error message if the value could not be stored.")
+	Required("key", "status")
+})
+
 var HealthResponse = Type("HealthResponse", func() {
 	Field(1, "service", String, "Service name.")
 	Field(2, "status", String, "Status message.")
 	Field(3, "version", String, "Service runtime version.")
+	Field(4, "checks", MapOf(String, String), "Status of the service dependencies.")
 	Required("service", "status", "version")
 })
@@ -15,28 +15,49 @@ import (
 
 // Client is the "cache" service client.
 type Client struct {
-	GetEndpoint         goa.Endpoint
-	SetEndpoint         goa.Endpoint
-	SetExternalEndpoint goa.Endpoint
+	GetEndpoint             goa.Endpoint
+	SetEndpoint             goa.Endpoint
+	SetExternalEndpoint     goa.Endpoint
+	PatchEndpoint           goa.Endpoint
+	DeleteEndpoint          goa.Endpoint
+	MetaEndpoint            goa.Endpoint
+	KeysEndpoint            goa.Endpoint
+	DeleteNamespaceEndpoint goa.Endpoint
+	JobEndpoint             goa.Endpoint
+	DeleteTagEndpoint       goa.Endpoint
+	BatchGetEndpoint        goa.Endpoint
+	BatchSetEndpoint        goa.Endpoint
 }
 
 // NewClient initializes a "cache" service client given the endpoints.
-func NewClient(get, set, setExternal goa.Endpoint) *Client {
+func NewClient(get, set, setExternal, patch, delete_, meta, keys, deleteNamespace, job, deleteTag, batchGet, batchSet goa.Endpoint) *Client {
 	return &Client{
-		GetEndpoint:         get,
-		SetEndpoint:         set,
-		SetExternalEndpoint: setExternal,
+		GetEndpoint:             get,
+		SetEndpoint:             set,
+		SetExternalEndpoint:     setExternal,
+		PatchEndpoint:           patch,
+		DeleteEndpoint:          delete_,
+		MetaEndpoint:            meta,
+		KeysEndpoint:            keys,
+		DeleteNamespaceEndpoint: deleteNamespace,
+		JobEndpoint:             job,
+		DeleteTagEndpoint:       deleteTag,
+		BatchGetEndpoint:        batchGet,
+		BatchSetEndpoint:        batchSet,
 	}
 }
 
 // Get calls the "Get" endpoint of the "cache" service.
-func (c *Client) Get(ctx context.Context, p *CacheGetRequest) (res any, err error) {
+// Get may return the following errors:
+//   - "not_modified" (type *CacheNotModified): Cache entry has not been modified.
+//   - error: internal error
+func (c *Client) Get(ctx context.Context, p *CacheGetRequest) (res *CacheGetResult, err error) {
 	var ires any
 	ires, err = c.GetEndpoint(ctx, p)
 	if err != nil {
 		return
 	}
-	return ires.(any), nil
+	return ires.(*CacheGetResult), nil
 }
 
 // Set calls the "Set" endpoint of the "cache" service.
@@ -50,3 +71,89 @@ func (c *Client) SetExternal(ctx context.Context, p *CacheSetRequest) (err error
 	_, err = c.SetExternalEndpoint(ctx, p)
 	return
 }
+
+// Patch calls the "Patch" endpoint of the "cache" service.
+func (c *Client) Patch(ctx context.Context, p *CachePatchRequest) (res *CacheGetResult, err error) {
+	var ires any
+	ires, err = c.PatchEndpoint(ctx, p)
+	if err != nil {
+		return
+	}
+	return ires.(*CacheGetResult), nil
+}
+
+// Delete calls the "Delete" endpoint of the "cache" service.
+func (c *Client) Delete(ctx context.Context, p *CacheDeleteRequest) (err error) {
+	_, err = c.DeleteEndpoint(ctx, p)
+	return
+}
+
+// Meta calls the "Meta" endpoint of the "cache" service.
+func (c *Client) Meta(ctx context.Context, p *CacheMetaRequest) (res *CacheMetaResponse, err error) {
+	var ires any
+	ires, err = c.MetaEndpoint(ctx, p)
+	if err != nil {
+		return
+	}
+	return ires.(*CacheMetaResponse), nil
+}
+
+// Keys calls the "Keys" endpoint of the "cache" service.
+func (c *Client) Keys(ctx context.Context, p *CacheKeysRequest) (res *CacheKeysResult, err error) {
+	var ires any
+	ires, err = c.KeysEndpoint(ctx, p)
+	if err != nil {
+		return
+	}
+	return ires.(*CacheKeysResult), nil
+}
+
+// DeleteNamespace calls the "DeleteNamespace" endpoint of the "cache" service.
+func (c *Client) DeleteNamespace(ctx context.Context, p *CacheDeleteNamespaceRequest) (res *CacheJob, err error) {
+	var ires any
+	ires, err = c.DeleteNamespaceEndpoint(ctx, p)
+	if err != nil {
+		return
+	}
+	return ires.(*CacheJob), nil
+}
+
+// Job calls the "Job" endpoint of the "cache" service.
+func (c *Client) Job(ctx context.Context, p *CacheJobRequest) (res *CacheJob, err error) {
+	var ires any
+	ires, err = c.JobEndpoint(ctx, p)
+	if err != nil {
+		return
+	}
+	return ires.(*CacheJob), nil
+}
+
+// DeleteTag calls the "DeleteTag" endpoint of the "cache" service.
+func (c *Client) DeleteTag(ctx context.Context, p *CacheDeleteTagRequest) (res *CacheDeleteTagResult, err error) {
+	var ires any
+	ires, err = c.DeleteTagEndpoint(ctx, p)
+	if err != nil {
+		return
+	}
+	return ires.(*CacheDeleteTagResult), nil
+}
+
+// BatchGet calls the "BatchGet" endpoint of the "cache" service.
+func (c *Client) BatchGet(ctx context.Context, p *CacheBatchGetRequest) (res []*CacheBatchGetResult, err error) {
+	var ires any
+	ires, err = c.BatchGetEndpoint(ctx, p)
+	if err != nil {
+		return
+	}
+	return ires.([]*CacheBatchGetResult), nil
+}
+
+// BatchSet calls the "BatchSet" endpoint of the "cache" service.
+func (c *Client) BatchSet(ctx context.Context, p *CacheBatchSetRequest) (res []*CacheBatchSetResult, err error) {
+	var ires any
+	ires, err = c.BatchSetEndpoint(ctx, p)
+	if err != nil {
+		return
+	}
+	return ires.([]*CacheBatchSetResult), nil
+}
@@ -15,17 +15,35 @@ import (
 
 // Endpoints wraps the "cache" service endpoints.
 type Endpoints struct {
-	Get         goa.Endpoint
-	Set         goa.Endpoint
-	SetExternal goa.Endpoint
+	Get             goa.Endpoint
+	Set             goa.Endpoint
+	SetExternal     goa.Endpoint
+	Patch           goa.Endpoint
+	Delete          goa.Endpoint
+	Meta            goa.Endpoint
+	Keys            goa.Endpoint
+	DeleteNamespace goa.Endpoint
+	Job             goa.Endpoint
+	DeleteTag       goa.Endpoint
+	BatchGet        goa.Endpoint
+	BatchSet        goa.Endpoint
 }
 
 // NewEndpoints wraps the methods of the "cache" service with endpoints.
 func NewEndpoints(s Service) *Endpoints {
 	return &Endpoints{
-		Get:         NewGetEndpoint(s),
-		Set:         NewSetEndpoint(s),
-		SetExternal: NewSetExternalEndpoint(s),
+		Get:             NewGetEndpoint(s),
+		Set:             NewSetEndpoint(s),
+		SetExternal:     NewSetExternalEndpoint(s),
+		Patch:           NewPatchEndpoint(s),
+		Delete:          NewDeleteEndpoint(s),
+		Meta:            NewMetaEndpoint(s),
+		Keys:            NewKeysEndpoint(s),
+		DeleteNamespace: NewDeleteNamespaceEndpoint(s),
+		Job:             NewJobEndpoint(s),
+		DeleteTag:       NewDeleteTagEndpoint(s),
+		BatchGet:        NewBatchGetEndpoint(s),
+		BatchSet:        NewBatchSetEndpoint(s),
 	}
 }
 
@@ -34,6 +52,15 @@ func (e *Endpoints) Use(m func(goa.Endpoint) goa.Endpoint) {
 	e.Get = m(e.Get)
 	e.Set = m(e.Set)
 	e.SetExternal = m(e.SetExternal)
+	e.Patch = m(e.Patch)
+	e.Delete = m(e.Delete)
+	e.Meta = m(e.Meta)
+	e.Keys = m(e.Keys)
+	e.DeleteNamespace = m(e.DeleteNamespace)
+	e.Job = m(e.Job)
+	e.DeleteTag = m(e.DeleteTag)
+	e.BatchGet = m(e.BatchGet)
+	e.BatchSet = m(e.BatchSet)
 }
 
 // NewGetEndpoint returns an endpoint function that calls the method "Get" of
@@ -62,3 +89,84 @@ func NewSetExternalEndpoint(s Service) goa.Endpoint {
 		return nil, s.SetExternal(ctx, p)
 	}
 }
+
+// NewPatchEndpoint returns an endpoint function that calls the method "Patch"
+// of service "cache".
+func NewPatchEndpoint(s Service) goa.Endpoint {
+	return func(ctx context.Context, req any) (any, error) {
+		p := req.(*CachePatchRequest)
+		return s.Patch(ctx, p)
+	}
+}
+
+// NewDeleteEndpoint returns an endpoint function that calls the method
+// "Delete" of service "cache".
+func NewDeleteEndpoint(s Service) goa.Endpoint {
+	return func(ctx context.Context, req any) (any, error) {
+		p := req.(*CacheDeleteRequest)
+		return nil, s.Delete(ctx, p)
+	}
+}
+
+// NewMetaEndpoint returns an endpoint function that calls the method "Meta" of
+// service "cache".
+func NewMetaEndpoint(s Service) goa.Endpoint {
+	return func(ctx context.Context, req any) (any, error) {
+		p := req.(*CacheMetaRequest)
+		return s.Meta(ctx, p)
+	}
+}
+
+// NewKeysEndpoint returns an endpoint function that calls the method "Keys" of
+// service "cache".
+func NewKeysEndpoint(s Service) goa.Endpoint {
+	return func(ctx context.Context, req any) (any, error) {
+		p := req.(*CacheKeysRequest)
+		return s.Keys(ctx, p)
+	}
+}
+
+// NewDeleteNamespaceEndpoint returns an endpoint function that calls the
+// method "DeleteNamespace" of service "cache".
+func NewDeleteNamespaceEndpoint(s Service) goa.Endpoint {
+	return func(ctx context.Context, req any) (any, error) {
+		p := req.(*CacheDeleteNamespaceRequest)
+		return s.DeleteNamespace(ctx, p)
+	}
+}
+
+// NewJobEndpoint returns an endpoint function that calls the method "Job" of
+// service "cache".
+func NewJobEndpoint(s Service) goa.Endpoint {
+	return func(ctx context.Context, req any) (any, error) {
+		p := req.(*CacheJobRequest)
+		return s.Job(ctx, p)
+	}
+}
+
+// NewDeleteTagEndpoint returns an endpoint function that calls the method
+// "DeleteTag" of service "cache".
+func NewDeleteTagEndpoint(s Service) goa.Endpoint {
+	return func(ctx context.Context, req any) (any, error) {
+		p := req.(*CacheDeleteTagRequest)
+		return s.DeleteTag(ctx, p)
+	}
+}
+
+// NewBatchGetEndpoint returns an endpoint function that calls the method
+// "BatchGet" of service "cache".
+func NewBatchGetEndpoint(s Service) goa.Endpoint {
+	return func(ctx context.Context, req any) (any, error) {
+		p := req.(*CacheBatchGetRequest)
+		return s.BatchGet(ctx, p)
+	}
+}
+
+// NewBatchSetEndpoint returns an endpoint function that calls the method
+// "BatchSet" of service "cache".
+func NewBatchSetEndpoint(s Service) goa.Endpoint {
+	return func(ctx context.Context, req any) (any, error) {
+		p := req.(*CacheBatchSetRequest)
+		return s.BatchSet(ctx, p)
+	}
+}
@@ -14,11 +14,34 @@ import (
 // Cache service allows storing and retrieving data from distributed cache.
 type Service interface {
 	// Get JSON value from the cache.
-	Get(context.Context, *CacheGetRequest) (res any, err error)
+	Get(context.Context, *CacheGetRequest) (res *CacheGetResult, err error)
 	// Set a JSON value in the cache.
 	Set(context.Context, *CacheSetRequest) (err error)
 	// Set an external JSON value in the cache and provide an event for the input.
 	SetExternal(context.Context, *CacheSetRequest) (err error)
+	// Update a JSON value in the cache with a JSON merge patch (RFC 7386) or a
+	// JSON patch (RFC 6902). The TTL of the entry is kept.
+	Patch(context.Context, *CachePatchRequest) (res *CacheGetResult, err error)
+	// Delete a value from the cache.
+	Delete(context.Context, *CacheDeleteRequest) (err error)
+	// Get metadata of a cache entry without its value.
+	Meta(context.Context, *CacheMetaRequest) (res *CacheMetaResponse, err error)
+	// List the keys stored under a namespace. Keys are iterated with SCAN and
+	// returned page by page.
+	Keys(context.Context, *CacheKeysRequest) (res *CacheKeysResult, err error)
+	// Delete all entries of a namespace, or of a namespace and scope, in a
+	// background job.
+	DeleteNamespace(context.Context, *CacheDeleteNamespaceRequest) (res *CacheJob, err error)
+	// Get the status of a background job.
+	Job(context.Context, *CacheJobRequest) (res *CacheJob, err error)
+	// Delete all entries which have been set with the tag.
+	DeleteTag(context.Context, *CacheDeleteTagRequest) (res *CacheDeleteTagResult, err error)
+	// Get multiple JSON values from the cache. Each item is looked up separately
+	// and has its own status.
+	BatchGet(context.Context, *CacheBatchGetRequest) (res []*CacheBatchGetResult, err error)
+	// Set multiple JSON values in the cache. Each item is stored separately and
+	// has its own status.
+	BatchSet(context.Context, *CacheBatchSetRequest) (res []*CacheBatchSetResult, err error)
 }
 
 // APIName is the name of the API as defined in the design.
@@ -35,14 +58,203 @@ const ServiceName = "cache"
 // MethodNames lists the service method names as defined in the design. These
 // are the same values that are set in the endpoint request contexts under the
 // MethodKey key.
-var MethodNames = [3]string{"Get", "Set", "SetExternal"}
+var MethodNames = [12]string{"Get", "Set", "SetExternal", "Patch", "Delete", "Meta", "Keys", "DeleteNamespace", "Job", "DeleteTag", "BatchGet", "BatchSet"}
+
+type CacheBatchGetItem struct {
+	// Cache entry key.
+	Key string
+	// Cache entry namespace.
+	Namespace *string
+	// Cache entry scope.
+	Scope *string
+}
+
+// CacheBatchGetRequest is the payload type of the cache service BatchGet
+// method.
+type CacheBatchGetRequest struct {
+	// Cache entries to get.
+	Items []*CacheBatchGetItem
+}
+
+type CacheBatchGetResult struct {
+	// Cache entry key.
+	Key string
+	// Cache entry namespace.
+	Namespace *string
+	// Cache entry scope.
+	Scope *string
+	// HTTP status code of the item.
+	Status int
+	// Cached JSON value.
+	Data any
+	// Error message if the value could not be retrieved.
+	Error *string
+}
+
+type CacheBatchSetItem struct {
+	// Cache entry key.
+	Key string
+	// Cache entry namespace.
+	Namespace *string
+	// Cache entry scope.
+	Scope *string
+	// JSON value to store.
+	Data any
+	// Cache entry TTL in seconds.
+	TTL *int
+}
+
+// CacheBatchSetRequest is the payload type of the cache service BatchSet
+// method.
+type CacheBatchSetRequest struct {
+	// Cache entries to set.
+	Items []*CacheBatchSetItem
+}
+
+type CacheBatchSetResult struct {
+	// Cache entry key.
+	Key string
+	// Cache entry namespace.
+	Namespace *string
+	// Cache entry scope.
+	Scope *string
+	// HTTP status code of the item.
+	Status int
+	// Error message if the value could not be stored.
+	Error *string
+}
+
+// CacheDeleteNamespaceRequest is the payload type of the cache service
+// DeleteNamespace method.
+type CacheDeleteNamespaceRequest struct {
+	// Namespace of the deleted entries.
+	Namespace string
+	Scope     *string
+}
+
+// CacheDeleteRequest is the payload type of the cache service Delete method.
+type CacheDeleteRequest struct {
+	Key       string
+	Namespace *string
+	Scope     *string
+}
+
+// CacheDeleteTagRequest is the payload type of the cache service DeleteTag
+// method.
+type CacheDeleteTagRequest struct {
+	// Tag of the deleted entries.
+	Tag string
+}
+
+// CacheDeleteTagResult is the result type of the cache service DeleteTag
+// method.
+type CacheDeleteTagResult struct {
+	// Number of deleted entries.
+	Deleted int64
+}
 
 // CacheGetRequest is the payload type of the cache service Get method.
 type CacheGetRequest struct {
+	Key           string
+	Namespace     *string
+	Scope         *string
+	Strategy      *string
+	IfNoneMatch   *string
+	Touch         *int
+	Arrays        *string
+	ScopePriority *string
+	Fields        *string
+}
+
+// CacheGetResult is the result type of the cache service Get method.
+type CacheGetResult struct {
+	// Cached JSON value.
+	Data any
+	// Entity tag of the cached value.
+	Etag *string
+}
+
+// CacheJob is the result type of the cache service DeleteNamespace method.
+type CacheJob struct {
+	// Job ID.
+	ID string
+	// Namespace of the deleted entries.
+	Namespace string
+	// Scope of the deleted entries.
+	Scope *string
+	// Job status.
+	Status string
+	// Number of deleted entries.
+	Deleted int64
+	// Error message if the job has failed.
+	Error *string
+	// Start time of the job.
+	StartedAt string
+	// End time of the job.
+	FinishedAt *string
+}
+
+// CacheJobRequest is the payload type of the cache service Job method.
+type CacheJobRequest struct {
+	// Job ID.
+	ID string
+}
+
+type CacheKeysItem struct {
+	// Cache entry key.
+	Key string
+	// Cache entry scope.
+	Scope *string
+}
+
+// CacheKeysRequest is the payload type of the cache service Keys method.
+type CacheKeysRequest struct {
+	Namespace string
+	Scope     *string
+	Prefix    *string
+	Cursor    *string
+	Limit     int
+}
+
+// CacheKeysResult is the result type of the cache service Keys method.
+type CacheKeysResult struct {
+	// Entries of the page.
+	Keys []*CacheKeysItem
+	// Opaque cursor of the next page, not set if the listing is complete.
+	Cursor *string
+}
+
+// CacheMetaRequest is the payload type of the cache service Meta method.
+type CacheMetaRequest struct {
 	Key       string
 	Namespace *string
 	Scope     *string
-	Strategy  *string
+}
+
+// CacheMetaResponse is the result type of the cache service Meta method.
+type CacheMetaResponse struct {
+	// Whether the entry exists in the cache.
+	Exists bool
+	// Storage key of the entry in Redis.
+	Key string
+	// Remaining time to live in seconds, not set if the entry does not expire.
+	TTL *int64
+	// Size of the stored value in bytes.
+	Size *int64
+}
+
+type CacheNotModified struct {
+	// Entity tag of the cached value.
+	Etag string
+}
+
+// CachePatchRequest is the payload type of the cache service Patch method.
+type CachePatchRequest struct {
+	Patch       any
+	Key         string
+	Namespace   *string
+	Scope       *string
+	ContentType *string
 }
 
 // CacheSetRequest is the payload type of the cache service Set method.
@@ -52,4 +264,24 @@ type CacheSetRequest struct {
 	Namespace *string
 	Scope     *string
 	TTL       *int
+	Condition *string
+	IfMatch   *string
+	Tags      *string
+}
+
+// Error returns an error description.
+func (e *CacheNotModified) Error() string {
+	return ""
+}
+
+// ErrorName returns "CacheNotModified".
+//
+// Deprecated: Use GoaErrorName - https://github.com/goadesign/goa/issues/3105
+func (e *CacheNotModified) ErrorName() string {
+	return e.GoaErrorName()
+}
+
+// GoaErrorName returns "CacheNotModified".
+func (e *CacheNotModified) GoaErrorName() string {
+	return "not_modified"
 }
@@ -38,6 +38,9 @@ func (c *Client) Liveness(ctx context.Context) (res *HealthResponse, err error)
 }
 
 // Readiness calls the "Readiness" endpoint of the "health" service.
+// Readiness may return the following errors:
+//   - "not_ready" (type *HealthResponse): Service dependencies are not available.
+//   - error: internal error
 func (c *Client) Readiness(ctx context.Context) (res *HealthResponse, err error) {
 	var ires any
 	ires, err = c.ReadinessEndpoint(ctx, nil)
@@ -43,4 +43,23 @@ type HealthResponse struct {
 	Status string
 	// Service runtime version.
 	Version string
+	// Status of the service dependencies.
+	Checks map[string]string
+}
+
+// Error returns an error description.
+func (e *HealthResponse) Error() string {
+	return ""
+}
+
+// ErrorName returns "HealthResponse".
+//
+// Deprecated: Use GoaErrorName - https://github.com/goadesign/goa/issues/3105
+func (e *HealthResponse) ErrorName() string {
+	return e.GoaErrorName()
+}
+
+// GoaErrorName returns "HealthResponse".
+func (e *HealthResponse) GoaErrorName() string {
+	return "not_ready"
 }
@@ -11,12 +11,15 @@ import (
 	"encoding/json"
 	"fmt"
 	"strconv"
+	"unicode/utf8"
 
 	cache "github.com/eclipse-xfsc/redis-cache-service/gen/cache"
+	goa "goa.design/goa/v3/pkg"
 )
 
 // BuildGetPayload builds the payload for the cache Get endpoint from CLI flags.
-func BuildGetPayload(cacheGetKey string, cacheGetNamespace string, cacheGetScope string, cacheGetStrategy string) (*cache.CacheGetRequest, error) {
+func BuildGetPayload(cacheGetKey string, cacheGetNamespace string, cacheGetScope string, cacheGetStrategy string, cacheGetArrays string, cacheGetScopePriority string, cacheGetIfNoneMatch string, cacheGetTouch string, cacheGetFields string) (*cache.CacheGetRequest, error) {
+	var err error
 	var key string
 	{
 		key = cacheGetKey
@@ -37,6 +40,60 @@ func BuildGetPayload(cacheGetKey string, cacheGetNamespace string, cacheGetScope
 	{
 		if cacheGetStrategy != "" {
 			strategy = &cacheGetStrategy
+			if !(*strategy == "merge" || *strategy == "first" || *strategy == "last" || *strategy == "deep" || *strategy == "scoped") {
+				err = goa.MergeErrors(err, goa.InvalidEnumValueError("strategy", *strategy, []any{"merge", "first", "last", "deep", "scoped"}))
+			}
+			if err != nil {
+				return nil, err
+			}
+		}
+	}
+	var arrays *string
+	{
+		if cacheGetArrays != "" {
+			arrays = &cacheGetArrays
+			if !(*arrays == "concat" || *arrays == "dedupe") {
+				err = goa.MergeErrors(err, goa.InvalidEnumValueError("arrays", *arrays, []any{"concat", "dedupe"}))
+			}
+			if err != nil {
+				return nil, err
+			}
+		}
+	}
+	var scopePriority *string
+	{
+		if cacheGetScopePriority != "" {
+			scopePriority = &cacheGetScopePriority
+		}
+	}
+	var ifNoneMatch *string
+	{
+		if cacheGetIfNoneMatch != "" {
+			ifNoneMatch = &cacheGetIfNoneMatch
+		}
+	}
+	var touch *int
+	{
+		if cacheGetTouch != "" {
+			var v int64
+			v, err = strconv.ParseInt(cacheGetTouch, 10, strconv.IntSize)
+			val := int(v)
+			touch = &val
+			if err != nil {
+				return nil, fmt.Errorf("invalid value for touch, must be INT")
+			}
+			if *touch < 1 {
+				err = goa.MergeErrors(err, goa.InvalidRangeError("touch", *touch, 1, true))
+			}
+			if err != nil {
+				return nil, err
+			}
+		}
+	}
+	var fields *string
+	{
+		if cacheGetFields != "" {
+			fields = &cacheGetFields
 		}
 	}
 	v := &cache.CacheGetRequest{}
@@ -44,18 +101,23 @@ func BuildGetPayload(cacheGetKey string, cacheGetNamespace string, cacheGetScope
 	v.Namespace = namespace
 	v.Scope = scope
 	v.Strategy = strategy
+	v.Arrays = arrays
+	v.ScopePriority = scopePriority
+	v.IfNoneMatch = ifNoneMatch
+	v.Touch = touch
+	v.Fields = fields
 
 	return v, nil
 }
 
 // BuildSetPayload builds the payload for the cache Set endpoint from CLI flags.
-func BuildSetPayload(cacheSetBody string, cacheSetKey string, cacheSetNamespace string, cacheSetScope string, cacheSetTTL string) (*cache.CacheSetRequest, error) {
+func BuildSetPayload(cacheSetBody string, cacheSetKey string, cacheSetNamespace string, cacheSetScope string, cacheSetTTL string, cacheSetCondition string, cacheSetIfMatch string, cacheSetTags string) (*cache.CacheSetRequest, error) {
 	var err error
 	var body any
 	{
 		err = json.Unmarshal([]byte(cacheSetBody), &body)
 		if err != nil {
-			return nil, fmt.Errorf("invalid JSON for body, \nerror: %s, \nexample of valid JSON:\n%s", err, "\"Enim vel.\"")
+			return nil, fmt.Errorf("invalid JSON for body, \nerror: %s, \nexample of valid JSON:\n%s", err, "\"Illo necessitatibus placeat molestiae.\"")
 		}
 	}
 	var key string
@@ -84,6 +146,36 @@ func BuildSetPayload(cacheSetBody string, cacheSetKey string, cacheSetNamespace
 			if err != nil {
 				return nil, fmt.Errorf("invalid value for ttl, must be INT")
 			}
+			if *ttl < 0 {
+				err = goa.MergeErrors(err, goa.InvalidRangeError("ttl", *ttl, 0, true))
+			}
+			if err != nil {
+				return nil, err
+			}
+		}
+	}
+	var condition *string
+	{
+		if cacheSetCondition != "" {
+			condition = &cacheSetCondition
+			if !(*condition == "nx" || *condition == "xx") {
+				err = goa.MergeErrors(err, goa.InvalidEnumValueError("condition", *condition, []any{"nx", "xx"}))
+			}
+			if err != nil {
+				return nil, err
+			}
+		}
+	}
+	var ifMatch *string
+	{
+		if cacheSetIfMatch != "" {
+			ifMatch = &cacheSetIfMatch
+		}
+	}
+	var tags *string
+	{
+		if cacheSetTags != "" {
+			tags = &cacheSetTags
 		}
 	}
 	v := body
@@ -94,19 +186,22 @@ func BuildSetPayload(cacheSetBody string, cacheSetKey string, cacheSetNamespace
 	res.Namespace = namespace
 	res.Scope = scope
 	res.TTL = ttl
+	res.Condition = condition
+	res.IfMatch = ifMatch
+	res.Tags = tags
 
 	return res, nil
 }
 
 // BuildSetExternalPayload builds the payload for the cache SetExternal
 // endpoint from CLI flags.
-func BuildSetExternalPayload(cacheSetExternalBody string, cacheSetExternalKey string, cacheSetExternalNamespace string, cacheSetExternalScope string, cacheSetExternalTTL string) (*cache.CacheSetRequest, error) {
+func BuildSetExternalPayload(cacheSetExternalBody string, cacheSetExternalKey string, cacheSetExternalNamespace string, cacheSetExternalScope string, cacheSetExternalTTL string, cacheSetExternalCondition string, cacheSetExternalIfMatch string, cacheSetExternalTags string) (*cache.CacheSetRequest, error) {
 	var err error
 	var body any
 	{
 		err = json.Unmarshal([]byte(cacheSetExternalBody), &body)
 		if err != nil {
-			return nil, fmt.Errorf("invalid JSON for body, \nerror: %s, \nexample of valid JSON:\n%s", err, "\"Sint ipsa fugiat et id rem.\"")
+			return nil, fmt.Errorf("invalid JSON for body, \nerror: %s, \nexample of valid JSON:\n%s", err, "\"Commodi sit aliquam fugit voluptatem omnis.\"")
 		}
 	}
 	var key string
@@ -135,6 +230,36 @@ func BuildSetExternalPayload(cacheSetExternalBody string, cacheSetExternalKey st
 			if err != nil {
 				return nil, fmt.Errorf("invalid value for ttl, must be INT")
 			}
+			if *ttl < 0 {
+				err = goa.MergeErrors(err, goa.InvalidRangeError("ttl", *ttl, 0, true))
+			}
+			if err != nil {
+				return nil, err
+			}
+		}
+	}
+	var condition *string
+	{
+		if cacheSetExternalCondition != "" {
+			condition = &cacheSetExternalCondition
+			if !(*condition == "nx" || *condition == "xx") {
+				err = goa.MergeErrors(err, goa.InvalidEnumValueError("condition", *condition, []any{"nx", "xx"}))
+			}
+			if err != nil {
+				return nil, err
+			}
+		}
+	}
+	var ifMatch *string
+	{
+		if cacheSetExternalIfMatch != "" {
+			ifMatch = &cacheSetExternalIfMatch
+		}
+	}
+	var tags *string
+	{
+		if cacheSetExternalTags != "" {
+			tags = &cacheSetExternalTags
 		}
 	}
 	v := body
@@ -145,6 +270,294 @@ func BuildSetExternalPayload(cacheSetExternalBody string, cacheSetExternalKey st
 	res.Namespace = namespace
 	res.Scope = scope
 	res.TTL = ttl
+	res.Condition = condition
+	res.IfMatch = ifMatch
+	res.Tags = tags
+
+	return res, nil
+}
+
+// BuildPatchPayload builds the payload for the cache Patch endpoint from CLI
+// flags.
+func BuildPatchPayload(cachePatchBody string, cachePatchKey string, cachePatchNamespace string, cachePatchScope string, cachePatchContentType string) (*cache.CachePatchRequest, error) {
+	var err error
+	var body any
+	{
+		err = json.Unmarshal([]byte(cachePatchBody), &body)
+		if err != nil {
+			return nil, fmt.Errorf("invalid JSON for body, \nerror: %s, \nexample of valid JSON:\n%s", err, "\"Et veniam enim doloribus facere.\"")
+		}
+	}
+	var key string
+	{
+		key = cachePatchKey
+	}
+	var namespace *string
+	{
+		if cachePatchNamespace != "" {
+			namespace = &cachePatchNamespace
+		}
+	}
+	var scope *string
+	{
+		if cachePatchScope != "" {
+			scope = &cachePatchScope
+		}
+	}
+	var contentType *string
+	{
+		if cachePatchContentType != "" {
+			contentType = &cachePatchContentType
+		}
+	}
+	v := body
+	res := &cache.CachePatchRequest{
+		Patch: v,
+	}
+	res.Key = key
+	res.Namespace = namespace
+	res.Scope = scope
+	res.ContentType = contentType
 
 	return res, nil
 }
+
+// BuildDeletePayload builds the payload for the cache Delete endpoint from CLI
+// flags.
+func BuildDeletePayload(cacheDeleteKey string, cacheDeleteNamespace string, cacheDeleteScope string) (*cache.CacheDeleteRequest, error) {
+	var key string
+	{
+		key = cacheDeleteKey
+	}
+	var namespace *string
+	{
+		if cacheDeleteNamespace != "" {
+			namespace = &cacheDeleteNamespace
+		}
+	}
+	var scope *string
+	{
+		if cacheDeleteScope != "" {
+			scope = &cacheDeleteScope
+		}
+	}
+	v := &cache.CacheDeleteRequest{}
+	v.Key = key
+	v.Namespace = namespace
+	v.Scope = scope
+
+	return v, nil
+}
+
+// BuildMetaPayload builds the payload for the cache Meta endpoint from CLI
+// flags.
+func BuildMetaPayload(cacheMetaKey string, cacheMetaNamespace string, cacheMetaScope string) (*cache.CacheMetaRequest, error) {
+	var key string
+	{
+		key = cacheMetaKey
+	}
+	var namespace *string
+	{
+		if cacheMetaNamespace != "" {
+			namespace = &cacheMetaNamespace
+		}
+	}
+	var scope *string
+	{
+		if cacheMetaScope != "" {
+			scope = &cacheMetaScope
+		}
+	}
+	v := &cache.CacheMetaRequest{}
+	v.Key = key
+	v.Namespace = namespace
+	v.Scope = scope
+
+	return v, nil
+}
+
+// BuildKeysPayload builds the payload for the cache Keys endpoint from CLI
+// flags.
+func BuildKeysPayload(cacheKeysNamespace string, cacheKeysScope string, cacheKeysPrefix string, cacheKeysCursor string, cacheKeysLimit string) (*cache.CacheKeysRequest, error) {
+	var err error
+	var namespace string
+	{
+		namespace = cacheKeysNamespace
+		if utf8.RuneCountInString(namespace) < 1 {
+			err = goa.MergeErrors(err, goa.InvalidLengthError("namespace", namespace, utf8.RuneCountInString(namespace), 1, true))
+		}
+		if err != nil {
+			return nil, err
+		}
+	}
+	var scope *string
+	{
+		if cacheKeysScope != "" {
+			scope = &cacheKeysScope
+		}
+	}
+	var prefix *string
+	{
+		if cacheKeysPrefix != "" {
+			prefix = &cacheKeysPrefix
+		}
+	}
+	var cursor *string
+	{
+		if cacheKeysCursor != "" {
+			cursor = &cacheKeysCursor
+		}
+	}
+	var limit int
+	{
+		if cacheKeysLimit != "" {
+			var v int64
+			v, err = strconv.ParseInt(cacheKeysLimit, 10, strconv.IntSize)
+			limit = int(v)
+			if err != nil {
+				return nil, fmt.Errorf("invalid value for limit, must be INT")
+			}
+			if limit < 1 {
+				err = goa.MergeErrors(err, goa.InvalidRangeError("limit", limit, 1, true))
+			}
+			if limit > 1000 {
+				err = goa.MergeErrors(err, goa.InvalidRangeError("limit", limit, 1000, false))
+			}
+			if err != nil {
+				return nil, err
+			}
+		}
+	}
+	v := &cache.CacheKeysRequest{}
+	v.Namespace = namespace
+	v.Scope = scope
+	v.Prefix = prefix
+	v.Cursor = cursor
+	v.Limit = limit
+
+	return v, nil
+}
+
+// BuildDeleteNamespacePayload builds the payload for the cache DeleteNamespace
+// endpoint from CLI flags.
+func BuildDeleteNamespacePayload(cacheDeleteNamespaceNamespace string, cacheDeleteNamespaceScope string) (*cache.CacheDeleteNamespaceRequest, error) {
+	var namespace string
+	{
+		namespace = cacheDeleteNamespaceNamespace
+	}
+	var scope *string
+	{
+		if cacheDeleteNamespaceScope != "" {
+			scope = &cacheDeleteNamespaceScope
+		}
+	}
+	v := &cache.CacheDeleteNamespaceRequest{}
+	v.Namespace = namespace
+	v.Scope = scope
+
+	return v, nil
+}
+
+// BuildJobPayload builds the payload for the cache Job endpoint from CLI flags.
+func BuildJobPayload(cacheJobID string) (*cache.CacheJobRequest, error) {
+	var id string
+	{
+		id = cacheJobID
+	}
+	v := &cache.CacheJobRequest{}
+	v.ID = id
+
+	return v, nil
+}
+
+// BuildDeleteTagPayload builds the payload for the cache DeleteTag endpoint
+// from CLI flags.
+func BuildDeleteTagPayload(cacheDeleteTagTag string) (*cache.CacheDeleteTagRequest, error) {
+	var tag string
+	{
+		tag = cacheDeleteTagTag
+	}
+	v := &cache.CacheDeleteTagRequest{}
+	v.Tag = tag
+
+	return v, nil
+}
+
+// BuildBatchGetPayload builds the payload for the cache BatchGet endpoint from
+// CLI flags.
+func BuildBatchGetPayload(cacheBatchGetBody string) (*cache.CacheBatchGetRequest, error) {
+	var err error
+	var body BatchGetRequestBody
+	{
+		err = json.Unmarshal([]byte(cacheBatchGetBody), &body)
+		if err != nil {
+			return nil, fmt.Errorf("invalid JSON for body, \nerror: %s, \nexample of valid JSON:\n%s", err, "'{\n      \"items\": [\n         {\n            \"key\": \"Nobis praesentium.\",\n            \"namespace\": \"Fuga beatae molestiae voluptates facere aspernatur impedit.\",\n            \"scope\": \"Eius id earum repellat aliquam quod.\"\n         },\n         {\n            \"key\": \"Nobis praesentium.\",\n            \"namespace\": \"Fuga beatae molestiae voluptates facere aspernatur impedit.\",\n            \"scope\": \"Eius id earum repellat aliquam quod.\"\n         },\n         {\n            \"key\": \"Nobis praesentium.\",\n            \"namespace\": \"Fuga beatae molestiae voluptates facere aspernatur impedit.\",\n            \"scope\": \"Eius id earum repellat aliquam quod.\"\n         }\n      ]\n   }'")
+		}
+		if body.Items == nil {
+			err = goa.MergeErrors(err, goa.MissingFieldError("items", "body"))
+		}
+		if len(body.Items) < 1 {
+			err = goa.MergeErrors(err, goa.InvalidLengthError("body.items", body.Items, len(body.Items), 1, true))
+		}
+		if len(body.Items) > 100 {
+			err = goa.MergeErrors(err, goa.InvalidLengthError("body.items", body.Items, len(body.Items), 100, false))
+		}
+		if err != nil {
+			return nil, err
+		}
+	}
+	v := &cache.CacheBatchGetRequest{}
+	if body.Items != nil {
+		v.Items = make([]*cache.CacheBatchGetItem, len(body.Items))
+		for i, val := range body.Items {
+			v.Items[i] = marshalCacheBatchGetItemRequestBodyToCacheCacheBatchGetItem(val)
+		}
+	} else {
+		v.Items = []*cache.CacheBatchGetItem{}
+	}
+
+	return v, nil
+}
+
+// BuildBatchSetPayload builds the payload for the cache BatchSet endpoint from
+// CLI flags.
+func BuildBatchSetPayload(cacheBatchSetBody string) (*cache.CacheBatchSetRequest, error) {
+	var err error
+	var body BatchSetRequestBody
+	{
+		err = json.Unmarshal([]byte(cacheBatchSetBody), &body)
+		if err != nil {
+			return nil, fmt.Errorf("invalid JSON for body, \nerror: %s, \nexample of valid JSON:\n%s", err, "'{\n      \"items\": [\n         {\n            \"data\": \"Dolorem rerum pariatur omnis.\",\n            \"key\": \"Praesentium est maiores.\",\n            \"namespace\": \"Consectetur nulla quia reprehenderit dolores enim recusandae.\",\n            \"scope\": \"Deserunt nostrum molestiae.\",\n            \"ttl\": 423180062880815134\n         },\n         {\n            \"data\": \"Dolorem rerum pariatur omnis.\",\n            \"key\": \"Praesentium est maiores.\",\n            \"namespace\": \"Consectetur nulla quia reprehenderit dolores enim recusandae.\",\n            \"scope\": \"Deserunt nostrum molestiae.\",\n            \"ttl\": 423180062880815134\n         }\n      ]\n   }'")
+		}
+		if body.Items == nil {
+			err = goa.MergeErrors(err, goa.MissingFieldError("items", "body"))
+		}
+		if len(body.Items) < 1 {
+			err = goa.MergeErrors(err, goa.InvalidLengthError("body.items", body.Items, len(body.Items), 1, true))
+		}
+		if len(body.Items) > 100 {
+			err = goa.MergeErrors(err, goa.InvalidLengthError("body.items", body.Items, len(body.Items), 100, false))
+		}
+		for _, e := range body.Items {
+			if e != nil {
+				if err2 := ValidateCacheBatchSetItemRequestBody(e); err2 != nil {
+					err = goa.MergeErrors(err, err2)
+				}
+			}
+		}
+		if err != nil {
+			return nil, err
+		}
+	}
+	v := &cache.CacheBatchSetRequest{}
+	if body.Items != nil {
+		v.Items = make([]*cache.CacheBatchSetItem, len(body.Items))
+		for i, val := range body.Items {
+			v.Items[i] = marshalCacheBatchSetItemRequestBodyToCacheCacheBatchSetItem(val)
+		}
+	} else {
+		v.Items = []*cache.CacheBatchSetItem{}
+	}
+
+	return v, nil
+}
@@ -27,6 +27,37 @@ type Client struct {
 	// endpoint.
 	SetExternalDoer goahttp.Doer
 
+	// Patch Doer is the HTTP client used to make requests to the Patch endpoint.
+	PatchDoer goahttp.Doer
+
+	// Delete Doer is the HTTP client used to make requests to the Delete endpoint.
+	DeleteDoer goahttp.Doer
+
+	// Meta Doer is the HTTP client used to make requests to the Meta endpoint.
+	MetaDoer goahttp.Doer
+
+	// Keys Doer is the HTTP client used to make requests to the Keys endpoint.
+	KeysDoer goahttp.Doer
+
+	// DeleteNamespace Doer is the HTTP client used to make requests to the
+	// DeleteNamespace endpoint.
+	DeleteNamespaceDoer goahttp.Doer
+
+	// Job Doer is the HTTP client used to make requests to the Job endpoint.
+	JobDoer goahttp.Doer
+
+	// DeleteTag Doer is the HTTP client used to make requests to the DeleteTag
+	// endpoint.
+	DeleteTagDoer goahttp.Doer
+
+	// BatchGet Doer is the HTTP client used to make requests to the BatchGet
+	// endpoint.
+	BatchGetDoer goahttp.Doer
+
+	// BatchSet Doer is the HTTP client used to make requests to the BatchSet
+	// endpoint.
+	BatchSetDoer goahttp.Doer
+
 	// RestoreResponseBody controls whether the response bodies are reset after
 	// decoding so they can be read again.
 	RestoreResponseBody bool
@@ -50,6 +81,15 @@ func NewClient(
 		GetDoer:             doer,
 		SetDoer:             doer,
 		SetExternalDoer:     doer,
+		PatchDoer:           doer,
+		DeleteDoer:          doer,
+		MetaDoer:            doer,
+		KeysDoer:            doer,
+		DeleteNamespaceDoer: doer,
+		JobDoer:             doer,
+		DeleteTagDoer:       doer,
+		BatchGetDoer:        doer,
+		BatchSetDoer:        doer,
 		RestoreResponseBody: restoreBody,
 		scheme:              scheme,
 		host:                host,
@@ -129,3 +169,209 @@ func (c *Client) SetExternal() goa.Endpoint {
 		return decodeResponse(resp)
 	}
 }
+
+// Patch returns an endpoint that makes HTTP requests to the cache service
+// Patch server.
+func (c *Client) Patch() goa.Endpoint {
+	var (
+		encodeRequest  = EncodePatchRequest(c.encoder)
+		decodeResponse = DecodePatchResponse(c.decoder, c.RestoreResponseBody)
+	)
+	return func(ctx context.Context, v any) (any, error) {
+		req, err := c.BuildPatchRequest(ctx, v)
+		if err != nil {
+			return nil, err
+		}
+		err = encodeRequest(req, v)
+		if err != nil {
+			return nil, err
+		}
+		resp, err := c.PatchDoer.Do(req)
+		if err != nil {
+			return nil, goahttp.ErrRequestError("cache", "Patch", err)
+		}
+		return decodeResponse(resp)
+	}
+}
+
+// Delete returns an endpoint that makes HTTP requests to the cache service
+// Delete server.
+func (c *Client) Delete() goa.Endpoint {
+	var (
+		encodeRequest  = EncodeDeleteRequest(c.encoder)
+		decodeResponse = DecodeDeleteResponse(c.decoder, c.RestoreResponseBody)
+	)
+	return func(ctx context.Context, v any) (any, error) {
+		req, err := c.BuildDeleteRequest(ctx, v)
+		if err != nil {
+			return nil, err
+		}
+		err = encodeRequest(req, v)
+		if err != nil {
+			return nil, err
+		}
+		resp, err := c.DeleteDoer.Do(req)
+		if err != nil {
+			return nil, goahttp.ErrRequestError("cache", "Delete", err)
+		}
+		return decodeResponse(resp)
+	}
+}
+
+// Meta returns an endpoint that makes HTTP requests to the cache service Meta
+// server.
+func (c *Client) Meta() goa.Endpoint {
+	var (
+		encodeRequest  = EncodeMetaRequest(c.encoder)
+		decodeResponse = DecodeMetaResponse(c.decoder, c.RestoreResponseBody)
+	)
+	return func(ctx context.Context, v any) (any, error) {
+		req, err := c.BuildMetaRequest(ctx, v)
+		if err != nil {
+			return nil, err
+		}
+		err = encodeRequest(req, v)
+		if err != nil {
+			return nil, err
+		}
+		resp, err := c.MetaDoer.Do(req)
+		if err != nil {
+			return nil, goahttp.ErrRequestError("cache", "Meta", err)
+		}
+		return decodeResponse(resp)
+	}
+}
+
+// Keys returns an endpoint that makes HTTP requests to the cache service Keys
+// server.
+func (c *Client) Keys() goa.Endpoint {
+	var (
+		encodeRequest  = EncodeKeysRequest(c.encoder)
+		decodeResponse = DecodeKeysResponse(c.decoder, c.RestoreResponseBody)
+	)
+	return func(ctx context.Context, v any) (any, error) {
+		req, err := c.BuildKeysRequest(ctx, v)
+		if err != nil {
+			return nil, err
+		}
+		err = encodeRequest(req, v)
+		if err != nil {
+			return nil, err
+		}
+		resp, err := c.KeysDoer.Do(req)
+		if err != nil {
+			return nil, goahttp.ErrRequestError("cache", "Keys", err)
+		}
+		return decodeResponse(resp)
+	}
+}
+
+// DeleteNamespace returns an endpoint that makes HTTP requests to the cache
+// service DeleteNamespace server.
+func (c *Client) DeleteNamespace() goa.Endpoint {
+	var (
+		encodeRequest  = EncodeDeleteNamespaceRequest(c.encoder)
+		decodeResponse = DecodeDeleteNamespaceResponse(c.decoder, c.RestoreResponseBody)
+	)
+	return func(ctx context.Context, v any) (any, error) {
+		req, err := c.BuildDeleteNamespaceRequest(ctx, v)
+		if err != nil {
+			return nil, err
+		}
+		err = encodeRequest(req, v)
+		if err != nil {
+			return nil, err
+		}
+		resp, err := c.DeleteNamespaceDoer.Do(req)
+		if err != nil {
+			return nil, goahttp.ErrRequestError("cache", "DeleteNamespace", err)
+		}
+		return decodeResponse(resp)
+	}
+}
+
+// Job returns an endpoint that makes HTTP requests to the cache service Job
+// server.
+func (c *Client) Job() goa.Endpoint {
+	var (
+		decodeResponse = DecodeJobResponse(c.decoder, c.RestoreResponseBody)
+	)
+	return func(ctx context.Context, v any) (any, error) {
+		req, err := c.BuildJobRequest(ctx, v)
+		if err != nil {
+			return nil, err
+		}
+		resp, err := c.JobDoer.Do(req)
+		if err != nil {
+			return nil, goahttp.ErrRequestError("cache", "Job", err)
+		}
+		return decodeResponse(resp)
+	}
+}
+
+// DeleteTag returns an endpoint that makes HTTP requests to the cache service
+// DeleteTag server.
+func (c *Client) DeleteTag() goa.Endpoint {
+	var (
+		decodeResponse = DecodeDeleteTagResponse(c.decoder, c.RestoreResponseBody)
+	)
+	return func(ctx context.Context, v any) (any, error) {
+		req, err := c.BuildDeleteTagRequest(ctx, v)
+		if err != nil {
+			return nil, err
+		}
+		resp, err := c.DeleteTagDoer.Do(req)
+		if err != nil {
+			return nil, goahttp.ErrRequestError("cache", "DeleteTag", err)
+		}
+		return decodeResponse(resp)
+	}
+}
+
+// BatchGet returns an endpoint that makes HTTP requests to the cache service
+// BatchGet server.
+func (c *Client) BatchGet() goa.Endpoint {
+	var (
+		encodeRequest  = EncodeBatchGetRequest(c.encoder)
+		decodeResponse = DecodeBatchGetResponse(c.decoder, c.RestoreResponseBody)
+	)
+	return func(ctx context.Context, v any) (any, error) {
+		req, err := c.BuildBatchGetRequest(ctx, v)
+		if err != nil {
+			return nil, err
+		}
+		err = encodeRequest(req, v)
+		if err != nil {
+			return nil, err
+		}
+		resp, err := c.BatchGetDoer.Do(req)
+		if err != nil {
+			return nil, goahttp.ErrRequestError("cache", "BatchGet", err)
+		}
+		return decodeResponse(resp)
+	}
+}
+
+// BatchSet returns an endpoint that makes HTTP requests to the cache service
+// BatchSet server.
+func (c *Client) BatchSet() goa.Endpoint {
+	var (
+		encodeRequest  = EncodeBatchSetRequest(c.encoder)
+		decodeResponse = DecodeBatchSetResponse(c.decoder, c.RestoreResponseBody)
+	)
+	return func(ctx context.Context, v any) (any, error) {
+		req, err := c.BuildBatchSetRequest(ctx, v)
+		if err != nil {
+			return nil, err
+		}
+		err = encodeRequest(req, v)
+		if err != nil {
+			return nil, err
+		}
+		resp, err := c.BatchSetDoer.Do(req)
+		if err != nil {
+			return nil, goahttp.ErrRequestError("cache", "BatchSet", err)
+		}
+		return decodeResponse(resp)
+	}
+}
@@ -10,6 +10,7 @@ package client
 import (
 	"bytes"
 	"context"
+	"fmt"
 	"io"
 	"net/http"
 	"net/url"
@@ -17,6 +18,7 @@ import (
 
 	cache "github.com/eclipse-xfsc/redis-cache-service/gen/cache"
 	goahttp "goa.design/goa/v3/http"
+	goa "goa.design/goa/v3/pkg"
 )
 
 // BuildGetRequest instantiates a HTTP request object with method and path set
@@ -58,6 +60,27 @@ func EncodeGetRequest(encoder func(*http.Request) goahttp.Encoder) func(*http.Re
 			head := *p.Strategy
 			req.Header.Set("x-cache-flatten-strategy", head)
 		}
+		if p.Arrays != nil {
+			head := *p.Arrays
+			req.Header.Set("x-cache-array-merge", head)
+		}
+		if p.ScopePriority != nil {
+			head := *p.ScopePriority
+			req.Header.Set("x-cache-scope-priority", head)
+		}
+		if p.IfNoneMatch != nil {
+			head := *p.IfNoneMatch
+			req.Header.Set("If-None-Match", head)
+		}
+		if p.Touch != nil {
+			head := *p.Touch
+			headStr := strconv.Itoa(head)
+			req.Header.Set("x-cache-touch", headStr)
+		}
+		if p.Fields != nil {
+			head := *p.Fields
+			req.Header.Set("x-cache-fields", head)
+		}
 		return nil
 	}
 }
@@ -65,6 +88,9 @@ func EncodeGetRequest(encoder func(*http.Request) goahttp.Encoder) func(*http.Re
 // DecodeGetResponse returns a decoder for responses returned by the cache Get
 // endpoint. restoreBody controls whether the response body should be restored
 // after having been read.
+// DecodeGetResponse may return the following errors:
+//   - "not_modified" (type *cache.CacheNotModified): http.StatusNotModified
+//   - error: internal error
 func DecodeGetResponse(decoder func(*http.Response) goahttp.Decoder, restoreBody bool) func(*http.Response) (any, error) {
 	return func(resp *http.Response) (any, error) {
 		if restoreBody {
@@ -89,7 +115,29 @@ func DecodeGetResponse(decoder func(*http.Response) goahttp.Decoder, restoreBody
 			if err != nil {
 				return nil, goahttp.ErrDecodingError("cache", "Get", err)
 			}
-			return body, nil
+			var (
+				etag *string
+			)
+			etagRaw := resp.Header.Get("Etag")
+			if etagRaw != "" {
+				etag = &etagRaw
+			}
+			res := NewGetCacheGetResultOK(body, etag)
+			return res, nil
+		case http.StatusNotModified:
+			var (
+				etag string
+				err  error
+			)
+			etagRaw := resp.Header.Get("Etag")
+			if etagRaw == "" {
+				err = goa.MergeErrors(err, goa.MissingFieldError("etag", "header"))
+			}
+			etag = etagRaw
+			if err != nil {
+				return nil, goahttp.ErrValidationError("cache", "Get", err)
+			}
+			return nil, NewGetNotModified(etag)
 		default:
 			body, _ := io.ReadAll(resp.Body)
 			return nil, goahttp.ErrInvalidResponse("cache", "Get", resp.StatusCode, string(body))
@@ -137,6 +185,18 @@ func EncodeSetRequest(encoder func(*http.Request) goahttp.Encoder) func(*http.Re
 			headStr := strconv.Itoa(head)
 			req.Header.Set("x-cache-ttl", headStr)
 		}
+		if p.Condition != nil {
+			head := *p.Condition
+			req.Header.Set("x-cache-condition", head)
+		}
+		if p.IfMatch != nil {
+			head := *p.IfMatch
+			req.Header.Set("If-Match", head)
+		}
+		if p.Tags != nil {
+			head := *p.Tags
+			req.Header.Set("x-cache-tags", head)
+		}
 		body := p.Data
 		if err := encoder(req).Encode(&body); err != nil {
 			return goahttp.ErrEncodingError("cache", "Set", err)
@@ -212,6 +272,18 @@ func EncodeSetExternalRequest(encoder func(*http.Request) goahttp.Encoder) func(
 			headStr := strconv.Itoa(head)
 			req.Header.Set("x-cache-ttl", headStr)
 		}
+		if p.Condition != nil {
+			head := *p.Condition
+			req.Header.Set("x-cache-condition", head)
+		}
+		if p.IfMatch != nil {
+			head := *p.IfMatch
+			req.Header.Set("If-Match", head)
+		}
+		if p.Tags != nil {
+			head := *p.Tags
+			req.Header.Set("x-cache-tags", head)
+		}
 		body := p.Data
 		if err := encoder(req).Encode(&body); err != nil {
 			return goahttp.ErrEncodingError("cache", "SetExternal", err)
@@ -246,3 +318,782 @@ func DecodeSetExternalResponse(decoder func(*http.Response) goahttp.Decoder, res
 		}
 	}
 }
+
+// BuildPatchRequest instantiates a HTTP request object with method and path
+// set to call the "cache" service "Patch" endpoint
+func (c *Client) BuildPatchRequest(ctx context.Context, v any) (*http.Request, error) {
+	u := &url.URL{Scheme: c.scheme, Host: c.host, Path: PatchCachePath()}
+	req, err := http.NewRequest("PATCH", u.String(), nil)
+	if err != nil {
+		return nil, goahttp.ErrInvalidURL("cache", "Patch", u.String(), err)
+	}
+	if ctx != nil {
+		req = req.WithContext(ctx)
+	}
+
+	return req, nil
+}
+
+// EncodePatchRequest returns an encoder for requests sent to the cache Patch
+// server.
+func EncodePatchRequest(encoder func(*http.Request) goahttp.Encoder) func(*http.Request, any) error {
+	return func(req *http.Request, v any) error {
+		p, ok := v.(*cache.CachePatchRequest)
+		if !ok {
+			return goahttp.ErrInvalidType("cache", "Patch", "*cache.CachePatchRequest", v)
+		}
+		{
+			head := p.Key
+			req.Header.Set("x-cache-key", head)
+		}
+		if p.Namespace != nil {
+			head := *p.Namespace
+			req.Header.Set("x-cache-namespace", head)
+		}
+		if p.Scope != nil {
+			head := *p.Scope
+			req.Header.Set("x-cache-scope", head)
+		}
+		if p.ContentType != nil {
+			head := *p.ContentType
+			req.Header.Set("Content-Type", head)
+		}
+		body := p.Patch
+		if err := encoder(req).Encode(&body); err != nil {
+			return goahttp.ErrEncodingError("cache", "Patch", err)
+		}
+		return nil
+	}
+}
+
+// DecodePatchResponse returns a decoder for responses returned by the cache
+// Patch endpoint. restoreBody controls whether the response body should be
+// restored after having been read.
+func DecodePatchResponse(decoder func(*http.Response) goahttp.Decoder, restoreBody bool) func(*http.Response) (any, error) {
+	return func(resp *http.Response) (any, error) {
+		if restoreBody {
+			b, err := io.ReadAll(resp.Body)
+			if err != nil {
+				return nil, err
+			}
+			resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			defer func() {
+				resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			}()
+		} else {
+			defer resp.Body.Close()
+		}
+		switch resp.StatusCode {
+		case http.StatusOK:
+			var (
+				body any
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("cache", "Patch", err)
+			}
+			var (
+				etag *string
+			)
+			etagRaw := resp.Header.Get("Etag")
+			if etagRaw != "" {
+				etag = &etagRaw
+			}
+			res := NewPatchCacheGetResultOK(body, etag)
+			return res, nil
+		default:
+			body, _ := io.ReadAll(resp.Body)
+			return nil, goahttp.ErrInvalidResponse("cache", "Patch", resp.StatusCode, string(body))
+		}
+	}
+}
+
+// BuildDeleteRequest instantiates a HTTP request object with method and path
+// set to call the "cache" service "Delete" endpoint
+func (c *Client) BuildDeleteRequest(ctx context.Context, v any) (*http.Request, error) {
+	u := &url.URL{Scheme: c.scheme, Host: c.host, Path: DeleteCachePath()}
+	req, err := http.NewRequest("DELETE", u.String(), nil)
+	if err != nil {
+		return nil, goahttp.ErrInvalidURL("cache", "Delete", u.String(), err)
+	}
+	if ctx != nil {
+		req = req.WithContext(ctx)
+	}
+
+	return req, nil
+}
+
+// EncodeDeleteRequest returns an encoder for requests sent to the cache Delete
+// server.
+func EncodeDeleteRequest(encoder func(*http.Request) goahttp.Encoder) func(*http.Request, any) error {
+	return func(req *http.Request, v any) error {
+		p, ok := v.(*cache.CacheDeleteRequest)
+		if !ok {
+			return goahttp.ErrInvalidType("cache", "Delete", "*cache.CacheDeleteRequest", v)
+		}
+		{
+			head := p.Key
+			req.Header.Set("x-cache-key", head)
+		}
+		if p.Namespace != nil {
+			head := *p.Namespace
+			req.Header.Set("x-cache-namespace", head)
+		}
+		if p.Scope != nil {
+			head := *p.Scope
+			req.Header.Set("x-cache-scope", head)
+		}
+		return nil
+	}
+}
+
+// DecodeDeleteResponse returns a decoder for responses returned by the cache
+// Delete endpoint. restoreBody controls whether the response body should be
+// restored after having been read.
+func DecodeDeleteResponse(decoder func(*http.Response) goahttp.Decoder, restoreBody bool) func(*http.Response) (any, error) {
+	return func(resp *http.Response) (any, error) {
+		if restoreBody {
+			b, err := io.ReadAll(resp.Body)
+			if err != nil {
+				return nil, err
+			}
+			resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			defer func() {
+				resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			}()
+		} else {
+			defer resp.Body.Close()
+		}
+		switch resp.StatusCode {
+		case http.StatusOK:
+			return nil, nil
+		default:
+			body, _ := io.ReadAll(resp.Body)
+			return nil, goahttp.ErrInvalidResponse("cache", "Delete", resp.StatusCode, string(body))
+		}
+	}
+}
+
+// BuildMetaRequest instantiates a HTTP request object with method and path set
+// to call the "cache" service "Meta" endpoint
+func (c *Client) BuildMetaRequest(ctx context.Context, v any) (*http.Request, error) {
+	u := &url.URL{Scheme: c.scheme, Host: c.host, Path: MetaCachePath()}
+	req, err := http.NewRequest("GET", u.String(), nil)
+	if err != nil {
+		return nil, goahttp.ErrInvalidURL("cache", "Meta", u.String(), err)
+	}
+	if ctx != nil {
+		req = req.WithContext(ctx)
+	}
+
+	return req, nil
+}
+
+// EncodeMetaRequest returns an encoder for requests sent to the cache Meta
+// server.
+func EncodeMetaRequest(encoder func(*http.Request) goahttp.Encoder) func(*http.Request, any) error {
+	return func(req *http.Request, v any) error {
+		p, ok := v.(*cache.CacheMetaRequest)
+		if !ok {
+			return goahttp.ErrInvalidType("cache", "Meta", "*cache.CacheMetaRequest", v)
+		}
+		{
+			head := p.Key
+			req.Header.Set("x-cache-key", head)
+		}
+		if p.Namespace != nil {
+			head := *p.Namespace
+			req.Header.Set("x-cache-namespace", head)
+		}
+		if p.Scope != nil {
+			head := *p.Scope
+			req.Header.Set("x-cache-scope", head)
+		}
+		return nil
+	}
+}
+
+// DecodeMetaResponse returns a decoder for responses returned by the cache
+// Meta endpoint. restoreBody controls whether the response body should be
+// restored after having been read.
+func DecodeMetaResponse(decoder func(*http.Response) goahttp.Decoder, restoreBody bool) func(*http.Response) (any, error) {
+	return func(resp *http.Response) (any, error) {
+		if restoreBody {
+			b, err := io.ReadAll(resp.Body)
+			if err != nil {
+				return nil, err
+			}
+			resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			defer func() {
+				resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			}()
+		} else {
+			defer resp.Body.Close()
+		}
+		switch resp.StatusCode {
+		case http.StatusOK:
+			var (
+				body MetaResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("cache", "Meta", err)
+			}
+			err = ValidateMetaResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("cache", "Meta", err)
+			}
+			res := NewMetaCacheMetaResponseOK(&body)
+			return res, nil
+		default:
+			body, _ := io.ReadAll(resp.Body)
+			return nil, goahttp.ErrInvalidResponse("cache", "Meta", resp.StatusCode, string(body))
+		}
+	}
+}
+
+// BuildKeysRequest instantiates a HTTP request object with method and path set
+// to call the "cache" service "Keys" endpoint
+func (c *Client) BuildKeysRequest(ctx context.Context, v any) (*http.Request, error) {
+	u := &url.URL{Scheme: c.scheme, Host: c.host, Path: KeysCachePath()}
+	req, err := http.NewRequest("GET", u.String(), nil)
+	if err != nil {
+		return nil, goahttp.ErrInvalidURL("cache", "Keys", u.String(), err)
+	}
+	if ctx != nil {
+		req = req.WithContext(ctx)
+	}
+
+	return req, nil
+}
+
+// EncodeKeysRequest returns an encoder for requests sent to the cache Keys
+// server.
+func EncodeKeysRequest(encoder func(*http.Request) goahttp.Encoder) func(*http.Request, any) error {
+	return func(req *http.Request, v any) error {
+		p, ok := v.(*cache.CacheKeysRequest)
+		if !ok {
+			return goahttp.ErrInvalidType("cache", "Keys", "*cache.CacheKeysRequest", v)
+		}
+		values := req.URL.Query()
+		values.Add("namespace", p.Namespace)
+		if p.Scope != nil {
+			values.Add("scope", *p.Scope)
+		}
+		if p.Prefix != nil {
+			values.Add("prefix", *p.Prefix)
+		}
+		if p.Cursor != nil {
+			values.Add("cursor", *p.Cursor)
+		}
+		values.Add("limit", fmt.Sprintf("%v", p.Limit))
+		req.URL.RawQuery = values.Encode()
+		return nil
+	}
+}
+
+// DecodeKeysResponse returns a decoder for responses returned by the cache
+// Keys endpoint. restoreBody controls whether the response body should be
+// restored after having been read.
+func DecodeKeysResponse(decoder func(*http.Response) goahttp.Decoder, restoreBody bool) func(*http.Response) (any, error) {
+	return func(resp *http.Response) (any, error) {
+		if restoreBody {
+			b, err := io.ReadAll(resp.Body)
+			if err != nil {
+				return nil, err
+			}
+			resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			defer func() {
+				resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			}()
+		} else {
+			defer resp.Body.Close()
+		}
+		switch resp.StatusCode {
+		case http.StatusOK:
+			var (
+				body KeysResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("cache", "Keys", err)
+			}
+			err = ValidateKeysResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("cache", "Keys", err)
+			}
+			res := NewKeysCacheKeysResultOK(&body)
+			return res, nil
+		default:
+			body, _ := io.ReadAll(resp.Body)
+			return nil, goahttp.ErrInvalidResponse("cache", "Keys", resp.StatusCode, string(body))
+		}
+	}
+}
+
+// BuildDeleteNamespaceRequest instantiates a HTTP request object with method
+// and path set to call the "cache" service "DeleteNamespace" endpoint
+func (c *Client) BuildDeleteNamespaceRequest(ctx context.Context, v any) (*http.Request, error) {
+	var (
+		namespace string
+	)
+	{
+		p, ok := v.(*cache.CacheDeleteNamespaceRequest)
+		if !ok {
+			return nil, goahttp.ErrInvalidType("cache", "DeleteNamespace", "*cache.CacheDeleteNamespaceRequest", v)
+		}
+		namespace = p.Namespace
+	}
+	u := &url.URL{Scheme: c.scheme, Host: c.host, Path: DeleteNamespaceCachePath(namespace)}
+	req, err := http.NewRequest("DELETE", u.String(), nil)
+	if err != nil {
+		return nil, goahttp.ErrInvalidURL("cache", "DeleteNamespace", u.String(), err)
+	}
+	if ctx != nil {
+		req = req.WithContext(ctx)
+	}
+
+	return req, nil
+}
+
+// EncodeDeleteNamespaceRequest returns an encoder for requests sent to the
+// cache DeleteNamespace server.
+func EncodeDeleteNamespaceRequest(encoder func(*http.Request) goahttp.Encoder) func(*http.Request, any) error {
+	return func(req *http.Request, v any) error {
+		p, ok := v.(*cache.CacheDeleteNamespaceRequest)
+		if !ok {
+			return goahttp.ErrInvalidType("cache", "DeleteNamespace", "*cache.CacheDeleteNamespaceRequest", v)
+		}
+		values := req.URL.Query()
+		if p.Scope != nil {
+			values.Add("scope", *p.Scope)
+		}
+		req.URL.RawQuery = values.Encode()
+		return nil
+	}
+}
+
+// DecodeDeleteNamespaceResponse returns a decoder for responses returned by
+// the cache DeleteNamespace endpoint. restoreBody controls whether the
+// response body should be restored after having been read.
+func DecodeDeleteNamespaceResponse(decoder func(*http.Response) goahttp.Decoder, restoreBody bool) func(*http.Response) (any, error) {
+	return func(resp *http.Response) (any, error) {
+		if restoreBody {
+			b, err := io.ReadAll(resp.Body)
+			if err != nil {
+				return nil, err
+			}
+			resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			defer func() {
+				resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			}()
+		} else {
+			defer resp.Body.Close()
+		}
+		switch resp.StatusCode {
+		case http.StatusAccepted:
+			var (
+				body DeleteNamespaceResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("cache", "DeleteNamespace", err)
+			}
+			err = ValidateDeleteNamespaceResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("cache", "DeleteNamespace", err)
+			}
+			res := NewDeleteNamespaceCacheJobAccepted(&body)
+			return res, nil
+		default:
+			body, _ := io.ReadAll(resp.Body)
+			return nil, goahttp.ErrInvalidResponse("cache", "DeleteNamespace", resp.StatusCode, string(body))
+		}
+	}
+}
+
+// BuildJobRequest instantiates a HTTP request object with method and path set
+// to call the "cache" service "Job" endpoint
+func (c *Client) BuildJobRequest(ctx context.Context, v any) (*http.Request, error) {
+	var (
+		id string
+	)
+	{
+		p, ok := v.(*cache.CacheJobRequest)
+		if !ok {
+			return nil, goahttp.ErrInvalidType("cache", "Job", "*cache.CacheJobRequest", v)
+		}
+		id = p.ID
+	}
+	u := &url.URL{Scheme: c.scheme, Host: c.host, Path: JobCachePath(id)}
+	req, err := http.NewRequest("GET", u.String(), nil)
+	if err != nil {
+		return nil, goahttp.ErrInvalidURL("cache", "Job", u.String(), err)
+	}
+	if ctx != nil {
+		req = req.WithContext(ctx)
+	}
+
+	return req, nil
+}
+
+// DecodeJobResponse returns a decoder for responses returned by the cache Job
+// endpoint. restoreBody controls whether the response body should be restored
+// after having been read.
+func DecodeJobResponse(decoder func(*http.Response) goahttp.Decoder, restoreBody bool) func(*http.Response) (any, error) {
+	return func(resp *http.Response) (any, error) {
+		if restoreBody {
+			b, err := io.ReadAll(resp.Body)
+			if err != nil {
+				return nil, err
+			}
+			resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			defer func() {
+				resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			}()
+		} else {
+			defer resp.Body.Close()
+		}
+		switch resp.StatusCode {
+		case http.StatusOK:
+			var (
+				body JobResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("cache", "Job", err)
+			}
+			err = ValidateJobResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("cache", "Job", err)
+			}
+			res := NewJobCacheJobOK(&body)
+			return res, nil
+		default:
+			body, _ := io.ReadAll(resp.Body)
+			return nil, goahttp.ErrInvalidResponse("cache", "Job", resp.StatusCode, string(body))
+		}
+	}
+}
+
+// BuildDeleteTagRequest instantiates a HTTP request object with method and
+// path set to call the "cache" service "DeleteTag" endpoint
+func (c *Client) BuildDeleteTagRequest(ctx context.Context, v any) (*http.Request, error) {
+	var (
+		tag string
+	)
+	{
+		p, ok := v.(*cache.CacheDeleteTagRequest)
+		if !ok {
+			return nil, goahttp.ErrInvalidType("cache", "DeleteTag", "*cache.CacheDeleteTagRequest", v)
+		}
+		tag = p.Tag
+	}
+	u := &url.URL{Scheme: c.scheme, Host: c.host, Path: DeleteTagCachePath(tag)}
+	req, err := http.NewRequest("DELETE", u.String(), nil)
+	if err != nil {
+		return nil, goahttp.ErrInvalidURL("cache", "DeleteTag", u.String(), err)
+	}
+	if ctx != nil {
+		req = req.WithContext(ctx)
+	}
+
+	return req, nil
+}
+
+// DecodeDeleteTagResponse returns a decoder for responses returned by the
+// cache DeleteTag endpoint. restoreBody controls whether the response body
+// should be restored after having been read.
+func DecodeDeleteTagResponse(decoder func(*http.Response) goahttp.Decoder, restoreBody bool) func(*http.Response) (any, error) {
+	return func(resp *http.Response) (any, error) {
+		if restoreBody {
+			b, err := io.ReadAll(resp.Body)
+			if err != nil {
+				return nil, err
+			}
+			resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			defer func() {
+				resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			}()
+		} else {
+			defer resp.Body.Close()
+		}
+		switch resp.StatusCode {
+		case http.StatusOK:
+			var (
+				body DeleteTagResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("cache", "DeleteTag", err)
+			}
+			err = ValidateDeleteTagResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("cache", "DeleteTag", err)
+			}
+			res := NewDeleteTagCacheDeleteTagResultOK(&body)
+			return res, nil
+		default:
+			body, _ := io.ReadAll(resp.Body)
+			return nil, goahttp.ErrInvalidResponse("cache", "DeleteTag", resp.StatusCode, string(body))
+		}
+	}
+}
+
+// BuildBatchGetRequest instantiates a HTTP request object with method and path
+// set to call the "cache" service "BatchGet" endpoint
+func (c *Client) BuildBatchGetRequest(ctx context.Context, v any) (*http.Request, error) {
+	u := &url.URL{Scheme: c.scheme, Host: c.host, Path: BatchGetCachePath()}
+	req, err := http.NewRequest("POST", u.String(), nil)
+	if err != nil {
+		return nil, goahttp.ErrInvalidURL("cache", "BatchGet", u.String(), err)
+	}
+	if ctx != nil {
+		req = req.WithContext(ctx)
+	}
+
+	return req, nil
+}
+
+// EncodeBatchGetRequest returns an encoder for requests sent to the cache
+// BatchGet server.
+func EncodeBatchGetRequest(encoder func(*http.Request) goahttp.Encoder) func(*http.Request, any) error {
+	return func(req *http.Request, v any) error {
+		p, ok := v.(*cache.CacheBatchGetRequest)
+		if !ok {
+			return goahttp.ErrInvalidType("cache", "BatchGet", "*cache.CacheBatchGetRequest", v)
+		}
+		body := NewBatchGetRequestBody(p)
+		if err := encoder(req).Encode(&body); err != nil {
+			return goahttp.ErrEncodingError("cache", "BatchGet", err)
+		}
+		return nil
+	}
+}
+
+// DecodeBatchGetResponse returns a decoder for responses returned by the cache
+// BatchGet endpoint. restoreBody controls whether the response body should be
+// restored after having been read.
+func DecodeBatchGetResponse(decoder func(*http.Response) goahttp.Decoder, restoreBody bool) func(*http.Response) (any, error) {
+	return func(resp *http.Response) (any, error) {
+		if restoreBody {
+			b, err := io.ReadAll(resp.Body)
+			if err != nil {
+				return nil, err
+			}
+			resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			defer func() {
+				resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			}()
+		} else {
+			defer resp.Body.Close()
+		}
+		switch resp.StatusCode {
+		case http.StatusOK:
+			var (
+				body BatchGetResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("cache", "BatchGet", err)
+			}
+			for _, e := range body {
+				if e != nil {
+					if err2 := ValidateCacheBatchGetResultResponse(e); err2 != nil {
+						err = goa.MergeErrors(err, err2)
+					}
+				}
+			}
+			if err != nil {
+				return nil, goahttp.ErrValidationError("cache", "BatchGet", err)
+			}
+			res := NewBatchGetCacheBatchGetResultOK(body)
+			return res, nil
+		default:
+			body, _ := io.ReadAll(resp.Body)
+			return nil, goahttp.ErrInvalidResponse("cache", "BatchGet", resp.StatusCode, string(body))
+		}
+	}
+}
+
+// BuildBatchSetRequest instantiates a HTTP request object with method and path
+// set to call the "cache" service "BatchSet" endpoint
+func (c *Client) BuildBatchSetRequest(ctx context.Context, v any) (*http.Request, error) {
+	u := &url.URL{Scheme: c.scheme, Host: c.host, Path: BatchSetCachePath()}
+	req, err := http.NewRequest("POST", u.String(), nil)
+	if err != nil {
+		return nil, goahttp.ErrInvalidURL("cache", "BatchSet", u.String(), err)
+	}
+	if ctx != nil {
+		req = req.WithContext(ctx)
+	}
+
+	return req, nil
+}
+
+// EncodeBatchSetRequest returns an encoder for requests sent to the cache
+// BatchSet server.
+func EncodeBatchSetRequest(encoder func(*http.Request) goahttp.Encoder) func(*http.Request, any) error {
+	return func(req *http.Request, v any) error {
+		p, ok := v.(*cache.CacheBatchSetRequest)
+		if !ok {
+			return goahttp.ErrInvalidType("cache", "BatchSet", "*cache.CacheBatchSetRequest", v)
+		}
+		body := NewBatchSetRequestBody(p)
+		if err := encoder(req).Encode(&body); err != nil {
+			return goahttp.ErrEncodingError("cache", "BatchSet", err)
+		}
+		return nil
+	}
+}
+
+// DecodeBatchSetResponse returns a decoder for responses returned by the cache
+// BatchSet endpoint. restoreBody controls whether the response body should be
+// restored after having been read.
+func DecodeBatchSetResponse(decoder func(*http.Response) goahttp.Decoder, restoreBody bool) func(*http.Response) (any, error) {
+	return func(resp *http.Response) (any, error) {
+		if restoreBody {
+			b, err := io.ReadAll(resp.Body)
+			if err != nil {
+				return nil, err
+			}
+			resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			defer func() {
+				resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			}()
+		} else {
+			defer resp.Body.Close()
+		}
+		switch resp.StatusCode {
+		case http.StatusOK:
+			var (
+				body BatchSetResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("cache", "BatchSet", err)
+			}
+			for _, e := range body {
+				if e != nil {
+					if err2 := ValidateCacheBatchSetResultResponse(e); err2 != nil {
+						err = goa.MergeErrors(err, err2)
+					}
+				}
+			}
+			if err != nil {
+				return nil, goahttp.ErrValidationError("cache", "BatchSet", err)
+			}
+			res := NewBatchSetCacheBatchSetResultOK(body)
+			return res, nil
+		default:
+			body, _ := io.ReadAll(resp.Body)
+			return nil, goahttp.ErrInvalidResponse("cache", "BatchSet", resp.StatusCode, string(body))
+		}
+	}
+}
+
+// unmarshalCacheKeysItemResponseBodyToCacheCacheKeysItem builds a value of
+// type *cache.CacheKeysItem from a value of type *CacheKeysItemResponseBody.
+func unmarshalCacheKeysItemResponseBodyToCacheCacheKeysItem(v *CacheKeysItemResponseBody) *cache.CacheKeysItem {
+	res := &cache.CacheKeysItem{
+		Key:   *v.Key,
+		Scope: v.Scope,
+	}
+
+	return res
+}
+
+// marshalCacheCacheBatchGetItemToCacheBatchGetItemRequestBody builds a value
+// of type *CacheBatchGetItemRequestBody from a value of type
+// *cache.CacheBatchGetItem.
+func marshalCacheCacheBatchGetItemToCacheBatchGetItemRequestBody(v *cache.CacheBatchGetItem) *CacheBatchGetItemRequestBody {
+	res := &CacheBatchGetItemRequestBody{
+		Key:       v.Key,
+		Namespace: v.Namespace,
+		Scope:     v.Scope,
+	}
+
+	return res
+}
+
+// marshalCacheBatchGetItemRequestBodyToCacheCacheBatchGetItem builds a value
+// of type *cache.CacheBatchGetItem from a value of type
+// *CacheBatchGetItemRequestBody.
+func marshalCacheBatchGetItemRequestBodyToCacheCacheBatchGetItem(v *CacheBatchGetItemRequestBody) *cache.CacheBatchGetItem {
+	res := &cache.CacheBatchGetItem{
+		Key:       v.Key,
+		Namespace: v.Namespace,
+		Scope:     v.Scope,
+	}
+
+	return res
+}
+
+// unmarshalCacheBatchGetResultResponseToCacheCacheBatchGetResult builds a
+// value of type *cache.CacheBatchGetResult from a value of type
+// *CacheBatchGetResultResponse.
+func unmarshalCacheBatchGetResultResponseToCacheCacheBatchGetResult(v *CacheBatchGetResultResponse) *cache.CacheBatchGetResult {
+	res := &cache.CacheBatchGetResult{
+		Key:       *v.Key,
+		Namespace: v.Namespace,
+		Scope:     v.Scope,
+		Status:    *v.Status,
+		Data:      v.Data,
+		Error:     v.Error,
+	}
+
+	return res
+}
+
+// marshalCacheCacheBatchSetItemToCacheBatchSetItemRequestBody builds a value
+// of type *CacheBatchSetItemRequestBody from a value of type
+// *cache.CacheBatchSetItem.
+func marshalCacheCacheBatchSetItemToCacheBatchSetItemRequestBody(v *cache.CacheBatchSetItem) *CacheBatchSetItemRequestBody {
+	res := &CacheBatchSetItemRequestBody{
+		Key:       v.Key,
+		Namespace: v.Namespace,
+		Scope:     v.Scope,
+		Data:      v.Data,
+		TTL:       v.TTL,
+	}
+
+	return res
+}
+
+// marshalCacheBatchSetItemRequestBodyToCacheCacheBatchSetItem builds a value
+// of type *cache.CacheBatchSetItem from a value of type
+// *CacheBatchSetItemRequestBody.
+func marshalCacheBatchSetItemRequestBodyToCacheCacheBatchSetItem(v *CacheBatchSetItemRequestBody) *cache.CacheBatchSetItem {
+	res := &cache.CacheBatchSetItem{
+		Key:       v.Key,
+		Namespace: v.Namespace,
+		Scope:     v.Scope,
+		Data:      v.Data,
+		TTL:       v.TTL,
+	}
+
+	return res
+}
+
+// unmarshalCacheBatchSetResultResponseToCacheCacheBatchSetResult builds a
+// value of type *cache.CacheBatchSetResult from a value of type
+// *CacheBatchSetResultResponse.
+func unmarshalCacheBatchSetResultResponseToCacheCacheBatchSetResult(v *CacheBatchSetResultResponse) *cache.CacheBatchSetResult {
+	res := &cache.CacheBatchSetResult{
+		Key:       *v.Key,
+		Namespace: v.Namespace,
+		Scope:     v.Scope,
+		Status:    *v.Status,
+		Error:     v.Error,
+	}
+
+	return res
+}
@@ -7,6 +7,10 @@
 
 package client
 
+import (
+	"fmt"
+)
+
 // GetCachePath returns the URL path to the cache service Get HTTP endpoint.
 func GetCachePath() string {
 	return "/v1/cache"
@@ -21,3 +25,48 @@ func SetCachePath() string {
 func SetExternalCachePath() string {
 	return "/v1/external/cache"
 }
+
+// PatchCachePath returns the URL path to the cache service Patch HTTP endpoint.
+func PatchCachePath() string {
+	return "/v1/cache"
+}
+
+// DeleteCachePath returns the URL path to the cache service Delete HTTP endpoint.
+func DeleteCachePath() string {
+	return "/v1/cache"
+}
+
+// MetaCachePath returns the URL path to the cache service Meta HTTP endpoint.
+func MetaCachePath() string {
+	return "/v1/cache/meta"
+}
+
+// KeysCachePath returns the URL path to the cache service Keys HTTP endpoint.
+func KeysCachePath() string {
+	return "/v1/cache/keys"
+}
+
+// DeleteNamespaceCachePath returns the URL path to the cache service DeleteNamespace HTTP endpoint.
+func DeleteNamespaceCachePath(namespace string) string {
+	return fmt.Sprintf("/v1/cache/namespaces/%v", namespace)
+}
+
+// JobCachePath returns the URL path to the cache service Job HTTP endpoint.
+func JobCachePath(id string) string {
+	return fmt.Sprintf("/v1/cache/jobs/%v", id)
+}
+
+// DeleteTagCachePath returns the URL path to the cache service DeleteTag HTTP endpoint.
+func DeleteTagCachePath(tag string) string {
+	return fmt.Sprintf("/v1/cache/tags/%v", tag)
+}
+
+// BatchGetCachePath returns the URL path to the cache service BatchGet HTTP endpoint.
+func BatchGetCachePath() string {
+	return "/v1/cache/batch/get"
+}
+
+// BatchSetCachePath returns the URL path to the cache service BatchSet HTTP endpoint.
+func BatchSetCachePath() string {
+	return "/v1/cache/batch/set"
+}
@@ -6,3 +6,463 @@
 // $ goa gen github.com/eclipse-xfsc/redis-cache-service/design
 
 package client
+
+import (
+	cache "github.com/eclipse-xfsc/redis-cache-service/gen/cache"
+	goa "goa.design/goa/v3/pkg"
+)
+
+// BatchGetRequestBody is the type of the "cache" service "BatchGet" endpoint
+// HTTP request body.
+type BatchGetRequestBody struct {
+	// Cache entries to get.
+	Items []*CacheBatchGetItemRequestBody `form:"items" json:"items" xml:"items"`
+}
+
+// BatchSetRequestBody is the type of the "cache" service "BatchSet" endpoint
+// HTTP request body.
+type BatchSetRequestBody struct {
+	// Cache entries to set.
+	Items []*CacheBatchSetItemRequestBody `form:"items" json:"items" xml:"items"`
+}
+
+// MetaResponseBody is the type of the "cache" service "Meta" endpoint HTTP
+// response body.
+type MetaResponseBody struct {
+	// Whether the entry exists in the cache.
+	Exists *bool `form:"exists,omitempty" json:"exists,omitempty" xml:"exists,omitempty"`
+	// Storage key of the entry in Redis.
+	Key *string `form:"key,omitempty" json:"key,omitempty" xml:"key,omitempty"`
+	// Remaining time to live in seconds, not set if the entry does not expire.
+	TTL *int64 `form:"ttl,omitempty" json:"ttl,omitempty" xml:"ttl,omitempty"`
+	// Size of the stored value in bytes.
+	Size *int64 `form:"size,omitempty" json:"size,omitempty" xml:"size,omitempty"`
+}
+
+// KeysResponseBody is the type of the "cache" service "Keys" endpoint HTTP
+// response body.
+type KeysResponseBody struct {
+	// Entries of the page.
+	Keys []*CacheKeysItemResponseBody `form:"keys,omitempty" json:"keys,omitempty" xml:"keys,omitempty"`
+	// Opaque cursor of the next page, not set if the listing is complete.
+	Cursor *string `form:"cursor,omitempty" json:"cursor,omitempty" xml:"cursor,omitempty"`
+}
+
+// DeleteNamespaceResponseBody is the type of the "cache" service
+// "DeleteNamespace" endpoint HTTP response body.
+type DeleteNamespaceResponseBody struct {
+	// Job ID.
+	ID *string `form:"id,omitempty" json:"id,omitempty" xml:"id,omitempty"`
+	// Namespace of the deleted entries.
+	Namespace *string `form:"namespace,omitempty" json:"namespace,omitempty" xml:"namespace,omitempty"`
+	// Scope of the deleted entries.
+	Scope *string `form:"scope,omitempty" json:"scope,omitempty" xml:"scope,omitempty"`
+	// Job status.
+	Status *string `form:"status,omitempty" json:"status,omitempty" xml:"status,omitempty"`
+	// Number of deleted entries.
+	Deleted *int64 `form:"deleted,omitempty" json:"deleted,omitempty" xml:"deleted,omitempty"`
+	// Error message if the job has failed.
+	Error *string `form:"error,omitempty" json:"error,omitempty" xml:"error,omitempty"`
+	// Start time of the job.
+	StartedAt *string `form:"startedAt,omitempty" json:"startedAt,omitempty" xml:"startedAt,omitempty"`
+	// End time of the job.
+	FinishedAt *string `form:"finishedAt,omitempty" json:"finishedAt,omitempty" xml:"finishedAt,omitempty"`
+}
+
+// JobResponseBody is the type of the "cache" service "Job" endpoint HTTP
+// response body.
+type JobResponseBody struct {
+	// Job ID.
+	ID *string `form:"id,omitempty" json:"id,omitempty" xml:"id,omitempty"`
+	// Namespace of the deleted entries.
+	Namespace *string `form:"namespace,omitempty" json:"namespace,omitempty" xml:"namespace,omitempty"`
+	// Scope of the deleted entries.
+	Scope *string `form:"scope,omitempty" json:"scope,omitempty" xml:"scope,omitempty"`
+	// Job status.
+	Status *string `form:"status,omitempty" json:"status,omitempty" xml:"status,omitempty"`
+	// Number of deleted entries.
+	Deleted *int64 `form:"deleted,omitempty" json:"deleted,omitempty" xml:"deleted,omitempty"`
+	// Error message if the job has failed.
+	Error *string `form:"error,omitempty" json:"error,omitempty" xml:"error,omitempty"`
+	// Start time of the job.
+	StartedAt *string `form:"startedAt,omitempty" json:"startedAt,omitempty" xml:"startedAt,omitempty"`
+	// End time of the job.
+	FinishedAt *string `form:"finishedAt,omitempty" json:"finishedAt,omitempty" xml:"finishedAt,omitempty"`
+}
+
+// DeleteTagResponseBody is the type of the "cache" service "DeleteTag"
+// endpoint HTTP response body.
+type DeleteTagResponseBody struct {
+	// Number of deleted entries.
+	Deleted *int64 `form:"deleted,omitempty" json:"deleted,omitempty" xml:"deleted,omitempty"`
+}
+
+// BatchGetResponseBody is the type of the "cache" service "BatchGet" endpoint
+// HTTP response body.
+type BatchGetResponseBody []*CacheBatchGetResultResponse
+
+// BatchSetResponseBody is the type of the "cache" service "BatchSet" endpoint
+// HTTP response body.
+type BatchSetResponseBody []*CacheBatchSetResultResponse
+
+// CacheKeysItemResponseBody is used to define fields on response body types.
+type CacheKeysItemResponseBody struct {
+	// Cache entry key.
+	Key *string `form:"key,omitempty" json:"key,omitempty" xml:"key,omitempty"`
+	// Cache entry scope.
+	Scope *string `form:"scope,omitempty" json:"scope,omitempty" xml:"scope,omitempty"`
+}
+
+// CacheBatchGetItemRequestBody is used to define fields on request body types.
+type CacheBatchGetItemRequestBody struct {
+	// Cache entry key.
+	Key string `form:"key" json:"key" xml:"key"`
+	// Cache entry namespace.
+	Namespace *string `form:"namespace,omitempty" json:"namespace,omitempty" xml:"namespace,omitempty"`
+	// Cache entry scope.
+	Scope *string `form:"scope,omitempty" json:"scope,omitempty" xml:"scope,omitempty"`
+}
+
+// CacheBatchGetResultResponse is used to define fields on response body types.
+type CacheBatchGetResultResponse struct {
+	// Cache entry key.
+	Key *string `form:"key,omitempty" json:"key,omitempty" xml:"key,omitempty"`
+	// Cache entry namespace.
+	Namespace *string `form:"namespace,omitempty" json:"namespace,omitempty" xml:"namespace,omitempty"`
+	// Cache entry scope.
+	Scope *string `form:"scope,omitempty" json:"scope,omitempty" xml:"scope,omitempty"`
+	// HTTP status code of the item.
+	Status *int `form:"status,omitempty" json:"status,omitempty" xml:"status,omitempty"`
+	// Cached JSON value.
+	Data any `form:"data,omitempty" json:"data,omitempty" xml:"data,omitempty"`
+	// Error message if the value could not be retrieved.
+	Error *string `form:"error,omitempty" json:"error,omitempty" xml:"error,omitempty"`
+}
+
+// CacheBatchSetItemRequestBody is used to define fields on request body types.
+type CacheBatchSetItemRequestBody struct {
+	// Cache entry key.
+	Key string `form:"key" json:"key" xml:"key"`
+	// Cache entry namespace.
+	Namespace *string `form:"namespace,omitempty" json:"namespace,omitempty" xml:"namespace,omitempty"`
+	// Cache entry scope.
+	Scope *string `form:"scope,omitempty" json:"scope,omitempty" xml:"scope,omitempty"`
+	// JSON value to store.
+	Data any `form:"data" json:"data" xml:"data"`
+	// Cache entry TTL in seconds.
+	TTL *int `form:"ttl,omitempty" json:"ttl,omitempty" xml:"ttl,omitempty"`
+}
+
+// CacheBatchSetResultResponse is used to define fields on response body types.
+type CacheBatchSetResultResponse struct {
+	// Cache entry key.
+	Key *string `form:"key,omitempty" json:"key,omitempty" xml:"key,omitempty"`
+	// Cache entry namespace.
+	Namespace *string `form:"namespace,omitempty" json:"namespace,omitempty" xml:"namespace,omitempty"`
+	// Cache entry scope.
+	Scope *string `form:"scope,omitempty" json:"scope,omitempty" xml:"scope,omitempty"`
+	// HTTP status code of the item.
+	Status *int `form:"status,omitempty" json:"status,omitempty" xml:"status,omitempty"`
+	// Error message if the value could not be stored.
+	Error *string `form:"error,omitempty" json:"error,omitempty" xml:"error,omitempty"`
+}
+
+// NewBatchGetRequestBody builds the HTTP request body from the payload of the
+// "BatchGet" endpoint of the "cache" service.
+func NewBatchGetRequestBody(p *cache.CacheBatchGetRequest) *BatchGetRequestBody {
+	body := &BatchGetRequestBody{}
+	if p.Items != nil {
+		body.Items = make([]*CacheBatchGetItemRequestBody, len(p.Items))
+		for i, val := range p.Items {
+			body.Items[i] = marshalCacheCacheBatchGetItemToCacheBatchGetItemRequestBody(val)
+		}
+	} else {
+		body.Items = []*CacheBatchGetItemRequestBody{}
+	}
+	return body
+}
+
+// NewBatchSetRequestBody builds the HTTP request body from the payload of the
+// "BatchSet" endpoint of the "cache" service.
+func NewBatchSetRequestBody(p *cache.CacheBatchSetRequest) *BatchSetRequestBody {
+	body := &BatchSetRequestBody{}
+	if p.Items != nil {
+		body.Items = make([]*CacheBatchSetItemRequestBody, len(p.Items))
+		for i, val := range p.Items {
+			body.Items[i] = marshalCacheCacheBatchSetItemToCacheBatchSetItemRequestBody(val)
+		}
+	} else {
+		body.Items = []*CacheBatchSetItemRequestBody{}
+	}
+	return body
+}
+
+// NewGetCacheGetResultOK builds a "cache" service "Get" endpoint result from a
+// HTTP "OK" response.
+func NewGetCacheGetResultOK(body any, etag *string) *cache.CacheGetResult {
+	v := body
+	res := &cache.CacheGetResult{
+		Data: v,
+	}
+	res.Etag = etag
+
+	return res
+}
+
+// NewGetNotModified builds a cache service Get endpoint not_modified error.
+func NewGetNotModified(etag string) *cache.CacheNotModified {
+	v := &cache.CacheNotModified{}
+	v.Etag = etag
+
+	return v
+}
+
+// NewPatchCacheGetResultOK builds a "cache" service "Patch" endpoint result
+// from a HTTP "OK" response.
+func NewPatchCacheGetResultOK(body any, etag *string) *cache.CacheGetResult {
+	v := body
+	res := &cache.CacheGetResult{
+		Data: v,
+	}
+	res.Etag = etag
+
+	return res
+}
+
+// NewMetaCacheMetaResponseOK builds a "cache" service "Meta" endpoint result
+// from a HTTP "OK" response.
+func NewMetaCacheMetaResponseOK(body *MetaResponseBody) *cache.CacheMetaResponse {
+	v := &cache.CacheMetaResponse{
+		Exists: *body.Exists,
+		Key:    *body.Key,
+		TTL:    body.TTL,
+		Size:   body.Size,
+	}
+
+	return v
+}
+
+// NewKeysCacheKeysResultOK builds a "cache" service "Keys" endpoint result
+// from a HTTP "OK" response.
+func NewKeysCacheKeysResultOK(body *KeysResponseBody) *cache.CacheKeysResult {
+	v := &cache.CacheKeysResult{
+		Cursor: body.Cursor,
+	}
+	v.Keys = make([]*cache.CacheKeysItem, len(body.Keys))
+	for i, val := range body.Keys {
+		v.Keys[i] = unmarshalCacheKeysItemResponseBodyToCacheCacheKeysItem(val)
+	}
+
+	return v
+}
+
+// NewDeleteNamespaceCacheJobAccepted builds a "cache" service
+// "DeleteNamespace" endpoint result from a HTTP "Accepted" response.
+func NewDeleteNamespaceCacheJobAccepted(body *DeleteNamespaceResponseBody) *cache.CacheJob {
+	v := &cache.CacheJob{
+		ID:         *body.ID,
+		Namespace:  *body.Namespace,
+		Scope:      body.Scope,
+		Status:     *body.Status,
+		Deleted:    *body.Deleted,
+		Error:      body.Error,
+		StartedAt:  *body.StartedAt,
+		FinishedAt: body.FinishedAt,
+	}
+
+	return v
+}
+
+// NewJobCacheJobOK builds a "cache" service "Job" endpoint result from a HTTP
+// "OK" response.
+func NewJobCacheJobOK(body *JobResponseBody) *cache.CacheJob {
+	v := &cache.CacheJob{
+		ID:         *body.ID,
+		Namespace:  *body.Namespace,
+		Scope:      body.Scope,
+		Status:     *body.Status,
+		Deleted:    *body.Deleted,
+		Error:      body.Error,
+		StartedAt:  *body.StartedAt,
+		FinishedAt: body.FinishedAt,
+	}
+
+	return v
+}
+
+// NewDeleteTagCacheDeleteTagResultOK builds a "cache" service "DeleteTag"
+// endpoint result from a HTTP "OK" response.
+func NewDeleteTagCacheDeleteTagResultOK(body *DeleteTagResponseBody) *cache.CacheDeleteTagResult {
+	v := &cache.CacheDeleteTagResult{
+		Deleted: *body.Deleted,
+	}
+
+	return v
+}
+
+// NewBatchGetCacheBatchGetResultOK builds a "cache" service "BatchGet"
+// endpoint result from a HTTP "OK" response.
+func NewBatchGetCacheBatchGetResultOK(body []*CacheBatchGetResultResponse) []*cache.CacheBatchGetResult {
+	v := make([]*cache.CacheBatchGetResult, len(body))
+	for i, val := range body {
+		v[i] = unmarshalCacheBatchGetResultResponseToCacheCacheBatchGetResult(val)
+	}
+
+	return v
+}
+
+// NewBatchSetCacheBatchSetResultOK builds a "cache" service "BatchSet"
+// endpoint result from a HTTP "OK" response.
+func NewBatchSetCacheBatchSetResultOK(body []*CacheBatchSetResultResponse) []*cache.CacheBatchSetResult {
+	v := make([]*cache.CacheBatchSetResult, len(body))
+	for i, val := range body {
+		v[i] = unmarshalCacheBatchSetResultResponseToCacheCacheBatchSetResult(val)
+	}
+
+	return v
+}
+
+// ValidateMetaResponseBody runs the validations defined on MetaResponseBody
+func ValidateMetaResponseBody(body *MetaResponseBody) (err error) {
+	if body.Exists == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("exists", "body"))
+	}
+	if body.Key == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("key", "body"))
+	}
+	return
+}
+
+// ValidateKeysResponseBody runs the validations defined on KeysResponseBody
+func ValidateKeysResponseBody(body *KeysResponseBody) (err error) {
+	if body.Keys == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("keys", "body"))
+	}
+	for _, e := range body.Keys {
+		if e != nil {
+			if err2 := ValidateCacheKeysItemResponseBody(e); err2 != nil {
+				err = goa.MergeErrors(err, err2)
+			}
+		}
+	}
+	return
+}
+
+// ValidateDeleteNamespaceResponseBody runs the validations defined on
+// DeleteNamespaceResponseBody
+func ValidateDeleteNamespaceResponseBody(body *DeleteNamespaceResponseBody) (err error) {
+	if body.ID == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("id", "body"))
+	}
+	if body.Namespace == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("namespace", "body"))
+	}
+	if body.Status == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("status", "body"))
+	}
+	if body.Deleted == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("deleted", "body"))
+	}
+	if body.StartedAt == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("startedAt", "body"))
+	}
+	if body.Status != nil {
+		if !(*body.Status == "running" || *body.Status == "completed" || *body.Status == "failed") {
+			err = goa.MergeErrors(err, goa.InvalidEnumValueError("body.status", *body.Status, []any{"running", "completed", "failed"}))
+		}
+	}
+	if body.StartedAt != nil {
+		err = goa.MergeErrors(err, goa.ValidateFormat("body.startedAt", *body.StartedAt, goa.FormatDateTime))
+	}
+	if body.FinishedAt != nil {
+		err = goa.MergeErrors(err, goa.ValidateFormat("body.finishedAt", *body.FinishedAt, goa.FormatDateTime))
+	}
+	return
+}
+
+// ValidateJobResponseBody runs the validations defined on JobResponseBody
+func ValidateJobResponseBody(body *JobResponseBody) (err error) {
+	if body.ID == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("id", "body"))
+	}
+	if body.Namespace == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("namespace", "body"))
+	}
+	if body.Status == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("status", "body"))
+	}
+	if body.Deleted == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("deleted", "body"))
+	}
+	if body.StartedAt == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("startedAt", "body"))
+	}
+	if body.Status != nil {
+		if !(*body.Status == "running" || *body.Status == "completed" || *body.Status == "failed") {
+			err = goa.MergeErrors(err, goa.InvalidEnumValueError("body.status", *body.Status, []any{"running", "completed", "failed"}))
+		}
+	}
+	if body.StartedAt != nil {
+		err = goa.MergeErrors(err, goa.ValidateFormat("body.startedAt", *body.StartedAt, goa.FormatDateTime))
+	}
+	if body.FinishedAt != nil {
+		err = goa.MergeErrors(err, goa.ValidateFormat("body.finishedAt", *body.FinishedAt, goa.FormatDateTime))
+	}
+	return
+}
+
+// ValidateDeleteTagResponseBody runs the validations defined on
+// DeleteTagResponseBody
+func ValidateDeleteTagResponseBody(body *DeleteTagResponseBody) (err error) {
+	if body.Deleted == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("deleted", "body"))
+	}
+	return
+}
+
+// ValidateCacheKeysItemResponseBody runs the validations defined on
+// CacheKeysItemResponseBody
+func ValidateCacheKeysItemResponseBody(body *CacheKeysItemResponseBody) (err error) {
+	if body.Key == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("key", "body"))
+	}
+	return
+}
+
+// ValidateCacheBatchGetResultResponse runs the validations defined on
+// CacheBatchGetResultResponse
+func ValidateCacheBatchGetResultResponse(body *CacheBatchGetResultResponse) (err error) {
+	if body.Key == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("key", "body"))
+	}
+	if body.Status == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("status", "body"))
+	}
+	return
+}
+
+// ValidateCacheBatchSetItemRequestBody runs the validations defined on
+// CacheBatchSetItemRequestBody
+func ValidateCacheBatchSetItemRequestBody(body *CacheBatchSetItemRequestBody) (err error) {
+	if body.Data == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("data", "body"))
+	}
+	if body.TTL != nil {
+		if *body.TTL < 0 {
+			err = goa.MergeErrors(err, goa.InvalidRangeError("body.ttl", *body.TTL, 0, true))
+		}
+	}
+	return
+}
+
+// ValidateCacheBatchSetResultResponse runs the validations defined on
+// CacheBatchSetResultResponse
+func ValidateCacheBatchSetResultResponse(body *CacheBatchSetResultResponse) (err error) {
+	if body.Key == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("key", "body"))
+	}
+	if body.Status == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("status", "body"))
+	}
+	return
+}
@@ -13,7 +13,9 @@ import (
 	"io"
 	"net/http"
 	"strconv"
+	"unicode/utf8"
 
+	cache "github.com/eclipse-xfsc/redis-cache-service/gen/cache"
 	goahttp "goa.design/goa/v3/http"
 	goa "goa.design/goa/v3/pkg"
 )
@@ -22,10 +24,13 @@ import (
 // endpoint.
 func EncodeGetResponse(encoder func(context.Context, http.ResponseWriter) goahttp.Encoder) func(context.Context, http.ResponseWriter, any) error {
 	return func(ctx context.Context, w http.ResponseWriter, v any) error {
-		res, _ := v.(any)
+		res, _ := v.(*cache.CacheGetResult)
 		ctx = context.WithValue(ctx, goahttp.ContentTypeKey, "application/json")
 		enc := encoder(ctx, w)
-		body := res
+		body := res.Data
+		if res.Etag != nil {
+			w.Header().Set("Etag", *res.Etag)
+		}
 		w.WriteHeader(http.StatusOK)
 		return enc.Encode(body)
 	}
@@ -36,11 +41,16 @@ func EncodeGetResponse(encoder func(context.Context, http.ResponseWriter) goahtt
 func DecodeGetRequest(mux goahttp.Muxer, decoder func(*http.Request) goahttp.Decoder) func(*http.Request) (any, error) {
 	return func(r *http.Request) (any, error) {
 		var (
-			key       string
-			namespace *string
-			scope     *string
-			strategy  *string
-			err       error
+			key           string
+			namespace     *string
+			scope         *string
+			strategy      *string
+			arrays        *string
+			scopePriority *string
+			ifNoneMatch   *string
+			touch         *int
+			fields        *string
+			err           error
 		)
 		key = r.Header.Get("x-cache-key")
 		if key == "" {
@@ -58,15 +68,80 @@ func DecodeGetRequest(mux goahttp.Muxer, decoder func(*http.Request) goahttp.Dec
 		if strategyRaw != "" {
 			strategy = &strategyRaw
 		}
+		if strategy != nil {
+			if !(*strategy == "merge" || *strategy == "first" || *strategy == "last" || *strategy == "deep" || *strategy == "scoped") {
+				err = goa.MergeErrors(err, goa.InvalidEnumValueError("strategy", *strategy, []any{"merge", "first", "last", "deep", "scoped"}))
+			}
+		}
+		arraysRaw := r.Header.Get("x-cache-array-merge")
+		if arraysRaw != "" {
+			arrays = &arraysRaw
+		}
+		if arrays != nil {
+			if !(*arrays == "concat" || *arrays == "dedupe") {
+				err = goa.MergeErrors(err, goa.InvalidEnumValueError("arrays", *arrays, []any{"concat", "dedupe"}))
+			}
+		}
+		scopePriorityRaw := r.Header.Get("x-cache-scope-priority")
+		if scopePriorityRaw != "" {
+			scopePriority = &scopePriorityRaw
+		}
+		ifNoneMatchRaw := r.Header.Get("If-None-Match")
+		if ifNoneMatchRaw != "" {
+			ifNoneMatch = &ifNoneMatchRaw
+		}
+		{
+			touchRaw := r.Header.Get("x-cache-touch")
+			if touchRaw != "" {
+				v, err2 := strconv.ParseInt(touchRaw, 10, strconv.IntSize)
+				if err2 != nil {
+					err = goa.MergeErrors(err, goa.InvalidFieldTypeError("touch", touchRaw, "integer"))
+				}
+				pv := int(v)
+				touch = &pv
+			}
+		}
+		if touch != nil {
+			if *touch < 1 {
+				err = goa.MergeErrors(err, goa.InvalidRangeError("touch", *touch, 1, true))
+			}
+		}
+		fieldsRaw := r.Header.Get("x-cache-fields")
+		if fieldsRaw != "" {
+			fields = &fieldsRaw
+		}
 		if err != nil {
 			return nil, err
 		}
-		payload := NewGetCacheGetRequest(key, namespace, scope, strategy)
+		payload := NewGetCacheGetRequest(key, namespace, scope, strategy, arrays, scopePriority, ifNoneMatch, touch, fields)
 
 		return payload, nil
 	}
 }
 
+// EncodeGetError returns an encoder for errors returned by the Get cache
+// endpoint.
+func EncodeGetError(encoder func(context.Context, http.ResponseWriter) goahttp.Encoder, formatter func(ctx context.Context, err error) goahttp.Statuser) func(context.Context, http.ResponseWriter, error) error {
+	encodeError := goahttp.ErrorEncoder(encoder, formatter)
+	return func(ctx context.Context, w http.ResponseWriter, v error) error {
+		var en goa.GoaErrorNamer
+		if !errors.As(v, &en) {
+			return encodeError(ctx, w, v)
+		}
+		switch en.GoaErrorName() {
+		case "not_modified":
+			var res *cache.CacheNotModified
+			errors.As(v, &res)
+			w.Header().Set("Etag", res.Etag)
+			w.Header().Set("goa-error", res.GoaErrorName())
+			w.WriteHeader(http.StatusNotModified)
+			return nil
+		default:
+			return encodeError(ctx, w, v)
+		}
+	}
+}
+
 // EncodeSetResponse returns an encoder for responses returned by the cache Set
 // endpoint.
 func EncodeSetResponse(encoder func(context.Context, http.ResponseWriter) goahttp.Encoder) func(context.Context, http.ResponseWriter, any) error {
@@ -101,6 +176,9 @@ func DecodeSetRequest(mux goahttp.Muxer, decoder func(*http.Request) goahttp.Dec
 			namespace *string
 			scope     *string
 			ttl       *int
+			condition *string
+			ifMatch   *string
+			tags      *string
 		)
 		key = r.Header.Get("x-cache-key")
 		if key == "" {
@@ -125,10 +203,32 @@ func DecodeSetRequest(mux goahttp.Muxer, decoder func(*http.Request) goahttp.Dec
 				ttl = &pv
 			}
 		}
+		if ttl != nil {
+			if *ttl < 0 {
+				err = goa.MergeErrors(err, goa.InvalidRangeError("ttl", *ttl, 0, true))
+			}
+		}
+		conditionRaw := r.Header.Get("x-cache-condition")
+		if conditionRaw != "" {
+			condition = &conditionRaw
+		}
+		if condition != nil {
+			if !(*condition == "nx" || *condition == "xx") {
+				err = goa.MergeErrors(err, goa.InvalidEnumValueError("condition", *condition, []any{"nx", "xx"}))
+			}
+		}
+		ifMatchRaw := r.Header.Get("If-Match")
+		if ifMatchRaw != "" {
+			ifMatch = &ifMatchRaw
+		}
+		tagsRaw := r.Header.Get("x-cache-tags")
+		if tagsRaw != "" {
+			tags = &tagsRaw
+		}
 		if err != nil {
 			return nil, err
 		}
-		payload := NewSetCacheSetRequest(body, key, namespace, scope, ttl)
+		payload := NewSetCacheSetRequest(body, key, namespace, scope, ttl, condition, ifMatch, tags)
 
 		return payload, nil
 	}
@@ -168,6 +268,9 @@ func DecodeSetExternalRequest(mux goahttp.Muxer, decoder func(*http.Request) goa
 			namespace *string
 			scope     *string
 			ttl       *int
+			condition *string
+			ifMatch   *string
+			tags      *string
 		)
 		key = r.Header.Get("x-cache-key")
 		if key == "" {
@@ -192,11 +295,495 @@ func DecodeSetExternalRequest(mux goahttp.Muxer, decoder func(*http.Request) goa
 				ttl = &pv
 			}
 		}
+		if ttl != nil {
+			if *ttl < 0 {
+				err = goa.MergeErrors(err, goa.InvalidRangeError("ttl", *ttl, 0, true))
+			}
+		}
+		conditionRaw := r.Header.Get("x-cache-condition")
+		if conditionRaw != "" {
+			condition = &conditionRaw
+		}
+		if condition != nil {
+			if !(*condition == "nx" || *condition == "xx") {
+				err = goa.MergeErrors(err, goa.InvalidEnumValueError("condition", *condition, []any{"nx", "xx"}))
+			}
+		}
+		ifMatchRaw := r.Header.Get("If-Match")
+		if ifMatchRaw != "" {
+			ifMatch = &ifMatchRaw
+		}
+		tagsRaw := r.Header.Get("x-cache-tags")
+		if tagsRaw != "" {
+			tags = &tagsRaw
+		}
+		if err != nil {
+			return nil, err
+		}
+		payload := NewSetExternalCacheSetRequest(body, key, namespace, scope, ttl, condition, ifMatch, tags)
+
+		return payload, nil
+	}
+}
+
+// EncodePatchResponse returns an encoder for responses returned by the cache
+// Patch endpoint.
+func EncodePatchResponse(encoder func(context.Context, http.ResponseWriter) goahttp.Encoder) func(context.Context, http.ResponseWriter, any) error {
+	return func(ctx context.Context, w http.ResponseWriter, v any) error {
+		res, _ := v.(*cache.CacheGetResult)
+		ctx = context.WithValue(ctx, goahttp.ContentTypeKey, "application/json")
+		enc := encoder(ctx, w)
+		body := res.Data
+		if res.Etag != nil {
+			w.Header().Set("Etag", *res.Etag)
+		}
+		w.WriteHeader(http.StatusOK)
+		return enc.Encode(body)
+	}
+}
+
+// DecodePatchRequest returns a decoder for requests sent to the cache Patch
+// endpoint.
+func DecodePatchRequest(mux goahttp.Muxer, decoder func(*http.Request) goahttp.Decoder) func(*http.Request) (any, error) {
+	return func(r *http.Request) (any, error) {
+		var (
+			body any
+			err  error
+		)
+		err = decoder(r).Decode(&body)
+		if err != nil {
+			if err == io.EOF {
+				return nil, goa.MissingPayloadError()
+			}
+			var gerr *goa.ServiceError
+			if errors.As(err, &gerr) {
+				return nil, gerr
+			}
+			return nil, goa.DecodePayloadError(err.Error())
+		}
+
+		var (
+			key         string
+			namespace   *string
+			scope       *string
+			contentType *string
+		)
+		key = r.Header.Get("x-cache-key")
+		if key == "" {
+			err = goa.MergeErrors(err, goa.MissingFieldError("key", "header"))
+		}
+		namespaceRaw := r.Header.Get("x-cache-namespace")
+		if namespaceRaw != "" {
+			namespace = &namespaceRaw
+		}
+		scopeRaw := r.Header.Get("x-cache-scope")
+		if scopeRaw != "" {
+			scope = &scopeRaw
+		}
+		contentTypeRaw := r.Header.Get("Content-Type")
+		if contentTypeRaw != "" {
+			contentType = &contentTypeRaw
+		}
+		if err != nil {
+			return nil, err
+		}
+		payload := NewPatchCachePatchRequest(body, key, namespace, scope, contentType)
+
+		return payload, nil
+	}
+}
+
+// EncodeDeleteResponse returns an encoder for responses returned by the cache
+// Delete endpoint.
+func EncodeDeleteResponse(encoder func(context.Context, http.ResponseWriter) goahttp.Encoder) func(context.Context, http.ResponseWriter, any) error {
+	return func(ctx context.Context, w http.ResponseWriter, v any) error {
+		w.WriteHeader(http.StatusOK)
+		return nil
+	}
+}
+
+// DecodeDeleteRequest returns a decoder for requests sent to the cache Delete
+// endpoint.
+func DecodeDeleteRequest(mux goahttp.Muxer, decoder func(*http.Request) goahttp.Decoder) func(*http.Request) (any, error) {
+	return func(r *http.Request) (any, error) {
+		var (
+			key       string
+			namespace *string
+			scope     *string
+			err       error
+		)
+		key = r.Header.Get("x-cache-key")
+		if key == "" {
+			err = goa.MergeErrors(err, goa.MissingFieldError("key", "header"))
+		}
+		namespaceRaw := r.Header.Get("x-cache-namespace")
+		if namespaceRaw != "" {
+			namespace = &namespaceRaw
+		}
+		scopeRaw := r.Header.Get("x-cache-scope")
+		if scopeRaw != "" {
+			scope = &scopeRaw
+		}
+		if err != nil {
+			return nil, err
+		}
+		payload := NewDeleteCacheDeleteRequest(key, namespace, scope)
+
+		return payload, nil
+	}
+}
+
+// EncodeMetaResponse returns an encoder for responses returned by the cache
+// Meta endpoint.
+func EncodeMetaResponse(encoder func(context.Context, http.ResponseWriter) goahttp.Encoder) func(context.Context, http.ResponseWriter, any) error {
+	return func(ctx context.Context, w http.ResponseWriter, v any) error {
+		res, _ := v.(*cache.CacheMetaResponse)
+		enc := encoder(ctx, w)
+		body := NewMetaResponseBody(res)
+		w.WriteHeader(http.StatusOK)
+		return enc.Encode(body)
+	}
+}
+
+// DecodeMetaRequest returns a decoder for requests sent to the cache Meta
+// endpoint.
+func DecodeMetaRequest(mux goahttp.Muxer, decoder func(*http.Request) goahttp.Decoder) func(*http.Request) (any, error) {
+	return func(r *http.Request) (any, error) {
+		var (
+			key       string
+			namespace *string
+			scope     *string
+			err       error
+		)
+		key = r.Header.Get("x-cache-key")
+		if key == "" {
+			err = goa.MergeErrors(err, goa.MissingFieldError("key", "header"))
+		}
+		namespaceRaw := r.Header.Get("x-cache-namespace")
+		if namespaceRaw != "" {
+			namespace = &namespaceRaw
+		}
+		scopeRaw := r.Header.Get("x-cache-scope")
+		if scopeRaw != "" {
+			scope = &scopeRaw
+		}
+		if err != nil {
+			return nil, err
+		}
+		payload := NewMetaCacheMetaRequest(key, namespace, scope)
+
+		return payload, nil
+	}
+}
+
+// EncodeKeysResponse returns an encoder for responses returned by the cache
+// Keys endpoint.
+func EncodeKeysResponse(encoder func(context.Context, http.ResponseWriter) goahttp.Encoder) func(context.Context, http.ResponseWriter, any) error {
+	return func(ctx context.Context, w http.ResponseWriter, v any) error {
+		res, _ := v.(*cache.CacheKeysResult)
+		enc := encoder(ctx, w)
+		body := NewKeysResponseBody(res)
+		w.WriteHeader(http.StatusOK)
+		return enc.Encode(body)
+	}
+}
+
+// DecodeKeysRequest returns a decoder for requests sent to the cache Keys
+// endpoint.
+func DecodeKeysRequest(mux goahttp.Muxer, decoder func(*http.Request) goahttp.Decoder) func(*http.Request) (any, error) {
+	return func(r *http.Request) (any, error) {
+		var (
+			namespace string
+			scope     *string
+			prefix    *string
+			cursor    *string
+			limit     int
+			err       error
+		)
+		qp := r.URL.Query()
+		namespace = qp.Get("namespace")
+		if namespace == "" {
+			err = goa.MergeErrors(err, goa.MissingFieldError("namespace", "query string"))
+		}
+		if utf8.RuneCountInString(namespace) < 1 {
+			err = goa.MergeErrors(err, goa.InvalidLengthError("namespace", namespace, utf8.RuneCountInString(namespace), 1, true))
+		}
+		scopeRaw := qp.Get("scope")
+		if scopeRaw != "" {
+			scope = &scopeRaw
+		}
+		prefixRaw := qp.Get("prefix")
+		if prefixRaw != "" {
+			prefix = &prefixRaw
+		}
+		cursorRaw := qp.Get("cursor")
+		if cursorRaw != "" {
+			cursor = &cursorRaw
+		}
+		{
+			limitRaw := qp.Get("limit")
+			if limitRaw == "" {
+				limit = 100
+			} else {
+				v, err2 := strconv.ParseInt(limitRaw, 10, strconv.IntSize)
+				if err2 != nil {
+					err = goa.MergeErrors(err, goa.InvalidFieldTypeError("limit", limitRaw, "integer"))
+				}
+				limit = int(v)
+			}
+		}
+		if limit < 1 {
+			err = goa.MergeErrors(err, goa.InvalidRangeError("limit", limit, 1, true))
+		}
+		if limit > 1000 {
+			err = goa.MergeErrors(err, goa.InvalidRangeError("limit", limit, 1000, false))
+		}
+		if err != nil {
+			return nil, err
+		}
+		payload := NewKeysCacheKeysRequest(namespace, scope, prefix, cursor, limit)
+
+		return payload, nil
+	}
+}
+
+// EncodeDeleteNamespaceResponse returns an encoder for responses returned by
+// the cache DeleteNamespace endpoint.
+func EncodeDeleteNamespaceResponse(encoder func(context.Context, http.ResponseWriter) goahttp.Encoder) func(context.Context, http.ResponseWriter, any) error {
+	return func(ctx context.Context, w http.ResponseWriter, v any) error {
+		res, _ := v.(*cache.CacheJob)
+		enc := encoder(ctx, w)
+		body := NewDeleteNamespaceResponseBody(res)
+		w.WriteHeader(http.StatusAccepted)
+		return enc.Encode(body)
+	}
+}
+
+// DecodeDeleteNamespaceRequest returns a decoder for requests sent to the
+// cache DeleteNamespace endpoint.
+func DecodeDeleteNamespaceRequest(mux goahttp.Muxer, decoder func(*http.Request) goahttp.Decoder) func(*http.Request) (any, error) {
+	return func(r *http.Request) (any, error) {
+		var (
+			namespace string
+			scope     *string
+
+			params = mux.Vars(r)
+		)
+		namespace = params["namespace"]
+		scopeRaw := r.URL.Query().Get("scope")
+		if scopeRaw != "" {
+			scope = &scopeRaw
+		}
+		payload := NewDeleteNamespaceCacheDeleteNamespaceRequest(namespace, scope)
+
+		return payload, nil
+	}
+}
+
+// EncodeJobResponse returns an encoder for responses returned by the cache Job
+// endpoint.
+func EncodeJobResponse(encoder func(context.Context, http.ResponseWriter) goahttp.Encoder) func(context.Context, http.ResponseWriter, any) error {
+	return func(ctx context.Context, w http.ResponseWriter, v any) error {
+		res, _ := v.(*cache.CacheJob)
+		enc := encoder(ctx, w)
+		body := NewJobResponseBody(res)
+		w.WriteHeader(http.StatusOK)
+		return enc.Encode(body)
+	}
+}
+
+// DecodeJobRequest returns a decoder for requests sent to the cache Job
+// endpoint.
+func DecodeJobRequest(mux goahttp.Muxer, decoder func(*http.Request) goahttp.Decoder) func(*http.Request) (any, error) {
+	return func(r *http.Request) (any, error) {
+		var (
+			id string
+
+			params = mux.Vars(r)
+		)
+		id = params["id"]
+		payload := NewJobCacheJobRequest(id)
+
+		return payload, nil
+	}
+}
+
+// EncodeDeleteTagResponse returns an encoder for responses returned by the
+// cache DeleteTag endpoint.
+func EncodeDeleteTagResponse(encoder func(context.Context, http.ResponseWriter) goahttp.Encoder) func(context.Context, http.ResponseWriter, any) error {
+	return func(ctx context.Context, w http.ResponseWriter, v any) error {
+		res, _ := v.(*cache.CacheDeleteTagResult)
+		enc := encoder(ctx, w)
+		body := NewDeleteTagResponseBody(res)
+		w.WriteHeader(http.StatusOK)
+		return enc.Encode(body)
+	}
+}
+
+// DecodeDeleteTagRequest returns a decoder for requests sent to the cache
+// DeleteTag endpoint.
+func DecodeDeleteTagRequest(mux goahttp.Muxer, decoder func(*http.Request) goahttp.Decoder) func(*http.Request) (any, error) {
+	return func(r *http.Request) (any, error) {
+		var (
+			tag string
+
+			params = mux.Vars(r)
+		)
+		tag = params["tag"]
+		payload := NewDeleteTagCacheDeleteTagRequest(tag)
+
+		return payload, nil
+	}
+}
+
+// EncodeBatchGetResponse returns an encoder for responses returned by the
+// cache BatchGet endpoint.
+func EncodeBatchGetResponse(encoder func(context.Context, http.ResponseWriter) goahttp.Encoder) func(context.Context, http.ResponseWriter, any) error {
+	return func(ctx context.Context, w http.ResponseWriter, v any) error {
+		res, _ := v.([]*cache.CacheBatchGetResult)
+		enc := encoder(ctx, w)
+		body := NewBatchGetResponseBody(res)
+		w.WriteHeader(http.StatusOK)
+		return enc.Encode(body)
+	}
+}
+
+// DecodeBatchGetRequest returns a decoder for requests sent to the cache
+// BatchGet endpoint.
+func DecodeBatchGetRequest(mux goahttp.Muxer, decoder func(*http.Request) goahttp.Decoder) func(*http.Request) (any, error) {
+	return func(r *http.Request) (any, error) {
+		var (
+			body BatchGetRequestBody
+			err  error
+		)
+		err = decoder(r).Decode(&body)
+		if err != nil {
+			if err == io.EOF {
+				return nil, goa.MissingPayloadError()
+			}
+			var gerr *goa.ServiceError
+			if errors.As(err, &gerr) {
+				return nil, gerr
+			}
+			return nil, goa.DecodePayloadError(err.Error())
+		}
+		err = ValidateBatchGetRequestBody(&body)
+		if err != nil {
+			return nil, err
+		}
+		payload := NewBatchGetCacheBatchGetRequest(&body)
+
+		return payload, nil
+	}
+}
+
+// EncodeBatchSetResponse returns an encoder for responses returned by the
+// cache BatchSet endpoint.
+func EncodeBatchSetResponse(encoder func(context.Context, http.ResponseWriter) goahttp.Encoder) func(context.Context, http.ResponseWriter, any) error {
+	return func(ctx context.Context, w http.ResponseWriter, v any) error {
+		res, _ := v.([]*cache.CacheBatchSetResult)
+		enc := encoder(ctx, w)
+		body := NewBatchSetResponseBody(res)
+		w.WriteHeader(http.StatusOK)
+		return enc.Encode(body)
+	}
+}
+
+// DecodeBatchSetRequest returns a decoder for requests sent to the cache
+// BatchSet endpoint.
+func DecodeBatchSetRequest(mux goahttp.Muxer, decoder func(*http.Request) goahttp.Decoder) func(*http.Request) (any, error) {
+	return func(r *http.Request) (any, error) {
+		var (
+			body BatchSetRequestBody
+			err  error
+		)
+		err = decoder(r).Decode(&body)
+		if err != nil {
+			if err == io.EOF {
+				return nil, goa.MissingPayloadError()
+			}
+			var gerr *goa.ServiceError
+			if errors.As(err, &gerr) {
+				return nil, gerr
+			}
+			return nil, goa.DecodePayloadError(err.Error())
+		}
+		err = ValidateBatchSetRequestBody(&body)
 		if err != nil {
 			return nil, err
 		}
-		payload := NewSetExternalCacheSetRequest(body, key, namespace, scope, ttl)
+		payload := NewBatchSetCacheBatchSetRequest(&body)
 
 		return payload, nil
 	}
 }
+
+// marshalCacheCacheKeysItemToCacheKeysItemResponseBody builds a value of type
+// *CacheKeysItemResponseBody from a value of type *cache.CacheKeysItem.
+func marshalCacheCacheKeysItemToCacheKeysItemResponseBody(v *cache.CacheKeysItem) *CacheKeysItemResponseBody {
+	res := &CacheKeysItemResponseBody{
+		Key:   v.Key,
+		Scope: v.Scope,
+	}
+
+	return res
+}
+
+// unmarshalCacheBatchGetItemRequestBodyToCacheCacheBatchGetItem builds a value
+// of type *cache.CacheBatchGetItem from a value of type
+// *CacheBatchGetItemRequestBody.
+func unmarshalCacheBatchGetItemRequestBodyToCacheCacheBatchGetItem(v *CacheBatchGetItemRequestBody) *cache.CacheBatchGetItem {
+	res := &cache.CacheBatchGetItem{
+		Key:       *v.Key,
+		Namespace: v.Namespace,
+		Scope:     v.Scope,
+	}
+
+	return res
+}
+
+// marshalCacheCacheBatchGetResultToCacheBatchGetResultResponse builds a value
+// of type *CacheBatchGetResultResponse from a value of type
+// *cache.CacheBatchGetResult.
+func marshalCacheCacheBatchGetResultToCacheBatchGetResultResponse(v *cache.CacheBatchGetResult) *CacheBatchGetResultResponse {
+	res := &CacheBatchGetResultResponse{
+		Key:       v.Key,
+		Namespace: v.Namespace,
+		Scope:     v.Scope,
+		Status:    v.Status,
+		Data:      v.Data,
+		Error:     v.Error,
+	}
+
+	return res
+}
+
+// unmarshalCacheBatchSetItemRequestBodyToCacheCacheBatchSetItem builds a value
+// of type *cache.CacheBatchSetItem from a value of type
+// *CacheBatchSetItemRequestBody.
+func unmarshalCacheBatchSetItemRequestBodyToCacheCacheBatchSetItem(v *CacheBatchSetItemRequestBody) *cache.CacheBatchSetItem {
+	res := &cache.CacheBatchSetItem{
+		Key:       *v.Key,
+		Namespace: v.Namespace,
+		Scope:     v.Scope,
+		Data:      v.Data,
+		TTL:       v.TTL,
+	}
+
+	return res
+}
+
+// marshalCacheCacheBatchSetResultToCacheBatchSetResultResponse builds a value
+// of type *CacheBatchSetResultResponse from a value of type
+// *cache.CacheBatchSetResult.
+func marshalCacheCacheBatchSetResultToCacheBatchSetResultResponse(v *cache.CacheBatchSetResult) *CacheBatchSetResultResponse {
+	res := &CacheBatchSetResultResponse{
+		Key:       v.Key,
+		Namespace: v.Namespace,
+		Scope:     v.Scope,
+		Status:    v.Status,
+		Error:     v.Error,
+	}
+
+	return res
+}
@@ -18,10 +18,19 @@ import (
 
 // Server lists the cache service endpoint HTTP handlers.
 type Server struct {
-	Mounts      []*MountPoint
-	Get         http.Handler
-	Set         http.Handler
-	SetExternal http.Handler
+	Mounts          []*MountPoint
+	Get             http.Handler
+	Set             http.Handler
+	SetExternal     http.Handler
+	Patch           http.Handler
+	Delete          http.Handler
+	Meta            http.Handler
+	Keys            http.Handler
+	DeleteNamespace http.Handler
+	Job             http.Handler
+	DeleteTag       http.Handler
+	BatchGet        http.Handler
+	BatchSet        http.Handler
 }
 
 // MountPoint holds information about the mounted endpoints.
@@ -54,10 +63,28 @@ func New(
 			{"Get", "GET", "/v1/cache"},
 			{"Set", "POST", "/v1/cache"},
 			{"SetExternal", "POST", "/v1/external/cache"},
+			{"Patch", "PATCH", "/v1/cache"},
+			{"Delete", "DELETE", "/v1/cache"},
+			{"Meta", "GET", "/v1/cache/meta"},
+			{"Keys", "GET", "/v1/cache/keys"},
+			{"DeleteNamespace", "DELETE", "/v1/cache/namespaces/{namespace}"},
+			{"Job", "GET", "/v1/cache/jobs/{id}"},
+			{"DeleteTag", "DELETE", "/v1/cache/tags/{tag}"},
+			{"BatchGet", "POST", "/v1/cache/batch/get"},
+			{"BatchSet", "POST", "/v1/cache/batch/set"},
 		},
-		Get:         NewGetHandler(e.Get, mux, decoder, encoder, errhandler, formatter),
-		Set:         NewSetHandler(e.Set, mux, decoder, encoder, errhandler, formatter),
-		SetExternal: NewSetExternalHandler(e.SetExternal, mux, decoder, encoder, errhandler, formatter),
+		Get:             NewGetHandler(e.Get, mux, decoder, encoder, errhandler, formatter),
+		Set:             NewSetHandler(e.Set, mux, decoder, encoder, errhandler, formatter),
+		SetExternal:     NewSetExternalHandler(e.SetExternal, mux, decoder, encoder, errhandler, formatter),
+		Patch:           NewPatchHandler(e.Patch, mux, decoder, encoder, errhandler, formatter),
+		Delete:          NewDeleteHandler(e.Delete, mux, decoder, encoder, errhandler, formatter),
+		Meta:            NewMetaHandler(e.Meta, mux, decoder, encoder, errhandler, formatter),
+		Keys:            NewKeysHandler(e.Keys, mux, decoder, encoder, errhandler, formatter),
+		DeleteNamespace: NewDeleteNamespaceHandler(e.DeleteNamespace, mux, decoder, encoder, errhandler, formatter),
+		Job:             NewJobHandler(e.Job, mux, decoder, encoder, errhandler, formatter),
+		DeleteTag:       NewDeleteTagHandler(e.DeleteTag, mux, decoder, encoder, errhandler, formatter),
+		BatchGet:        NewBatchGetHandler(e.BatchGet, mux, decoder, encoder, errhandler, formatter),
+		BatchSet:        NewBatchSetHandler(e.BatchSet, mux, decoder, encoder, errhandler, formatter),
 	}
 }
 
@@ -69,6 +96,15 @@ func (s *Server) Use(m func(http.Handler) http.Handler) {
 	s.Get = m(s.Get)
 	s.Set = m(s.Set)
 	s.SetExternal = m(s.SetExternal)
+	s.Patch = m(s.Patch)
+	s.Delete = m(s.Delete)
+	s.Meta = m(s.Meta)
+	s.Keys = m(s.Keys)
+	s.DeleteNamespace = m(s.DeleteNamespace)
+	s.Job = m(s.Job)
+	s.DeleteTag = m(s.DeleteTag)
+	s.BatchGet = m(s.BatchGet)
+	s.BatchSet = m(s.BatchSet)
 }
 
 // MethodNames returns the methods served.
@@ -79,6 +115,15 @@ func Mount(mux goahttp.Muxer, h *Server) {
 	MountGetHandler(mux, h.Get)
 	MountSetHandler(mux, h.Set)
 	MountSetExternalHandler(mux, h.SetExternal)
+	MountPatchHandler(mux, h.Patch)
+	MountDeleteHandler(mux, h.Delete)
+	MountMetaHandler(mux, h.Meta)
+	MountKeysHandler(mux, h.Keys)
+	MountDeleteNamespaceHandler(mux, h.DeleteNamespace)
+	MountJobHandler(mux, h.Job)
+	MountDeleteTagHandler(mux, h.DeleteTag)
+	MountBatchGetHandler(mux, h.BatchGet)
+	MountBatchSetHandler(mux, h.BatchSet)
 }
 
 // Mount configures the mux to serve the cache endpoints.
@@ -111,7 +156,7 @@ func NewGetHandler(
 	var (
 		decodeRequest  = DecodeGetRequest(mux, decoder)
 		encodeResponse = EncodeGetResponse(encoder)
-		encodeError    = goahttp.ErrorEncoder(encoder, formatter)
+		encodeError    = EncodeGetError(encoder, formatter)
 	)
 	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		ctx := context.WithValue(r.Context(), goahttp.AcceptTypeKey, r.Header.Get("Accept"))
@@ -238,3 +283,462 @@ func NewSetExternalHandler(
 		}
 	})
 }
+
+// MountPatchHandler configures the mux to serve the "cache" service "Patch"
+// endpoint.
+func MountPatchHandler(mux goahttp.Muxer, h http.Handler) {
+	f, ok := h.(http.HandlerFunc)
+	if !ok {
+		f = func(w http.ResponseWriter, r *http.Request) {
+			h.ServeHTTP(w, r)
+		}
+	}
+	mux.Handle("PATCH", "/v1/cache", f)
+}
+
+// NewPatchHandler creates a HTTP handler which loads the HTTP request and
+// calls the "cache" service "Patch" endpoint.
+func NewPatchHandler(
+	endpoint goa.Endpoint,
+	mux goahttp.Muxer,
+	decoder func(*http.Request) goahttp.Decoder,
+	encoder func(context.Context, http.ResponseWriter) goahttp.Encoder,
+	errhandler func(context.Context, http.ResponseWriter, error),
+	formatter func(ctx context.Context, err error) goahttp.Statuser,
+) http.Handler {
+	var (
+		decodeRequest  = DecodePatchRequest(mux, decoder)
+		encodeResponse = EncodePatchResponse(encoder)
+		encodeError    = goahttp.ErrorEncoder(encoder, formatter)
+	)
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		ctx := context.WithValue(r.Context(), goahttp.AcceptTypeKey, r.Header.Get("Accept"))
+		ctx = context.WithValue(ctx, goa.MethodKey, "Patch")
+		ctx = context.WithValue(ctx, goa.ServiceKey, "cache")
+		payload, err := decodeRequest(r)
+		if err != nil {
+			if err := encodeError(ctx, w, err); err != nil {
+				errhandler(ctx, w, err)
+			}
+			return
+		}
+		res, err := endpoint(ctx, payload)
+		if err != nil {
+			if err := encodeError(ctx, w, err); err != nil {
+				errhandler(ctx, w, err)
+			}
+			return
+		}
+		if err := encodeResponse(ctx, w, res); err != nil {
+			errhandler(ctx, w, err)
+		}
+	})
+}
+
+// MountDeleteHandler configures the mux to serve the "cache" service "Delete"
+// endpoint.
+func MountDeleteHandler(mux goahttp.Muxer, h http.Handler) {
+	f, ok := h.(http.HandlerFunc)
+	if !ok {
+		f = func(w http.ResponseWriter, r *http.Request) {
+			h.ServeHTTP(w, r)
+		}
+	}
+	mux.Handle("DELETE", "/v1/cache", f)
+}
+
+// NewDeleteHandler creates a HTTP handler which loads the HTTP request and
+// calls the "cache" service "Delete" endpoint.
+func NewDeleteHandler(
+	endpoint goa.Endpoint,
+	mux goahttp.Muxer,
+	decoder func(*http.Request) goahttp.Decoder,
+	encoder func(context.Context, http.ResponseWriter) goahttp.Encoder,
+	errhandler func(context.Context, http.ResponseWriter, error),
+	formatter func(ctx context.Context, err error) goahttp.Statuser,
+) http.Handler {
+	var (
+		decodeRequest  = DecodeDeleteRequest(mux, decoder)
+		encodeResponse = EncodeDeleteResponse(encoder)
+		encodeError    = goahttp.ErrorEncoder(encoder, formatter)
+	)
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		ctx := context.WithValue(r.Context(), goahttp.AcceptTypeKey, r.Header.Get("Accept"))
+		ctx = context.WithValue(ctx, goa.MethodKey, "Delete")
+		ctx = context.WithValue(ctx, goa.ServiceKey, "cache")
+		payload, err := decodeRequest(r)
+		if err != nil {
+			if err := encodeError(ctx, w, err); err != nil {
+				errhandler(ctx, w, err)
+			}
+			return
+		}
+		res, err := endpoint(ctx, payload)
+		if err != nil {
+			if err := encodeError(ctx, w, err); err != nil {
+				errhandler(ctx, w, err)
+			}
+			return
+		}
+		if err := encodeResponse(ctx, w, res); err != nil {
+			errhandler(ctx, w, err)
+		}
+	})
+}
+
+// MountMetaHandler configures the mux to serve the "cache" service "Meta"
+// endpoint.
+func MountMetaHandler(mux goahttp.Muxer, h http.Handler) {
+	f, ok := h.(http.HandlerFunc)
+	if !ok {
+		f = func(w http.ResponseWriter, r *http.Request) {
+			h.ServeHTTP(w, r)
+		}
+	}
+	mux.Handle("GET", "/v1/cache/meta", f)
+}
+
+// NewMetaHandler creates a HTTP handler which loads the HTTP request and calls
+// the "cache" service "Meta" endpoint.
+func NewMetaHandler(
+	endpoint goa.Endpoint,
+	mux goahttp.Muxer,
+	decoder func(*http.Request) goahttp.Decoder,
+	encoder func(context.Context, http.ResponseWriter) goahttp.Encoder,
+	errhandler func(context.Context, http.ResponseWriter, error),
+	formatter func(ctx context.Context, err error) goahttp.Statuser,
+) http.Handler {
+	var (
+		decodeRequest  = DecodeMetaRequest(mux, decoder)
+		encodeResponse = EncodeMetaResponse(encoder)
+		encodeError    = goahttp.ErrorEncoder(encoder, formatter)
+	)
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		ctx := context.WithValue(r.Context(), goahttp.AcceptTypeKey, r.Header.Get("Accept"))
+		ctx = context.WithValue(ctx, goa.MethodKey, "Meta")
+		ctx = context.WithValue(ctx, goa.ServiceKey, "cache")
+		payload, err := decodeRequest(r)
+		if err != nil {
+			if err := encodeError(ctx, w, err); err != nil {
+				errhandler(ctx, w, err)
+			}
+			return
+		}
+		res, err := endpoint(ctx, payload)
+		if err != nil {
+			if err := encodeError(ctx, w, err); err != nil {
+				errhandler(ctx, w, err)
+			}
+			return
+		}
+		if err := encodeResponse(ctx, w, res); err != nil {
+			errhandler(ctx, w, err)
+		}
+	})
+}
+
+// MountKeysHandler configures the mux to serve the "cache" service "Keys"
+// endpoint.
+func MountKeysHandler(mux goahttp.Muxer, h http.Handler) {
+	f, ok := h.(http.HandlerFunc)
+	if !ok {
+		f = func(w http.ResponseWriter, r *http.Request) {
+			h.ServeHTTP(w, r)
+		}
+	}
+	mux.Handle("GET", "/v1/cache/keys", f)
+}
+
+// NewKeysHandler creates a HTTP handler which loads the HTTP request and calls
+// the "cache" service "Keys" endpoint.
+func NewKeysHandler(
+	endpoint goa.Endpoint,
+	mux goahttp.Muxer,
+	decoder func(*http.Request) goahttp.Decoder,
+	encoder func(context.Context, http.ResponseWriter) goahttp.Encoder,
+	errhandler func(context.Context, http.ResponseWriter, error),
+	formatter func(ctx context.Context, err error) goahttp.Statuser,
+) http.Handler {
+	var (
+		decodeRequest  = DecodeKeysRequest(mux, decoder)
+		encodeResponse = EncodeKeysResponse(encoder)
+		encodeError    = goahttp.ErrorEncoder(encoder, formatter)
+	)
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		ctx := context.WithValue(r.Context(), goahttp.AcceptTypeKey, r.Header.Get("Accept"))
+		ctx = context.WithValue(ctx, goa.MethodKey, "Keys")
+		ctx = context.WithValue(ctx, goa.ServiceKey, "cache")
+		payload, err := decodeRequest(r)
+		if err != nil {
+			if err := encodeError(ctx, w, err); err != nil {
+				errhandler(ctx, w, err)
+			}
+			return
+		}
+		res, err := endpoint(ctx, payload)
+		if err != nil {
+			if err := encodeError(ctx, w, err); err != nil {
+				errhandler(ctx, w, err)
+			}
+			return
+		}
+		if err := encodeResponse(ctx, w, res); err != nil {
+			errhandler(ctx, w, err)
+		}
+	})
+}
+
+// MountDeleteNamespaceHandler configures the mux to serve the "cache" service
+// "DeleteNamespace" endpoint.
+func MountDeleteNamespaceHandler(mux goahttp.Muxer, h http.Handler) {
+	f, ok := h.(http.HandlerFunc)
+	if !ok {
+		f = func(w http.ResponseWriter, r *http.Request) {
+			h.ServeHTTP(w, r)
+		}
+	}
+	mux.Handle("DELETE", "/v1/cache/namespaces/{namespace}", f)
+}
+
+// NewDeleteNamespaceHandler creates a HTTP handler which loads the HTTP
+// request and calls the "cache" service "DeleteNamespace" endpoint.
+func NewDeleteNamespaceHandler(
+	endpoint goa.Endpoint,
+	mux goahttp.Muxer,
+	decoder func(*http.Request) goahttp.Decoder,
+	encoder func(context.Context, http.ResponseWriter) goahttp.Encoder,
+	errhandler func(context.Context, http.ResponseWriter, error),
+	formatter func(ctx context.Context, err error) goahttp.Statuser,
+) http.Handler {
+	var (
+		decodeRequest  = DecodeDeleteNamespaceRequest(mux, decoder)
+		encodeResponse = EncodeDeleteNamespaceResponse(encoder)
+		encodeError    = goahttp.ErrorEncoder(encoder, formatter)
+	)
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		ctx := context.WithValue(r.Context(), goahttp.AcceptTypeKey, r.Header.Get("Accept"))
+		ctx = context.WithValue(ctx, goa.MethodKey, "DeleteNamespace")
+		ctx = context.WithValue(ctx, goa.ServiceKey, "cache")
+		payload, err := decodeRequest(r)
+		if err != nil {
+			if err := encodeError(ctx, w, err); err != nil {
+				errhandler(ctx, w, err)
+			}
+			return
+		}
+		res, err := endpoint(ctx, payload)
+		if err != nil {
+			if err := encodeError(ctx, w, err); err != nil {
+				errhandler(ctx, w, err)
+			}
+			return
+		}
+		if err := encodeResponse(ctx, w, res); err != nil {
+			errhandler(ctx, w, err)
+		}
+	})
+}
+
+// MountJobHandler configures the mux to serve the "cache" service "Job"
+// endpoint.
+func MountJobHandler(mux goahttp.Muxer, h http.Handler) {
+	f, ok := h.(http.HandlerFunc)
+	if !ok {
+		f = func(w http.ResponseWriter, r *http.Request) {
+			h.ServeHTTP(w, r)
+		}
+	}
+	mux.Handle("GET", "/v1/cache/jobs/{id}", f)
+}
+
+// NewJobHandler creates a HTTP handler which loads the HTTP request and calls
+// the "cache" service "Job" endpoint.
+func NewJobHandler(
+	endpoint goa.Endpoint,
+	mux goahttp.Muxer,
+	decoder func(*http.Request) goahttp.Decoder,
+	encoder func(context.Context, http.ResponseWriter) goahttp.Encoder,
+	errhandler func(context.Context, http.ResponseWriter, error),
+	formatter func(ctx context.Context, err error) goahttp.Statuser,
+) http.Handler {
+	var (
+		decodeRequest  = DecodeJobRequest(mux, decoder)
+		encodeResponse = EncodeJobResponse(encoder)
+		encodeError    = goahttp.ErrorEncoder(encoder, formatter)
+	)
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		ctx := context.WithValue(r.Context(), goahttp.AcceptTypeKey, r.Header.Get("Accept"))
+		ctx = context.WithValue(ctx, goa.MethodKey, "Job")
+		ctx = context.WithValue(ctx, goa.ServiceKey, "cache")
+		payload, err := decodeRequest(r)
+		if err != nil {
+			if err := encodeError(ctx, w, err); err != nil {
+				errhandler(ctx, w, err)
+			}
+			return
+		}
+		res, err := endpoint(ctx, payload)
+		if err != nil {
+			if err := encodeError(ctx, w, err); err != nil {
+				errhandler(ctx, w, err)
+			}
+			return
+		}
+		if err := encodeResponse(ctx, w, res); err != nil {
+			errhandler(ctx, w, err)
+		}
+	})
+}
+
+// MountDeleteTagHandler configures the mux to serve the "cache" service
+// "DeleteTag" endpoint.
+func MountDeleteTagHandler(mux goahttp.Muxer, h http.Handler) {
+	f, ok := h.(http.HandlerFunc)
+	if !ok {
+		f = func(w http.ResponseWriter, r *http.Request) {
+			h.ServeHTTP(w, r)
+		}
+	}
+	mux.Handle("DELETE", "/v1/cache/tags/{tag}", f)
+}
+
+// NewDeleteTagHandler creates a HTTP handler which loads the HTTP request and
+// calls the "cache" service "DeleteTag" endpoint.
+func NewDeleteTagHandler(
+	endpoint goa.Endpoint,
+	mux goahttp.Muxer,
+	decoder func(*http.Request) goahttp.Decoder,
+	encoder func(context.Context, http.ResponseWriter) goahttp.Encoder,
+	errhandler func(context.Context, http.ResponseWriter, error),
+	formatter func(ctx context.Context, err error) goahttp.Statuser,
+) http.Handler {
+	var (
+		decodeRequest  = DecodeDeleteTagRequest(mux, decoder)
+		encodeResponse = EncodeDeleteTagResponse(encoder)
+		encodeError    = goahttp.ErrorEncoder(encoder, formatter)
+	)
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		ctx := context.WithValue(r.Context(), goahttp.AcceptTypeKey, r.Header.Get("Accept"))
+		ctx = context.WithValue(ctx, goa.MethodKey, "DeleteTag")
+		ctx = context.WithValue(ctx, goa.ServiceKey, "cache")
+		payload, err := decodeRequest(r)
+		if err != nil {
+			if err := encodeError(ctx, w, err); err != nil {
+				errhandler(ctx, w, err)
+			}
+			return
+		}
+		res, err := endpoint(ctx, payload)
+		if err != nil {
+			if err := encodeError(ctx, w, err); err != nil {
+				errhandler(ctx, w, err)
+			}
+			return
+		}
+		if err := encodeResponse(ctx, w, res); err != nil {
+			errhandler(ctx, w, err)
+		}
+	})
+}
+
+// MountBatchGetHandler configures the mux to serve the "cache" service
+// "BatchGet" endpoint.
+func MountBatchGetHandler(mux goahttp.Muxer, h http.Handler) {
+	f, ok := h.(http.HandlerFunc)
+	if !ok {
+		f = func(w http.ResponseWriter, r *http.Request) {
+			h.ServeHTTP(w, r)
+		}
+	}
+	mux.Handle("POST", "/v1/cache/batch/get", f)
+}
+
+// NewBatchGetHandler creates a HTTP handler which loads the HTTP request and
+// calls the "cache" service "BatchGet" endpoint.
+func NewBatchGetHandler(
+	endpoint goa.Endpoint,
+	mux goahttp.Muxer,
+	decoder func(*http.Request) goahttp.Decoder,
+	encoder func(context.Context, http.ResponseWriter) goahttp.Encoder,
+	errhandler func(context.Context, http.ResponseWriter, error),
+	formatter func(ctx context.Context, err error) goahttp.Statuser,
+) http.Handler {
+	var (
+		decodeRequest  = DecodeBatchGetRequest(mux, decoder)
+		encodeResponse = EncodeBatchGetResponse(encoder)
+		encodeError    = goahttp.ErrorEncoder(encoder, formatter)
+	)
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		ctx := context.WithValue(r.Context(), goahttp.AcceptTypeKey, r.Header.Get("Accept"))
+		ctx = context.WithValue(ctx, goa.MethodKey, "BatchGet")
+		ctx = context.WithValue(ctx, goa.ServiceKey, "cache")
+		payload, err := decodeRequest(r)
+		if err != nil {
+			if err := encodeError(ctx, w, err); err != nil {
+				errhandler(ctx, w, err)
+			}
+			return
+		}
+		res, err := endpoint(ctx, payload)
+		if err != nil {
+			if err := encodeError(ctx, w, err); err != nil {
+				errhandler(ctx, w, err)
+			}
+			return
+		}
+		if err := encodeResponse(ctx, w, res); err != nil {
+			errhandler(ctx, w, err)
+		}
+	})
+}
+
+// MountBatchSetHandler configures the mux to serve the "cache" service
+// "BatchSet" endpoint.
+func MountBatchSetHandler(mux goahttp.Muxer, h http.Handler) {
+	f, ok := h.(http.HandlerFunc)
+	if !ok {
+		f = func(w http.ResponseWriter, r *http.Request) {
+			h.ServeHTTP(w, r)
+		}
+	}
+	mux.Handle("POST", "/v1/cache/batch/set", f)
+}
+
+// NewBatchSetHandler creates a HTTP handler which loads the HTTP request and
+// calls the "cache" service "BatchSet" endpoint.
+func NewBatchSetHandler(
+	endpoint goa.Endpoint,
+	mux goahttp.Muxer,
+	decoder func(*http.Request) goahttp.Decoder,
+	encoder func(context.Context, http.ResponseWriter) goahttp.Encoder,
+	errhandler func(context.Context, http.ResponseWriter, error),
+	formatter func(ctx context.Context, err error) goahttp.Statuser,
+) http.Handler {
+	var (
+		decodeRequest  = DecodeBatchSetRequest(mux, decoder)
+		encodeResponse = EncodeBatchSetResponse(encoder)
+		encodeError    = goahttp.ErrorEncoder(encoder, formatter)
+	)
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		ctx := context.WithValue(r.Context(), goahttp.AcceptTypeKey, r.Header.Get("Accept"))
+		ctx = context.WithValue(ctx, goa.MethodKey, "BatchSet")
+		ctx = context.WithValue(ctx, goa.ServiceKey, "cache")
+		payload, err := decodeRequest(r)
+		if err != nil {
+			if err := encodeError(ctx, w, err); err != nil {
+				errhandler(ctx, w, err)
+			}
+			return
+		}
+		res, err := endpoint(ctx, payload)
+		if err != nil {
+			if err := encodeError(ctx, w, err); err != nil {
+				errhandler(ctx, w, err)
+			}
+			return
+		}
+		if err := encodeResponse(ctx, w, res); err != nil {
+			errhandler(ctx, w, err)
+		}
+	})
+}
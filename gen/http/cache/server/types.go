@@ -9,21 +9,272 @@ package server
 
 import (
 	cache "github.com/eclipse-xfsc/redis-cache-service/gen/cache"
+	goa "goa.design/goa/v3/pkg"
 )
 
+// BatchGetRequestBody is the type of the "cache" service "BatchGet" endpoint
+// HTTP request body.
+type BatchGetRequestBody struct {
+	// Cache entries to get.
+	Items []*CacheBatchGetItemRequestBody `form:"items,omitempty" json:"items,omitempty" xml:"items,omitempty"`
+}
+
+// BatchSetRequestBody is the type of the "cache" service "BatchSet" endpoint
+// HTTP request body.
+type BatchSetRequestBody struct {
+	// Cache entries to set.
+	Items []*CacheBatchSetItemRequestBody `form:"items,omitempty" json:"items,omitempty" xml:"items,omitempty"`
+}
+
+// MetaResponseBody is the type of the "cache" service "Meta" endpoint HTTP
+// response body.
+type MetaResponseBody struct {
+	// Whether the entry exists in the cache.
+	Exists bool `form:"exists" json:"exists" xml:"exists"`
+	// Storage key of the entry in Redis.
+	Key string `form:"key" json:"key" xml:"key"`
+	// Remaining time to live in seconds, not set if the entry does not expire.
+	TTL *int64 `form:"ttl,omitempty" json:"ttl,omitempty" xml:"ttl,omitempty"`
+	// Size of the stored value in bytes.
+	Size *int64 `form:"size,omitempty" json:"size,omitempty" xml:"size,omitempty"`
+}
+
+// KeysResponseBody is the type of the "cache" service "Keys" endpoint HTTP
+// response body.
+type KeysResponseBody struct {
+	// Entries of the page.
+	Keys []*CacheKeysItemResponseBody `form:"keys" json:"keys" xml:"keys"`
+	// Opaque cursor of the next page, not set if the listing is complete.
+	Cursor *string `form:"cursor,omitempty" json:"cursor,omitempty" xml:"cursor,omitempty"`
+}
+
+// DeleteNamespaceResponseBody is the type of the "cache" service
+// "DeleteNamespace" endpoint HTTP response body.
+type DeleteNamespaceResponseBody struct {
+	// Job ID.
+	ID string `form:"id" json:"id" xml:"id"`
+	// Namespace of the deleted entries.
+	Namespace string `form:"namespace" json:"namespace" xml:"namespace"`
+	// Scope of the deleted entries.
+	Scope *string `form:"scope,omitempty" json:"scope,omitempty" xml:"scope,omitempty"`
+	// Job status.
+	Status string `form:"status" json:"status" xml:"status"`
+	// Number of deleted entries.
+	Deleted int64 `form:"deleted" json:"deleted" xml:"deleted"`
+	// Error message if the job has failed.
+	Error *string `form:"error,omitempty" json:"error,omitempty" xml:"error,omitempty"`
+	// Start time of the job.
+	StartedAt string `form:"startedAt" json:"startedAt" xml:"startedAt"`
+	// End time of the job.
+	FinishedAt *string `form:"finishedAt,omitempty" json:"finishedAt,omitempty" xml:"finishedAt,omitempty"`
+}
+
+// JobResponseBody is the type of the "cache" service "Job" endpoint HTTP
+// response body.
+type JobResponseBody struct {
+	// Job ID.
+	ID string `form:"id" json:"id" xml:"id"`
+	// Namespace of the deleted entries.
+	Namespace string `form:"namespace" json:"namespace" xml:"namespace"`
+	// Scope of the deleted entries.
+	Scope *string `form:"scope,omitempty" json:"scope,omitempty" xml:"scope,omitempty"`
+	// Job status.
+	Status string `form:"status" json:"status" xml:"status"`
+	// Number of deleted entries.
+	Deleted int64 `form:"deleted" json:"deleted" xml:"deleted"`
+	// Error message if the job has failed.
+	Error *string `form:"error,omitempty" json:"error,omitempty" xml:"error,omitempty"`
+	// Start time of the job.
+	StartedAt string `form:"startedAt" json:"startedAt" xml:"startedAt"`
+	// End time of the job.
+	FinishedAt *string `form:"finishedAt,omitempty" json:"finishedAt,omitempty" xml:"finishedAt,omitempty"`
+}
+
+// DeleteTagResponseBody is the type of the "cache" service "DeleteTag"
+// endpoint HTTP response body.
+type DeleteTagResponseBody struct {
+	// Number of deleted entries.
+	Deleted int64 `form:"deleted" json:"deleted" xml:"deleted"`
+}
+
+// BatchGetResponseBody is the type of the "cache" service "BatchGet" endpoint
+// HTTP response body.
+type BatchGetResponseBody []*CacheBatchGetResultResponse
+
+// BatchSetResponseBody is the type of the "cache" service "BatchSet" endpoint
+// HTTP response body.
+type BatchSetResponseBody []*CacheBatchSetResultResponse
+
+// CacheKeysItemResponseBody is used to define fields on response body types.
+type CacheKeysItemResponseBody struct {
+	// Cache entry key.
+	Key string `form:"key" json:"key" xml:"key"`
+	// Cache entry scope.
+	Scope *string `form:"scope,omitempty" json:"scope,omitempty" xml:"scope,omitempty"`
+}
+
+// CacheBatchGetResultResponse is used to define fields on response body types.
+type CacheBatchGetResultResponse struct {
+	// Cache entry key.
+	Key string `form:"key" json:"key" xml:"key"`
+	// Cache entry namespace.
+	Namespace *string `form:"namespace,omitempty" json:"namespace,omitempty" xml:"namespace,omitempty"`
+	// Cache entry scope.
+	Scope *string `form:"scope,omitempty" json:"scope,omitempty" xml:"scope,omitempty"`
+	// HTTP status code of the item.
+	Status int `form:"status" json:"status" xml:"status"`
+	// Cached JSON value.
+	Data any `form:"data,omitempty" json:"data,omitempty" xml:"data,omitempty"`
+	// Error message if the value could not be retrieved.
+	Error *string `form:"error,omitempty" json:"error,omitempty" xml:"error,omitempty"`
+}
+
+// CacheBatchSetResultResponse is used to define fields on response body types.
+type CacheBatchSetResultResponse struct {
+	// Cache entry key.
+	Key string `form:"key" json:"key" xml:"key"`
+	// Cache entry namespace.
+	Namespace *string `form:"namespace,omitempty" json:"namespace,omitempty" xml:"namespace,omitempty"`
+	// Cache entry scope.
+	Scope *string `form:"scope,omitempty" json:"scope,omitempty" xml:"scope,omitempty"`
+	// HTTP status code of the item.
+	Status int `form:"status" json:"status" xml:"status"`
+	// Error message if the value could not be stored.
+	Error *string `form:"error,omitempty" json:"error,omitempty" xml:"error,omitempty"`
+}
+
+// CacheBatchGetItemRequestBody is used to define fields on request body types.
+type CacheBatchGetItemRequestBody struct {
+	// Cache entry key.
+	Key *string `form:"key,omitempty" json:"key,omitempty" xml:"key,omitempty"`
+	// Cache entry namespace.
+	Namespace *string `form:"namespace,omitempty" json:"namespace,omitempty" xml:"namespace,omitempty"`
+	// Cache entry scope.
+	Scope *string `form:"scope,omitempty" json:"scope,omitempty" xml:"scope,omitempty"`
+}
+
+// CacheBatchSetItemRequestBody is used to define fields on request body types.
+type CacheBatchSetItemRequestBody struct {
+	// Cache entry key.
+	Key *string `form:"key,omitempty" json:"key,omitempty" xml:"key,omitempty"`
+	// Cache entry namespace.
+	Namespace *string `form:"namespace,omitempty" json:"namespace,omitempty" xml:"namespace,omitempty"`
+	// Cache entry scope.
+	Scope *string `form:"scope,omitempty" json:"scope,omitempty" xml:"scope,omitempty"`
+	// JSON value to store.
+	Data any `form:"data,omitempty" json:"data,omitempty" xml:"data,omitempty"`
+	// Cache entry TTL in seconds.
+	TTL *int `form:"ttl,omitempty" json:"ttl,omitempty" xml:"ttl,omitempty"`
+}
+
+// NewMetaResponseBody builds the HTTP response body from the result of the
+// "Meta" endpoint of the "cache" service.
+func NewMetaResponseBody(res *cache.CacheMetaResponse) *MetaResponseBody {
+	body := &MetaResponseBody{
+		Exists: res.Exists,
+		Key:    res.Key,
+		TTL:    res.TTL,
+		Size:   res.Size,
+	}
+	return body
+}
+
+// NewKeysResponseBody builds the HTTP response body from the result of the
+// "Keys" endpoint of the "cache" service.
+func NewKeysResponseBody(res *cache.CacheKeysResult) *KeysResponseBody {
+	body := &KeysResponseBody{
+		Cursor: res.Cursor,
+	}
+	if res.Keys != nil {
+		body.Keys = make([]*CacheKeysItemResponseBody, len(res.Keys))
+		for i, val := range res.Keys {
+			body.Keys[i] = marshalCacheCacheKeysItemToCacheKeysItemResponseBody(val)
+		}
+	} else {
+		body.Keys = []*CacheKeysItemResponseBody{}
+	}
+	return body
+}
+
+// NewDeleteNamespaceResponseBody builds the HTTP response body from the result
+// of the "DeleteNamespace" endpoint of the "cache" service.
+func NewDeleteNamespaceResponseBody(res *cache.CacheJob) *DeleteNamespaceResponseBody {
+	body := &DeleteNamespaceResponseBody{
+		ID:         res.ID,
+		Namespace:  res.Namespace,
+		Scope:      res.Scope,
+		Status:     res.Status,
+		Deleted:    res.Deleted,
+		Error:      res.Error,
+		StartedAt:  res.StartedAt,
+		FinishedAt: res.FinishedAt,
+	}
+	return body
+}
+
+// NewJobResponseBody builds the HTTP response body from the result of the
+// "Job" endpoint of the "cache" service.
+func NewJobResponseBody(res *cache.CacheJob) *JobResponseBody {
+	body := &JobResponseBody{
+		ID:         res.ID,
+		Namespace:  res.Namespace,
+		Scope:      res.Scope,
+		Status:     res.Status,
+		Deleted:    res.Deleted,
+		Error:      res.Error,
+		StartedAt:  res.StartedAt,
+		FinishedAt: res.FinishedAt,
+	}
+	return body
+}
+
+// NewDeleteTagResponseBody builds the HTTP response body from the result of
+// the "DeleteTag" endpoint of the "cache" service.
+func NewDeleteTagResponseBody(res *cache.CacheDeleteTagResult) *DeleteTagResponseBody {
+	body := &DeleteTagResponseBody{
+		Deleted: res.Deleted,
+	}
+	return body
+}
+
+// NewBatchGetResponseBody builds the HTTP response body from the result of the
+// "BatchGet" endpoint of the "cache" service.
+func NewBatchGetResponseBody(res []*cache.CacheBatchGetResult) BatchGetResponseBody {
+	body := make([]*CacheBatchGetResultResponse, len(res))
+	for i, val := range res {
+		body[i] = marshalCacheCacheBatchGetResultToCacheBatchGetResultResponse(val)
+	}
+	return body
+}
+
+// NewBatchSetResponseBody builds the HTTP response body from the result of the
+// "BatchSet" endpoint of the "cache" service.
+func NewBatchSetResponseBody(res []*cache.CacheBatchSetResult) BatchSetResponseBody {
+	body := make([]*CacheBatchSetResultResponse, len(res))
+	for i, val := range res {
+		body[i] = marshalCacheCacheBatchSetResultToCacheBatchSetResultResponse(val)
+	}
+	return body
+}
+
 // NewGetCacheGetRequest builds a cache service Get endpoint payload.
-func NewGetCacheGetRequest(key string, namespace *string, scope *string, strategy *string) *cache.CacheGetRequest {
+func NewGetCacheGetRequest(key string, namespace *string, scope *string, strategy *string, arrays *string, scopePriority *string, ifNoneMatch *string, touch *int, fields *string) *cache.CacheGetRequest {
 	v := &cache.CacheGetRequest{}
 	v.Key = key
 	v.Namespace = namespace
 	v.Scope = scope
 	v.Strategy = strategy
+	v.Arrays = arrays
+	v.ScopePriority = scopePriority
+	v.IfNoneMatch = ifNoneMatch
+	v.Touch = touch
+	v.Fields = fields
 
 	return v
 }
 
 // NewSetCacheSetRequest builds a cache service Set endpoint payload.
-func NewSetCacheSetRequest(body any, key string, namespace *string, scope *string, ttl *int) *cache.CacheSetRequest {
+func NewSetCacheSetRequest(body any, key string, namespace *string, scope *string, ttl *int, condition *string, ifMatch *string, tags *string) *cache.CacheSetRequest {
 	v := body
 	res := &cache.CacheSetRequest{
 		Data: v,
@@ -32,13 +283,16 @@ func NewSetCacheSetRequest(body any, key string, namespace *string, scope *strin
 	res.Namespace = namespace
 	res.Scope = scope
 	res.TTL = ttl
+	res.Condition = condition
+	res.IfMatch = ifMatch
+	res.Tags = tags
 
 	return res
 }
 
 // NewSetExternalCacheSetRequest builds a cache service SetExternal endpoint
 // payload.
-func NewSetExternalCacheSetRequest(body any, key string, namespace *string, scope *string, ttl *int) *cache.CacheSetRequest {
+func NewSetExternalCacheSetRequest(body any, key string, namespace *string, scope *string, ttl *int, condition *string, ifMatch *string, tags *string) *cache.CacheSetRequest {
 	v := body
 	res := &cache.CacheSetRequest{
 		Data: v,
@@ -47,6 +301,176 @@ func NewSetExternalCacheSetRequest(body any, key string, namespace *string, scop
 	res.Namespace = namespace
 	res.Scope = scope
 	res.TTL = ttl
+	res.Condition = condition
+	res.IfMatch = ifMatch
+	res.Tags = tags
 
 	return res
 }
+
+// NewPatchCachePatchRequest builds a cache service Patch endpoint payload.
+func NewPatchCachePatchRequest(body any, key string, namespace *string, scope *string, contentType *string) *cache.CachePatchRequest {
+	v := body
+	res := &cache.CachePatchRequest{
+		Patch: v,
+	}
+	res.Key = key
+	res.Namespace = namespace
+	res.Scope = scope
+	res.ContentType = contentType
+
+	return res
+}
+
+// NewDeleteCacheDeleteRequest builds a cache service Delete endpoint payload.
+func NewDeleteCacheDeleteRequest(key string, namespace *string, scope *string) *cache.CacheDeleteRequest {
+	v := &cache.CacheDeleteRequest{}
+	v.Key = key
+	v.Namespace = namespace
+	v.Scope = scope
+
+	return v
+}
+
+// NewMetaCacheMetaRequest builds a cache service Meta endpoint payload.
+func NewMetaCacheMetaRequest(key string, namespace *string, scope *string) *cache.CacheMetaRequest {
+	v := &cache.CacheMetaRequest{}
+	v.Key = key
+	v.Namespace = namespace
+	v.Scope = scope
+
+	return v
+}
+
+// NewKeysCacheKeysRequest builds a cache service Keys endpoint payload.
+func NewKeysCacheKeysRequest(namespace string, scope *string, prefix *string, cursor *string, limit int) *cache.CacheKeysRequest {
+	v := &cache.CacheKeysRequest{}
+	v.Namespace = namespace
+	v.Scope = scope
+	v.Prefix = prefix
+	v.Cursor = cursor
+	v.Limit = limit
+
+	return v
+}
+
+// NewDeleteNamespaceCacheDeleteNamespaceRequest builds a cache service
+// DeleteNamespace endpoint payload.
+func NewDeleteNamespaceCacheDeleteNamespaceRequest(namespace string, scope *string) *cache.CacheDeleteNamespaceRequest {
+	v := &cache.CacheDeleteNamespaceRequest{}
+	v.Namespace = namespace
+	v.Scope = scope
+
+	return v
+}
+
+// NewJobCacheJobRequest builds a cache service Job endpoint payload.
+func NewJobCacheJobRequest(id string) *cache.CacheJobRequest {
+	v := &cache.CacheJobRequest{}
+	v.ID = id
+
+	return v
+}
+
+// NewDeleteTagCacheDeleteTagRequest builds a cache service DeleteTag endpoint
+// payload.
+func NewDeleteTagCacheDeleteTagRequest(tag string) *cache.CacheDeleteTagRequest {
+	v := &cache.CacheDeleteTagRequest{}
+	v.Tag = tag
+
+	return v
+}
+
+// NewBatchGetCacheBatchGetRequest builds a cache service BatchGet endpoint
+// payload.
+func NewBatchGetCacheBatchGetRequest(body *BatchGetRequestBody) *cache.CacheBatchGetRequest {
+	v := &cache.CacheBatchGetRequest{}
+	v.Items = make([]*cache.CacheBatchGetItem, len(body.Items))
+	for i, val := range body.Items {
+		v.Items[i] = unmarshalCacheBatchGetItemRequestBodyToCacheCacheBatchGetItem(val)
+	}
+
+	return v
+}
+
+// NewBatchSetCacheBatchSetRequest builds a cache service BatchSet endpoint
+// payload.
+func NewBatchSetCacheBatchSetRequest(body *BatchSetRequestBody) *cache.CacheBatchSetRequest {
+	v := &cache.CacheBatchSetRequest{}
+	v.Items = make([]*cache.CacheBatchSetItem, len(body.Items))
+	for i, val := range body.Items {
+		v.Items[i] = unmarshalCacheBatchSetItemRequestBodyToCacheCacheBatchSetItem(val)
+	}
+
+	return v
+}
+
+// ValidateBatchGetRequestBody runs the validations defined on
+// BatchGetRequestBody
+func ValidateBatchGetRequestBody(body *BatchGetRequestBody) (err error) {
+	if body.Items == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("items", "body"))
+	}
+	if len(body.Items) < 1 {
+		err = goa.MergeErrors(err, goa.InvalidLengthError("body.items", body.Items, len(body.Items), 1, true))
+	}
+	if len(body.Items) > 100 {
+		err = goa.MergeErrors(err, goa.InvalidLengthError("body.items", body.Items, len(body.Items), 100, false))
+	}
+	for _, e := range body.Items {
+		if e != nil {
+			if err2 := ValidateCacheBatchGetItemRequestBody(e); err2 != nil {
+				err = goa.MergeErrors(err, err2)
+			}
+		}
+	}
+	return
+}
+
+// ValidateBatchSetRequestBody runs the validations defined on
+// BatchSetRequestBody
+func ValidateBatchSetRequestBody(body *BatchSetRequestBody) (err error) {
+	if body.Items == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("items", "body"))
+	}
+	if len(body.Items) < 1 {
+		err = goa.MergeErrors(err, goa.InvalidLengthError("body.items", body.Items, len(body.Items), 1, true))
+	}
+	if len(body.Items) > 100 {
+		err = goa.MergeErrors(err, goa.InvalidLengthError("body.items", body.Items, len(body.Items), 100, false))
+	}
+	for _, e := range body.Items {
+		if e != nil {
+			if err2 := ValidateCacheBatchSetItemRequestBody(e); err2 != nil {
+				err = goa.MergeErrors(err, err2)
+			}
+		}
+	}
+	return
+}
+
+// ValidateCacheBatchGetItemRequestBody runs the validations defined on
+// CacheBatchGetItemRequestBody
+func ValidateCacheBatchGetItemRequestBody(body *CacheBatchGetItemRequestBody) (err error) {
+	if body.Key == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("key", "body"))
+	}
+	return
+}
+
+// ValidateCacheBatchSetItemRequestBody runs the validations defined on
+// CacheBatchSetItemRequestBody
+func ValidateCacheBatchSetItemRequestBody(body *CacheBatchSetItemRequestBody) (err error) {
+	if body.Key == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("key", "body"))
+	}
+	if body.Data == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("data", "body"))
+	}
+	if body.TTL != nil {
+		if *body.TTL < 0 {
+			err = goa.MergeErrors(err, goa.InvalidRangeError("body.ttl", *body.TTL, 0, true))
+		}
+	}
+	return
+}
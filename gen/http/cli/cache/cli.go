@@ -23,14 +23,14 @@ import (
 //
 //	command (subcommand1|subcommand2|...)
 func UsageCommands() string {
-	return `cache (get|set|set-external)
+	return `cache (get|set|set-external|patch|delete|meta|keys|delete-namespace|job|delete-tag|batch-get|batch-set)
 health (liveness|readiness)
 `
 }
 
 // UsageExamples produces an example of a valid invocation of the CLI tool.
 func UsageExamples() string {
-	return os.Args[0] + ` cache get --key "Iusto consequatur voluptatem eligendi et eligendi." --namespace "Optio natus." --scope "Ratione quasi perspiciatis qui." --strategy "Animi non alias occaecati esse."` + "\n" +
+	return os.Args[0] + ` cache get --key "Deserunt praesentium." --namespace "Nobis corrupti aut." --scope "Ipsa aut nulla deserunt nam beatae." --strategy "deep" --arrays "concat" --scope-priority "Et mollitia facilis sunt." --if-none-match "Id omnis voluptatum debitis." --touch 445111548950541181 --fields "Deleniti non nobis quas aut voluptas voluptatem."` + "\n" +
 		os.Args[0] + ` health liveness` + "\n" +
 		""
 }
@@ -47,11 +47,16 @@ func ParseEndpoint(
 	var (
 		cacheFlags = flag.NewFlagSet("cache", flag.ContinueOnError)
 
-		cacheGetFlags         = flag.NewFlagSet("get", flag.ExitOnError)
-		cacheGetKeyFlag       = cacheGetFlags.String("key", "REQUIRED", "")
-		cacheGetNamespaceFlag = cacheGetFlags.String("namespace", "", "")
-		cacheGetScopeFlag     = cacheGetFlags.String("scope", "", "")
-		cacheGetStrategyFlag  = cacheGetFlags.String("strategy", "", "")
+		cacheGetFlags             = flag.NewFlagSet("get", flag.ExitOnError)
+		cacheGetKeyFlag           = cacheGetFlags.String("key", "REQUIRED", "")
+		cacheGetNamespaceFlag     = cacheGetFlags.String("namespace", "", "")
+		cacheGetScopeFlag         = cacheGetFlags.String("scope", "", "")
+		cacheGetStrategyFlag      = cacheGetFlags.String("strategy", "", "")
+		cacheGetArraysFlag        = cacheGetFlags.String("arrays", "", "")
+		cacheGetScopePriorityFlag = cacheGetFlags.String("scope-priority", "", "")
+		cacheGetIfNoneMatchFlag   = cacheGetFlags.String("if-none-match", "", "")
+		cacheGetTouchFlag         = cacheGetFlags.String("touch", "", "")
+		cacheGetFieldsFlag        = cacheGetFlags.String("fields", "", "")
 
 		cacheSetFlags         = flag.NewFlagSet("set", flag.ExitOnError)
 		cacheSetBodyFlag      = cacheSetFlags.String("body", "REQUIRED", "")
@@ -59,6 +64,9 @@ func ParseEndpoint(
 		cacheSetNamespaceFlag = cacheSetFlags.String("namespace", "", "")
 		cacheSetScopeFlag     = cacheSetFlags.String("scope", "", "")
 		cacheSetTTLFlag       = cacheSetFlags.String("ttl", "", "")
+		cacheSetConditionFlag = cacheSetFlags.String("condition", "", "")
+		cacheSetIfMatchFlag   = cacheSetFlags.String("if-match", "", "")
+		cacheSetTagsFlag      = cacheSetFlags.String("tags", "", "")
 
 		cacheSetExternalFlags         = flag.NewFlagSet("set-external", flag.ExitOnError)
 		cacheSetExternalBodyFlag      = cacheSetExternalFlags.String("body", "REQUIRED", "")
@@ -66,6 +74,49 @@ func ParseEndpoint(
 		cacheSetExternalNamespaceFlag = cacheSetExternalFlags.String("namespace", "", "")
 		cacheSetExternalScopeFlag     = cacheSetExternalFlags.String("scope", "", "")
 		cacheSetExternalTTLFlag       = cacheSetExternalFlags.String("ttl", "", "")
+		cacheSetExternalConditionFlag = cacheSetExternalFlags.String("condition", "", "")
+		cacheSetExternalIfMatchFlag   = cacheSetExternalFlags.String("if-match", "", "")
+		cacheSetExternalTagsFlag      = cacheSetExternalFlags.String("tags", "", "")
+
+		cachePatchFlags           = flag.NewFlagSet("patch", flag.ExitOnError)
+		cachePatchBodyFlag        = cachePatchFlags.String("body", "REQUIRED", "")
+		cachePatchKeyFlag         = cachePatchFlags.String("key", "REQUIRED", "")
+		cachePatchNamespaceFlag   = cachePatchFlags.String("namespace", "", "")
+		cachePatchScopeFlag       = cachePatchFlags.String("scope", "", "")
+		cachePatchContentTypeFlag = cachePatchFlags.String("content-type", "", "")
+
+		cacheDeleteFlags         = flag.NewFlagSet("delete", flag.ExitOnError)
+		cacheDeleteKeyFlag       = cacheDeleteFlags.String("key", "REQUIRED", "")
+		cacheDeleteNamespaceFlag = cacheDeleteFlags.String("namespace", "", "")
+		cacheDeleteScopeFlag     = cacheDeleteFlags.String("scope", "", "")
+
+		cacheMetaFlags         = flag.NewFlagSet("meta", flag.ExitOnError)
+		cacheMetaKeyFlag       = cacheMetaFlags.String("key", "REQUIRED", "")
+		cacheMetaNamespaceFlag = cacheMetaFlags.String("namespace", "", "")
+		cacheMetaScopeFlag     = cacheMetaFlags.String("scope", "", "")
+
+		cacheKeysFlags         = flag.NewFlagSet("keys", flag.ExitOnError)
+		cacheKeysNamespaceFlag = cacheKeysFlags.String("namespace", "REQUIRED", "")
+		cacheKeysScopeFlag     = cacheKeysFlags.String("scope", "", "")
+		cacheKeysPrefixFlag    = cacheKeysFlags.String("prefix", "", "")
+		cacheKeysCursorFlag    = cacheKeysFlags.String("cursor", "", "")
+		cacheKeysLimitFlag     = cacheKeysFlags.String("limit", "100", "")
+
+		cacheDeleteNamespaceFlags         = flag.NewFlagSet("delete-namespace", flag.ExitOnError)
+		cacheDeleteNamespaceNamespaceFlag = cacheDeleteNamespaceFlags.String("namespace", "REQUIRED", "Namespace of the deleted entries.")
+		cacheDeleteNamespaceScopeFlag     = cacheDeleteNamespaceFlags.String("scope", "", "")
+
+		cacheJobFlags  = flag.NewFlagSet("job", flag.ExitOnError)
+		cacheJobIDFlag = cacheJobFlags.String("id", "REQUIRED", "Job ID.")
+
+		cacheDeleteTagFlags   = flag.NewFlagSet("delete-tag", flag.ExitOnError)
+		cacheDeleteTagTagFlag = cacheDeleteTagFlags.String("tag", "REQUIRED", "Tag of the deleted entries.")
+
+		cacheBatchGetFlags    = flag.NewFlagSet("batch-get", flag.ExitOnError)
+		cacheBatchGetBodyFlag = cacheBatchGetFlags.String("body", "REQUIRED", "")
+
+		cacheBatchSetFlags    = flag.NewFlagSet("batch-set", flag.ExitOnError)
+		cacheBatchSetBodyFlag = cacheBatchSetFlags.String("body", "REQUIRED", "")
 
 		healthFlags = flag.NewFlagSet("health", flag.ContinueOnError)
 
@@ -77,6 +128,15 @@ func ParseEndpoint(
 	cacheGetFlags.Usage = cacheGetUsage
 	cacheSetFlags.Usage = cacheSetUsage
 	cacheSetExternalFlags.Usage = cacheSetExternalUsage
+	cachePatchFlags.Usage = cachePatchUsage
+	cacheDeleteFlags.Usage = cacheDeleteUsage
+	cacheMetaFlags.Usage = cacheMetaUsage
+	cacheKeysFlags.Usage = cacheKeysUsage
+	cacheDeleteNamespaceFlags.Usage = cacheDeleteNamespaceUsage
+	cacheJobFlags.Usage = cacheJobUsage
+	cacheDeleteTagFlags.Usage = cacheDeleteTagUsage
+	cacheBatchGetFlags.Usage = cacheBatchGetUsage
+	cacheBatchSetFlags.Usage = cacheBatchSetUsage
 
 	healthFlags.Usage = healthUsage
 	healthLivenessFlags.Usage = healthLivenessUsage
@@ -127,6 +187,33 @@ func ParseEndpoint(
 			case "set-external":
 				epf = cacheSetExternalFlags
 
+			case "patch":
+				epf = cachePatchFlags
+
+			case "delete":
+				epf = cacheDeleteFlags
+
+			case "meta":
+				epf = cacheMetaFlags
+
+			case "keys":
+				epf = cacheKeysFlags
+
+			case "delete-namespace":
+				epf = cacheDeleteNamespaceFlags
+
+			case "job":
+				epf = cacheJobFlags
+
+			case "delete-tag":
+				epf = cacheDeleteTagFlags
+
+			case "batch-get":
+				epf = cacheBatchGetFlags
+
+			case "batch-set":
+				epf = cacheBatchSetFlags
+
 			}
 
 		case "health":
@@ -164,13 +251,40 @@ func ParseEndpoint(
 			switch epn {
 			case "get":
 				endpoint = c.Get()
-				data, err = cachec.BuildGetPayload(*cacheGetKeyFlag, *cacheGetNamespaceFlag, *cacheGetScopeFlag, *cacheGetStrategyFlag)
+				data, err = cachec.BuildGetPayload(*cacheGetKeyFlag, *cacheGetNamespaceFlag, *cacheGetScopeFlag, *cacheGetStrategyFlag, *cacheGetArraysFlag, *cacheGetScopePriorityFlag, *cacheGetIfNoneMatchFlag, *cacheGetTouchFlag, *cacheGetFieldsFlag)
 			case "set":
 				endpoint = c.Set()
-				data, err = cachec.BuildSetPayload(*cacheSetBodyFlag, *cacheSetKeyFlag, *cacheSetNamespaceFlag, *cacheSetScopeFlag, *cacheSetTTLFlag)
+				data, err = cachec.BuildSetPayload(*cacheSetBodyFlag, *cacheSetKeyFlag, *cacheSetNamespaceFlag, *cacheSetScopeFlag, *cacheSetTTLFlag, *cacheSetConditionFlag, *cacheSetIfMatchFlag, *cacheSetTagsFlag)
 			case "set-external":
 				endpoint = c.SetExternal()
-				data, err = cachec.BuildSetExternalPayload(*cacheSetExternalBodyFlag, *cacheSetExternalKeyFlag, *cacheSetExternalNamespaceFlag, *cacheSetExternalScopeFlag, *cacheSetExternalTTLFlag)
+				data, err = cachec.BuildSetExternalPayload(*cacheSetExternalBodyFlag, *cacheSetExternalKeyFlag, *cacheSetExternalNamespaceFlag, *cacheSetExternalScopeFlag, *cacheSetExternalTTLFlag, *cacheSetExternalConditionFlag, *cacheSetExternalIfMatchFlag, *cacheSetExternalTagsFlag)
+			case "patch":
+				endpoint = c.Patch()
+				data, err = cachec.BuildPatchPayload(*cachePatchBodyFlag, *cachePatchKeyFlag, *cachePatchNamespaceFlag, *cachePatchScopeFlag, *cachePatchContentTypeFlag)
+			case "delete":
+				endpoint = c.Delete()
+				data, err = cachec.BuildDeletePayload(*cacheDeleteKeyFlag, *cacheDeleteNamespaceFlag, *cacheDeleteScopeFlag)
+			case "meta":
+				endpoint = c.Meta()
+				data, err = cachec.BuildMetaPayload(*cacheMetaKeyFlag, *cacheMetaNamespaceFlag, *cacheMetaScopeFlag)
+			case "keys":
+				endpoint = c.Keys()
+				data, err = cachec.BuildKeysPayload(*cacheKeysNamespaceFlag, *cacheKeysScopeFlag, *cacheKeysPrefixFlag, *cacheKeysCursorFlag, *cacheKeysLimitFlag)
+			case "delete-namespace":
+				endpoint = c.DeleteNamespace()
+				data, err = cachec.BuildDeleteNamespacePayload(*cacheDeleteNamespaceNamespaceFlag, *cacheDeleteNamespaceScopeFlag)
+			case "job":
+				endpoint = c.Job()
+				data, err = cachec.BuildJobPayload(*cacheJobIDFlag)
+			case "delete-tag":
+				endpoint = c.DeleteTag()
+				data, err = cachec.BuildDeleteTagPayload(*cacheDeleteTagTagFlag)
+			case "batch-get":
+				endpoint = c.BatchGet()
+				data, err = cachec.BuildBatchGetPayload(*cacheBatchGetBodyFlag)
+			case "batch-set":
+				endpoint = c.BatchSet()
+				data, err = cachec.BuildBatchSetPayload(*cacheBatchSetBodyFlag)
 			}
 		case "health":
 			c := healthc.NewClient(scheme, host, doer, enc, dec, restore)
@@ -199,27 +313,41 @@ COMMAND:
     get: Get JSON value from the cache.
     set: Set a JSON value in the cache.
     set-external: Set an external JSON value in the cache and provide an event for the input.
+    patch: Update a JSON value in the cache with a JSON merge patch (RFC 7386) or a JSON patch (RFC 6902). The TTL of the entry is kept.
+    delete: Delete a value from the cache.
+    meta: Get metadata of a cache entry without its value.
+    keys: List the keys stored under a namespace. Keys are iterated with SCAN and returned page by page.
+    delete-namespace: Delete all entries of a namespace, or of a namespace and scope, in a background job.
+    job: Get the status of a background job.
+    delete-tag: Delete all entries which have been set with the tag.
+    batch-get: Get multiple JSON values from the cache. Each item is looked up separately and has its own status.
+    batch-set: Set multiple JSON values in the cache. Each item is stored separately and has its own status.
 
 Additional help:
     %[1]s cache COMMAND --help
 `, os.Args[0])
 }
 func cacheGetUsage() {
-	fmt.Fprintf(os.Stderr, `%[1]s [flags] cache get -key STRING -namespace STRING -scope STRING -strategy STRING
+	fmt.Fprintf(os.Stderr, `%[1]s [flags] cache get -key STRING -namespace STRING -scope STRING -strategy STRING -arrays STRING -scope-priority STRING -if-none-match STRING -touch INT -fields STRING
 
 Get JSON value from the cache.
     -key STRING: 
     -namespace STRING: 
     -scope STRING: 
     -strategy STRING: 
+    -arrays STRING: 
+    -scope-priority STRING: 
+    -if-none-match STRING: 
+    -touch INT: 
+    -fields STRING: 
 
 Example:
-    %[1]s cache get --key "Iusto consequatur voluptatem eligendi et eligendi." --namespace "Optio natus." --scope "Ratione quasi perspiciatis qui." --strategy "Animi non alias occaecati esse."
+    %[1]s cache get --key "Deserunt praesentium." --namespace "Nobis corrupti aut." --scope "Ipsa aut nulla deserunt nam beatae." --strategy "deep" --arrays "concat" --scope-priority "Et mollitia facilis sunt." --if-none-match "Id omnis voluptatum debitis." --touch 445111548950541181 --fields "Deleniti non nobis quas aut voluptas voluptatem."
 `, os.Args[0])
 }
 
 func cacheSetUsage() {
-	fmt.Fprintf(os.Stderr, `%[1]s [flags] cache set -body JSON -key STRING -namespace STRING -scope STRING -ttl INT
+	fmt.Fprintf(os.Stderr, `%[1]s [flags] cache set -body JSON -key STRING -namespace STRING -scope STRING -ttl INT -condition STRING -if-match STRING -tags STRING
 
 Set a JSON value in the cache.
     -body JSON: 
@@ -227,14 +355,17 @@ Set a JSON value in the cache.
     -namespace STRING: 
     -scope STRING: 
     -ttl INT: 
+    -condition STRING: 
+    -if-match STRING: 
+    -tags STRING: 
 
 Example:
-    %[1]s cache set --body "Enim vel." --key "Ut in." --namespace "Ab dolores distinctio quis." --scope "Optio aliquam error nam." --ttl 2227603043401673122
+    %[1]s cache set --body "Illo necessitatibus placeat molestiae." --key "Et sed nihil quod exercitationem distinctio." --namespace "Et deserunt numquam unde." --scope "Perferendis maiores." --ttl 1864122175246310429 --condition "nx" --if-match "Vero suscipit ipsum sed." --tags "Veritatis sit in recusandae eum."
 `, os.Args[0])
 }
 
 func cacheSetExternalUsage() {
-	fmt.Fprintf(os.Stderr, `%[1]s [flags] cache set-external -body JSON -key STRING -namespace STRING -scope STRING -ttl INT
+	fmt.Fprintf(os.Stderr, `%[1]s [flags] cache set-external -body JSON -key STRING -namespace STRING -scope STRING -ttl INT -condition STRING -if-match STRING -tags STRING
 
 Set an external JSON value in the cache and provide an event for the input.
     -body JSON: 
@@ -242,9 +373,159 @@ Set an external JSON value in the cache and provide an event for the input.
     -namespace STRING: 
     -scope STRING: 
     -ttl INT: 
+    -condition STRING: 
+    -if-match STRING: 
+    -tags STRING: 
+
+Example:
+    %[1]s cache set-external --body "Commodi sit aliquam fugit voluptatem omnis." --key "Neque dolorum et sapiente dicta." --namespace "Quisquam dolorum voluptas recusandae." --scope "Rerum sapiente." --ttl 759092065871787114 --condition "nx" --if-match "Voluptatem voluptatibus maxime accusamus odio laboriosam." --tags "Necessitatibus laboriosam et ratione consequatur et nihil."
+`, os.Args[0])
+}
+
+func cachePatchUsage() {
+	fmt.Fprintf(os.Stderr, `%[1]s [flags] cache patch -body JSON -key STRING -namespace STRING -scope STRING -content-type STRING
+
+Update a JSON value in the cache with a JSON merge patch (RFC 7386) or a JSON patch (RFC 6902). The TTL of the entry is kept.
+    -body JSON: 
+    -key STRING: 
+    -namespace STRING: 
+    -scope STRING: 
+    -content-type STRING: 
+
+Example:
+    %[1]s cache patch --body "Et veniam enim doloribus facere." --key "Incidunt illum quisquam nisi autem." --namespace "Est iusto necessitatibus perspiciatis aut." --scope "Delectus incidunt sed et ad." --content-type "Quisquam voluptas deleniti."
+`, os.Args[0])
+}
+
+func cacheDeleteUsage() {
+	fmt.Fprintf(os.Stderr, `%[1]s [flags] cache delete -key STRING -namespace STRING -scope STRING
+
+Delete a value from the cache.
+    -key STRING: 
+    -namespace STRING: 
+    -scope STRING: 
+
+Example:
+    %[1]s cache delete --key "Sed facilis eum." --namespace "Quasi perspiciatis consectetur." --scope "Perferendis amet."
+`, os.Args[0])
+}
+
+func cacheMetaUsage() {
+	fmt.Fprintf(os.Stderr, `%[1]s [flags] cache meta -key STRING -namespace STRING -scope STRING
+
+Get metadata of a cache entry without its value.
+    -key STRING: 
+    -namespace STRING: 
+    -scope STRING: 
+
+Example:
+    %[1]s cache meta --key "Amet maiores accusantium quae expedita." --namespace "Et quae harum tempore ex consequatur." --scope "Quae cum nihil sunt nostrum quia iure."
+`, os.Args[0])
+}
+
+func cacheKeysUsage() {
+	fmt.Fprintf(os.Stderr, `%[1]s [flags] cache keys -namespace STRING -scope STRING -prefix STRING -cursor STRING -limit INT
+
+List the keys stored under a namespace. Keys are iterated with SCAN and returned page by page.
+    -namespace STRING: 
+    -scope STRING: 
+    -prefix STRING: 
+    -cursor STRING: 
+    -limit INT: 
+
+Example:
+    %[1]s cache keys --namespace "Login" --scope "administration" --prefix "did:web:" --cursor "Voluptatibus rerum nisi dignissimos rerum ut ut." --limit 100
+`, os.Args[0])
+}
+
+func cacheDeleteNamespaceUsage() {
+	fmt.Fprintf(os.Stderr, `%[1]s [flags] cache delete-namespace -namespace STRING -scope STRING
+
+Delete all entries of a namespace, or of a namespace and scope, in a background job.
+    -namespace STRING: Namespace of the deleted entries.
+    -scope STRING: 
+
+Example:
+    %[1]s cache delete-namespace --namespace "Login" --scope "administration"
+`, os.Args[0])
+}
+
+func cacheJobUsage() {
+	fmt.Fprintf(os.Stderr, `%[1]s [flags] cache job -id STRING
+
+Get the status of a background job.
+    -id STRING: Job ID.
+
+Example:
+    %[1]s cache job --id "Numquam et autem voluptas."
+`, os.Args[0])
+}
+
+func cacheDeleteTagUsage() {
+	fmt.Fprintf(os.Stderr, `%[1]s [flags] cache delete-tag -tag STRING
+
+Delete all entries which have been set with the tag.
+    -tag STRING: Tag of the deleted entries.
+
+Example:
+    %[1]s cache delete-tag --tag "schema:v2"
+`, os.Args[0])
+}
+
+func cacheBatchGetUsage() {
+	fmt.Fprintf(os.Stderr, `%[1]s [flags] cache batch-get -body JSON
+
+Get multiple JSON values from the cache. Each item is looked up separately and has its own status.
+    -body JSON: 
+
+Example:
+    %[1]s cache batch-get --body '{
+      "items": [
+         {
+            "key": "Nobis praesentium.",
+            "namespace": "Fuga beatae molestiae voluptates facere aspernatur impedit.",
+            "scope": "Eius id earum repellat aliquam quod."
+         },
+         {
+            "key": "Nobis praesentium.",
+            "namespace": "Fuga beatae molestiae voluptates facere aspernatur impedit.",
+            "scope": "Eius id earum repellat aliquam quod."
+         },
+         {
+            "key": "Nobis praesentium.",
+            "namespace": "Fuga beatae molestiae voluptates facere aspernatur impedit.",
+            "scope": "Eius id earum repellat aliquam quod."
+         }
+      ]
+   }'
+`, os.Args[0])
+}
+
+func cacheBatchSetUsage() {
+	fmt.Fprintf(os.Stderr, `%[1]s [flags] cache batch-set -body JSON
+
+Set multiple JSON values in the cache. Each item is stored separately and has its own status.
+    -body JSON: 
 
 Example:
-    %[1]s cache set-external --body "Sint ipsa fugiat et id rem." --key "Molestiae minima." --namespace "Quia dolores rem." --scope "Est illum." --ttl 6207033275224297400
+    %[1]s cache batch-set --body '{
+      "items": [
+         {
+            "data": "Dolorem rerum pariatur omnis.",
+            "key": "Praesentium est maiores.",
+            "namespace": "Consectetur nulla quia reprehenderit dolores enim recusandae.",
+            "scope": "Deserunt nostrum molestiae.",
+            "ttl": 423180062880815134
+         },
+         {
+            "data": "Dolorem rerum pariatur omnis.",
+            "key": "Praesentium est maiores.",
+            "namespace": "Consectetur nulla quia reprehenderit dolores enim recusandae.",
+            "scope": "Deserunt nostrum molestiae.",
+            "ttl": 423180062880815134
+         }
+      ]
+   }'
 `, os.Args[0])
 }
 
@@ -90,6 +90,9 @@ func (c *Client) BuildReadinessRequest(ctx context.Context, v any) (*http.Reques
 // DecodeReadinessResponse returns a decoder for responses returned by the
 // health Readiness endpoint. restoreBody controls whether the response body
 // should be restored after having been read.
+// DecodeReadinessResponse may return the following errors:
+//   - "not_ready" (type *health.HealthResponse): http.StatusServiceUnavailable
+//   - error: internal error
 func DecodeReadinessResponse(decoder func(*http.Response) goahttp.Decoder, restoreBody bool) func(*http.Response) (any, error) {
 	return func(resp *http.Response) (any, error) {
 		if restoreBody {
@@ -120,6 +123,20 @@ func DecodeReadinessResponse(decoder func(*http.Response) goahttp.Decoder, resto
 			}
 			res := NewReadinessHealthResponseOK(&body)
 			return res, nil
+		case http.StatusServiceUnavailable:
+			var (
+				body ReadinessNotReadyResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("health", "Readiness", err)
+			}
+			err = ValidateReadinessNotReadyResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("health", "Readiness", err)
+			}
+			return nil, NewReadinessNotReady(&body)
 		default:
 			body, _ := io.ReadAll(resp.Body)
 			return nil, goahttp.ErrInvalidResponse("health", "Readiness", resp.StatusCode, string(body))
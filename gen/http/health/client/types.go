@@ -21,6 +21,8 @@ type LivenessResponseBody struct {
 	Status *string `form:"status,omitempty" json:"status,omitempty" xml:"status,omitempty"`
 	// Service runtime version.
 	Version *string `form:"version,omitempty" json:"version,omitempty" xml:"version,omitempty"`
+	// Status of the service dependencies.
+	Checks map[string]string `form:"checks,omitempty" json:"checks,omitempty" xml:"checks,omitempty"`
 }
 
 // ReadinessResponseBody is the type of the "health" service "Readiness"
@@ -32,6 +34,21 @@ type ReadinessResponseBody struct {
 	Status *string `form:"status,omitempty" json:"status,omitempty" xml:"status,omitempty"`
 	// Service runtime version.
 	Version *string `form:"version,omitempty" json:"version,omitempty" xml:"version,omitempty"`
+	// Status of the service dependencies.
+	Checks map[string]string `form:"checks,omitempty" json:"checks,omitempty" xml:"checks,omitempty"`
+}
+
+// ReadinessNotReadyResponseBody is the type of the "health" service
+// "Readiness" endpoint HTTP response body for the "not_ready" error.
+type ReadinessNotReadyResponseBody struct {
+	// Service name.
+	Service *string `form:"service,omitempty" json:"service,omitempty" xml:"service,omitempty"`
+	// Status message.
+	Status *string `form:"status,omitempty" json:"status,omitempty" xml:"status,omitempty"`
+	// Service runtime version.
+	Version *string `form:"version,omitempty" json:"version,omitempty" xml:"version,omitempty"`
+	// Status of the service dependencies.
+	Checks map[string]string `form:"checks,omitempty" json:"checks,omitempty" xml:"checks,omitempty"`
 }
 
 // NewLivenessHealthResponseOK builds a "health" service "Liveness" endpoint
@@ -42,6 +59,14 @@ func NewLivenessHealthResponseOK(body *LivenessResponseBody) *health.HealthRespo
 		Status:  *body.Status,
 		Version: *body.Version,
 	}
+	if body.Checks != nil {
+		v.Checks = make(map[string]string, len(body.Checks))
+		for key, val := range body.Checks {
+			tk := key
+			tv := val
+			v.Checks[tk] = tv
+		}
+	}
 
 	return v
 }
@@ -54,6 +79,34 @@ func NewReadinessHealthResponseOK(body *ReadinessResponseBody) *health.HealthRes
 		Status:  *body.Status,
 		Version: *body.Version,
 	}
+	if body.Checks != nil {
+		v.Checks = make(map[string]string, len(body.Checks))
+		for key, val := range body.Checks {
+			tk := key
+			tv := val
+			v.Checks[tk] = tv
+		}
+	}
+
+	return v
+}
+
+// NewReadinessNotReady builds a health service Readiness endpoint not_ready
+// error.
+func NewReadinessNotReady(body *ReadinessNotReadyResponseBody) *health.HealthResponse {
+	v := &health.HealthResponse{
+		Service: *body.Service,
+		Status:  *body.Status,
+		Version: *body.Version,
+	}
+	if body.Checks != nil {
+		v.Checks = make(map[string]string, len(body.Checks))
+		for key, val := range body.Checks {
+			tk := key
+			tv := val
+			v.Checks[tk] = tv
+		}
+	}
 
 	return v
 }
@@ -87,3 +140,18 @@ func ValidateReadinessResponseBody(body *ReadinessResponseBody) (err error) {
 	}
 	return
 }
+
+// ValidateReadinessNotReadyResponseBody runs the validations defined on
+// Readiness_not_ready_Response_Body
+func ValidateReadinessNotReadyResponseBody(body *ReadinessNotReadyResponseBody) (err error) {
+	if body.Service == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("service", "body"))
+	}
+	if body.Status == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("status", "body"))
+	}
+	if body.Version == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("version", "body"))
+	}
+	return
+}
@@ -9,10 +9,12 @@ package server
 
 import (
 	"context"
+	"errors"
 	"net/http"
 
 	health "github.com/eclipse-xfsc/redis-cache-service/gen/health"
 	goahttp "goa.design/goa/v3/http"
+	goa "goa.design/goa/v3/pkg"
 )
 
 // EncodeLivenessResponse returns an encoder for responses returned by the
@@ -38,3 +40,32 @@ func EncodeReadinessResponse(encoder func(context.Context, http.ResponseWriter)
 		return enc.Encode(body)
 	}
 }
+
+// EncodeReadinessError returns an encoder for errors returned by the Readiness
+// health endpoint.
+func EncodeReadinessError(encoder func(context.Context, http.ResponseWriter) goahttp.Encoder, formatter func(ctx context.Context, err error) goahttp.Statuser) func(context.Context, http.ResponseWriter, error) error {
+	encodeError := goahttp.ErrorEncoder(encoder, formatter)
+	return func(ctx context.Context, w http.ResponseWriter, v error) error {
+		var en goa.GoaErrorNamer
+		if !errors.As(v, &en) {
+			return encodeError(ctx, w, v)
+		}
+		switch en.GoaErrorName() {
+		case "not_ready":
+			var res *health.HealthResponse
+			errors.As(v, &res)
+			enc := encoder(ctx, w)
+			var body any
+			if formatter != nil {
+				body = formatter(ctx, res)
+			} else {
+				body = NewReadinessNotReadyResponseBody(res)
+			}
+			w.Header().Set("goa-error", res.GoaErrorName())
+			w.WriteHeader(http.StatusServiceUnavailable)
+			return enc.Encode(body)
+		default:
+			return encodeError(ctx, w, v)
+		}
+	}
+}
@@ -149,7 +149,7 @@ func NewReadinessHandler(
 ) http.Handler {
 	var (
 		encodeResponse = EncodeReadinessResponse(encoder)
-		encodeError    = goahttp.ErrorEncoder(encoder, formatter)
+		encodeError    = EncodeReadinessError(encoder, formatter)
 	)
 	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		ctx := context.WithValue(r.Context(), goahttp.AcceptTypeKey, r.Header.Get("Accept"))
@@ -20,6 +20,8 @@ type LivenessResponseBody struct {
 	Status string `form:"status" json:"status" xml:"status"`
 	// Service runtime version.
 	Version string `form:"version" json:"version" xml:"version"`
+	// Status of the service dependencies.
+	Checks map[string]string `form:"checks,omitempty" json:"checks,omitempty" xml:"checks,omitempty"`
 }
 
 // ReadinessResponseBody is the type of the "health" service "Readiness"
@@ -31,6 +33,21 @@ type ReadinessResponseBody struct {
 	Status string `form:"status" json:"status" xml:"status"`
 	// Service runtime version.
 	Version string `form:"version" json:"version" xml:"version"`
+	// Status of the service dependencies.
+	Checks map[string]string `form:"checks,omitempty" json:"checks,omitempty" xml:"checks,omitempty"`
+}
+
+// ReadinessNotReadyResponseBody is the type of the "health" service
+// "Readiness" endpoint HTTP response body for the "not_ready" error.
+type ReadinessNotReadyResponseBody struct {
+	// Service name.
+	Service string `form:"service" json:"service" xml:"service"`
+	// Status message.
+	Status string `form:"status" json:"status" xml:"status"`
+	// Service runtime version.
+	Version string `form:"version" json:"version" xml:"version"`
+	// Status of the service dependencies.
+	Checks map[string]string `form:"checks,omitempty" json:"checks,omitempty" xml:"checks,omitempty"`
 }
 
 // NewLivenessResponseBody builds the HTTP response body from the result of the
@@ -41,6 +58,14 @@ func NewLivenessResponseBody(res *health.HealthResponse) *LivenessResponseBody {
 		Status:  res.Status,
 		Version: res.Version,
 	}
+	if res.Checks != nil {
+		body.Checks = make(map[string]string, len(res.Checks))
+		for key, val := range res.Checks {
+			tk := key
+			tv := val
+			body.Checks[tk] = tv
+		}
+	}
 	return body
 }
 
@@ -52,5 +77,32 @@ func NewReadinessResponseBody(res *health.HealthResponse) *ReadinessResponseBody
 		Status:  res.Status,
 		Version: res.Version,
 	}
+	if res.Checks != nil {
+		body.Checks = make(map[string]string, len(res.Checks))
+		for key, val := range res.Checks {
+			tk := key
+			tv := val
+			body.Checks[tk] = tv
+		}
+	}
+	return body
+}
+
+// NewReadinessNotReadyResponseBody builds the HTTP response body from the
+// result of the "Readiness" endpoint of the "health" service.
+func NewReadinessNotReadyResponseBody(res *health.HealthResponse) *ReadinessNotReadyResponseBody {
+	body := &ReadinessNotReadyResponseBody{
+		Service: res.Service,
+		Status:  res.Status,
+		Version: res.Version,
+	}
+	if res.Checks != nil {
+		body.Checks = make(map[string]string, len(res.Checks))
+		for key, val := range res.Checks {
+			tk := key
+			tv := val
+			body.Checks[tk] = tv
+		}
+	}
 	return body
 }
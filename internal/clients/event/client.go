@@ -2,78 +2,220 @@ package event
 
 import (
 	"context"
+	"encoding/json"
+	"errors"
 	"fmt"
-
+	"strings"
 	"time"
 
-	"github.com/cloudevents/sdk-go/protocol/nats/v2"
 	cloudevents "github.com/cloudevents/sdk-go/v2"
 	"github.com/cloudevents/sdk-go/v2/event"
+	"github.com/cloudevents/sdk-go/v2/extensions"
+	"github.com/cloudevents/sdk-go/v2/protocol"
 	"github.com/google/uuid"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/propagation"
+	"go.opentelemetry.io/otel/trace"
+
+	"github.com/eclipse-xfsc/redis-cache-service/internal/metrics"
+)
+
+// Event types which share the same envelope and subject.
+const (
+	setEventType     = "cache_set_event"
+	deleteEventType  = "cache_delete_event"
+	expiredEventType = "cache_expired_event"
+)
+
+const tracerName = "github.com/eclipse-xfsc/redis-cache-service/internal/clients/event"
+
+// Transport is the protocol which is used to publish events.
+type Transport string
+
+const (
+	// TransportNATS publishes events to NATS or NATS JetStream.
+	TransportNATS Transport = "nats"
+	// TransportHTTP posts events in the CloudEvents HTTP binary mode to a webhook.
+	TransportHTTP Transport = "http"
+	// TransportKafka produces events to a Kafka topic.
+	TransportKafka Transport = "kafka"
+	// TransportNone disables the events.
+	TransportNone Transport = "none"
 )
 
-const eventType = "cache_set_event"
+// ParseTransport returns the transport of the configured name.
+func ParseTransport(name string) (Transport, error) {
+	switch t := Transport(strings.ToLower(name)); t {
+	case TransportNATS, TransportHTTP, TransportKafka, TransportNone:
+		return t, nil
+	default:
+		return "", fmt.Errorf("unknown events transport %q", name)
+	}
+}
+
+// ErrMalformedEvent is returned by Publish for events which cannot be decoded.
+var ErrMalformedEvent = errors.New("malformed event")
+
+// ErrDisabled is returned for events which must be published
+// if the events are disabled with TransportNone.
+var ErrDisabled = errors.New("events are disabled")
+
+// connection is the connection of a transport to the message broker.
+type connection interface {
+	Ping(ctx context.Context) error
+	Close(ctx context.Context) error
+}
 
 type Client struct {
-	sender *nats.Sender
-	events cloudevents.Client
+	transport Transport
+	conn      connection
+	events    cloudevents.Client
+
+	payloadModes map[string]PayloadMode
+	jetStream    *JetStreamConfig
+}
+
+func newClient(transport Transport, opts []Option) *Client {
+	c := &Client{transport: transport}
+	for _, opt := range opts {
+		opt(c)
+	}
+	return c
 }
 
-type Data struct {
-	Key string `json:"key"`
+// NewNone creates a client which publishes no events. Events of deleted and
+// expired entries are dropped, while set events fail with ErrDisabled.
+func NewNone(opts ...Option) *Client {
+	return newClient(TransportNone, opts)
 }
 
-func New(addr, subject string) (*Client, error) {
-	// create cloudevents nats sender
-	// other protocol implementations: https://github.com/cloudevents/sdk-go/tree/main/protocol
-	sender, err := nats.NewSender(addr, subject, nats.NatsOptions())
+// NewSetEvent returns the encoded cache_set_event for a stored external input.
+// ErrDisabled is returned without transport.
+func (c *Client) NewSetEvent(ctx context.Context, entry Entry) ([]byte, error) {
+	return c.encode(ctx, setEventType, entry)
+}
+
+// NewDeleteEvent returns the encoded cache_delete_event for an entry deleted
+// from the cache. ErrDisabled is returned without transport.
+func (c *Client) NewDeleteEvent(ctx context.Context, entry Entry) ([]byte, error) {
+	return c.encode(ctx, deleteEventType, entry)
+}
+
+func (c *Client) encode(ctx context.Context, eventType string, entry Entry) ([]byte, error) {
+	if c.transport == TransportNone {
+		return nil, ErrDisabled
+	}
+	e, err := newEvent(ctx, eventType, newData(entry, c.payloadMode(entry.Namespace)))
 	if err != nil {
 		return nil, err
 	}
+	return json.Marshal(e)
+}
 
-	// create cloudevents client
-	eventsClient, err := cloudevents.NewClient(sender)
-	if err != nil {
-		return nil, err
+// Publish publishes an event encoded by NewSetEvent or NewDeleteEvent. An error
+// wrapping ErrMalformedEvent is returned if the event cannot be decoded.
+func (c *Client) Publish(ctx context.Context, encoded []byte) error {
+	if c.transport == TransportNone {
+		return ErrDisabled
+	}
+	e := cloudevents.NewEvent()
+	if err := json.Unmarshal(encoded, &e); err != nil {
+		return fmt.Errorf("%w: %v", ErrMalformedEvent, err)
 	}
+	return c.publish(ctx, &e)
+}
 
-	return &Client{
-		sender: sender,
-		events: eventsClient,
-	}, nil
+// SendExpired publishes a cache_expired_event for an entry expired in the cache.
+func (c *Client) SendExpired(ctx context.Context, entry Entry) error {
+	return c.send(ctx, expiredEventType, entry)
 }
 
-func (c *Client) Send(ctx context.Context, key string) error {
-	e, err := newEvent(key)
+func (c *Client) send(ctx context.Context, eventType string, entry Entry) error {
+	if c.transport == TransportNone {
+		return nil
+	}
+	e, err := newEvent(ctx, eventType, newData(entry, c.payloadMode(entry.Namespace)))
 	if err != nil {
 		return err
 	}
+	return c.publish(ctx, e)
+}
+
+func (c *Client) publish(ctx context.Context, e *event.Event) error {
+	ctx, span := otel.Tracer(tracerName).Start(ctx, "events.Send "+e.Type(), trace.WithSpanKind(trace.SpanKindProducer))
+	defer span.End()
 
+	// a NACK, e.g. an HTTP error status of a webhook, is a failure as well
 	res := c.events.Send(ctx, *e)
-	if cloudevents.IsUndelivered(res) {
-		return fmt.Errorf("failed to send event for key: %s, reason: %v", key, res)
+	if !protocol.IsACK(res) {
+		err := fmt.Errorf("failed to send %s %s, reason: %v", e.Type(), e.ID(), res)
+		metrics.ObserveEventPublish(e.Type(), err)
+		span.RecordError(err)
+		span.SetStatus(codes.Error, "event not delivered")
+		return err
 	}
 
+	metrics.ObserveEventPublish(e.Type(), nil)
 	return nil
 }
 
+// Ping checks that the connection to the message broker is established.
+func (c *Client) Ping(ctx context.Context) error {
+	if c.conn == nil {
+		return nil
+	}
+	return c.conn.Ping(ctx)
+}
+
 func (c *Client) CLose(ctx context.Context) error {
-	return c.sender.Close(ctx)
+	if c.conn == nil {
+		return nil
+	}
+	return c.conn.Close(ctx)
+}
+
+// setSender creates the CloudEvents client which sends the events with sender.
+func (c *Client) setSender(sender protocol.Sender, conn connection) error {
+	events, err := cloudevents.NewClient(sender)
+	if err != nil {
+		return err
+	}
+	c.events = events
+	c.conn = conn
+	return nil
 }
 
-func newEvent(key string) (*event.Event, error) {
+func newEvent(ctx context.Context, eventType string, data *Data) (*event.Event, error) {
 	e := cloudevents.NewEvent()
 	e.SetID(uuid.NewString()) // required field
 	e.SetSource("cache")      // required field
 	e.SetType(eventType)      // required field
 	e.SetTime(time.Now())
+	e.SetDataSchema(dataSchema)
+	setTraceContext(ctx, &e)
 
-	err := e.SetData(event.ApplicationJSON, &Data{
-		Key: key,
-	})
+	err := e.SetData(event.ApplicationJSON, data)
 	if err != nil {
 		return nil, err
 	}
 
 	return &e, nil
 }
+
+// setTraceContext propagates the W3C trace context of ctx to the
+// event using the CloudEvents distributed tracing extension.
+func setTraceContext(ctx context.Context, e *event.Event) {
+	carrier := propagation.MapCarrier{}
+	otel.GetTextMapPropagator().Inject(ctx, carrier)
+
+	traceParent := carrier.Get("traceparent")
+	if traceParent == "" {
+		return
+	}
+
+	extensions.DistributedTracingExtension{
+		TraceParent: traceParent,
+		TraceState:  carrier.Get("tracestate"),
+	}.AddTracingAttributes(e)
+}
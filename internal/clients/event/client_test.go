@@ -0,0 +1,171 @@
+package event
+
+import (
+	"context"
+	"encoding/json"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/cloudevents/sdk-go/v2/extensions"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/propagation"
+	"go.opentelemetry.io/otel/trace"
+)
+
+func TestNewEvent_TraceContext(t *testing.T) {
+	otel.SetTextMapPropagator(propagation.TraceContext{})
+
+	t.Run("event without trace context", func(t *testing.T) {
+		e, err := newEvent(context.Background(), setEventType, &Data{Key: "key"})
+		assert.NoError(t, err)
+
+		_, ok := extensions.GetDistributedTracingExtension(*e)
+		assert.False(t, ok)
+	})
+
+	t.Run("event with trace context", func(t *testing.T) {
+		traceID, _ := trace.TraceIDFromHex("4bf92f3577b34da6a3ce929d0e0e4736")
+		spanID, _ := trace.SpanIDFromHex("00f067aa0ba902b7")
+		ctx := trace.ContextWithSpanContext(context.Background(), trace.NewSpanContext(trace.SpanContextConfig{
+			TraceID:    traceID,
+			SpanID:     spanID,
+			TraceFlags: trace.FlagsSampled,
+		}))
+
+		e, err := newEvent(ctx, setEventType, &Data{Key: "key"})
+		assert.NoError(t, err)
+
+		ext, ok := extensions.GetDistributedTracingExtension(*e)
+		assert.True(t, ok)
+		assert.Equal(t, "00-4bf92f3577b34da6a3ce929d0e0e4736-00f067aa0ba902b7-01", ext.TraceParent)
+	})
+}
+
+func TestNewEvent_Types(t *testing.T) {
+	for _, eventType := range []string{setEventType, deleteEventType, expiredEventType} {
+		t.Run(eventType, func(t *testing.T) {
+			e, err := newEvent(context.Background(), eventType, &Data{Key: "key", Namespace: "namespace", Scope: "scope"})
+			assert.NoError(t, err)
+			assert.NoError(t, e.Validate())
+
+			// all types share the same envelope
+			assert.Equal(t, eventType, e.Type())
+			assert.Equal(t, "cache", e.Source())
+			assert.Equal(t, "application/json", e.DataContentType())
+			assert.Equal(t, dataSchema, e.DataSchema())
+			assert.JSONEq(t, `{"key":"key","namespace":"namespace","scope":"scope"}`, string(e.Data()))
+		})
+	}
+}
+
+func TestParsePayloadModes(t *testing.T) {
+	modes, err := ParsePayloadModes(map[string]string{"Login": "Hash", "Profile": "value", "*": "key"})
+	assert.NoError(t, err)
+	assert.Equal(t, map[string]PayloadMode{"Login": PayloadHash, "Profile": PayloadValue, "*": PayloadKey}, modes)
+
+	_, err = ParsePayloadModes(map[string]string{"Login": "full"})
+	assert.EqualError(t, err, `unknown event payload mode "full" of namespace "Login"`)
+}
+
+func TestClient_PayloadMode(t *testing.T) {
+	c := &Client{}
+	assert.Equal(t, PayloadKey, c.payloadMode("Login"))
+
+	WithPayloadModes(map[string]PayloadMode{"Login": PayloadValue, "*": PayloadHash})(c)
+	assert.Equal(t, PayloadValue, c.payloadMode("Login"))
+	assert.Equal(t, PayloadHash, c.payloadMode("Profile"))
+	assert.Equal(t, PayloadHash, c.payloadMode(""))
+}
+
+func TestNewData(t *testing.T) {
+	entry := Entry{
+		Key:       "key",
+		Namespace: "namespace",
+		Scope:     "scope",
+		TTL:       90 * time.Second,
+		StoredAt:  time.Date(2024, 5, 1, 12, 0, 0, 0, time.FixedZone("CEST", 2*60*60)),
+		Value:     []byte(`{"test":"value"}`),
+	}
+
+	tests := []struct {
+		name  string
+		entry Entry
+		mode  PayloadMode
+		data  string
+	}{
+		{
+			name:  "key only",
+			entry: entry,
+			mode:  PayloadKey,
+			data:  `{"key":"key","namespace":"namespace","scope":"scope","ttl":90,"storedAt":"2024-05-01T10:00:00Z"}`,
+		},
+		{
+			name:  "embedded value",
+			entry: entry,
+			mode:  PayloadValue,
+			data:  `{"key":"key","namespace":"namespace","scope":"scope","ttl":90,"storedAt":"2024-05-01T10:00:00Z","value":{"test":"value"}}`,
+		},
+		{
+			name:  "hash of the value",
+			entry: entry,
+			mode:  PayloadHash,
+			data:  `{"key":"key","namespace":"namespace","scope":"scope","ttl":90,"storedAt":"2024-05-01T10:00:00Z","hash":"f98be16ebfa861cb39a61faff9e52b33f5bcc16bb6ae72e728d226dc07093932"}`,
+		},
+		{
+			name:  "entry without value",
+			entry: Entry{Key: "key", Namespace: "namespace"},
+			mode:  PayloadValue,
+			data:  `{"key":"key","namespace":"namespace"}`,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			data, err := json.Marshal(newData(test.entry, test.mode))
+			assert.NoError(t, err)
+			assert.JSONEq(t, test.data, string(data))
+		})
+	}
+}
+
+func TestParseTransport(t *testing.T) {
+	for _, name := range []string{"nats", "HTTP", "kafka", "none"} {
+		transport, err := ParseTransport(name)
+		assert.NoError(t, err)
+		assert.Equal(t, Transport(strings.ToLower(name)), transport)
+	}
+
+	_, err := ParseTransport("amqp")
+	assert.EqualError(t, err, `unknown events transport "amqp"`)
+}
+
+func TestNewNone(t *testing.T) {
+	c := NewNone()
+	assert.Equal(t, TransportNone, c.transport)
+	assert.NoError(t, c.Ping(context.Background()))
+
+	// events of expired entries are dropped
+	assert.NoError(t, c.SendExpired(context.Background(), Entry{Key: "key"}))
+
+	// events written to the outbox cannot be published
+	_, err := c.NewSetEvent(context.Background(), Entry{Key: "key"})
+	assert.ErrorIs(t, err, ErrDisabled)
+	_, err = c.NewDeleteEvent(context.Background(), Entry{Key: "key"})
+	assert.ErrorIs(t, err, ErrDisabled)
+	assert.ErrorIs(t, c.Publish(context.Background(), []byte(`{}`)), ErrDisabled)
+
+	assert.NoError(t, c.CLose(context.Background()))
+}
+
+// publishDelete encodes a delete event for the entry and publishes it
+// like the outbox relay.
+func publishDelete(t *testing.T, c *Client, entry Entry) error {
+	t.Helper()
+
+	encoded, err := c.NewDeleteEvent(context.Background(), entry)
+	require.NoError(t, err)
+	return c.Publish(context.Background(), encoded)
+}
@@ -0,0 +1,74 @@
+package event
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+	"time"
+
+	cehttp "github.com/cloudevents/sdk-go/v2/protocol/http"
+)
+
+// HTTPConfig configures the delivery of events to a webhook.
+type HTTPConfig struct {
+	// URL is the webhook which receives the events with POST requests.
+	URL string
+	// Timeout limits the time of a request, including the response.
+	Timeout time.Duration
+	// Headers are added to every request, e.g. for authorization.
+	Headers map[string]string
+}
+
+// defaultHTTPTimeout is used without configured request timeout.
+const defaultHTTPTimeout = 10 * time.Second
+
+// NewHTTP creates a client which posts events in the CloudEvents HTTP binary
+// mode to a webhook: the event attributes are sent as ce-* headers and the
+// data as request body. Events are delivered once the webhook responds with
+// a 2xx status.
+func NewHTTP(config HTTPConfig, opts ...Option) (*Client, error) {
+	target, err := url.Parse(config.URL)
+	if err != nil || (target.Scheme != "http" && target.Scheme != "https") || target.Host == "" {
+		return nil, fmt.Errorf("invalid webhook url %q", config.URL)
+	}
+	c := newClient(TransportHTTP, opts)
+
+	timeout := config.Timeout
+	if timeout <= 0 {
+		timeout = defaultHTTPTimeout
+	}
+
+	protocolOpts := []cehttp.Option{
+		cehttp.WithTarget(target.String()),
+		cehttp.WithClient(http.Client{Timeout: timeout}),
+	}
+	for key, value := range config.Headers {
+		protocolOpts = append(protocolOpts, cehttp.WithHeader(key, value))
+	}
+
+	sender, err := cehttp.New(protocolOpts...)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := c.setSender(sender, httpConnection{sender.Client}); err != nil {
+		return nil, err
+	}
+	return c, nil
+}
+
+// httpConnection has no permanent connection to the webhook,
+// which is only known to be reachable by sending events.
+type httpConnection struct {
+	client *http.Client
+}
+
+func (httpConnection) Ping(_ context.Context) error {
+	return nil
+}
+
+func (h httpConnection) Close(_ context.Context) error {
+	h.client.CloseIdleConnections()
+	return nil
+}
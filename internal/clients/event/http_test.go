@@ -0,0 +1,70 @@
+package event
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewHTTP(t *testing.T) {
+	var (
+		header http.Header
+		body   []byte
+		status = http.StatusAccepted
+	)
+	webhook := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		header = r.Header.Clone()
+		body, _ = io.ReadAll(r.Body)
+		w.WriteHeader(status)
+	}))
+	defer webhook.Close()
+
+	c, err := NewHTTP(HTTPConfig{
+		URL:     webhook.URL + "/events",
+		Timeout: time.Second,
+		Headers: map[string]string{"Authorization": "Bearer token"},
+	})
+	require.NoError(t, err)
+	defer c.CLose(context.Background()) //nolint:errcheck
+	assert.Equal(t, TransportHTTP, c.transport)
+	assert.NoError(t, c.Ping(context.Background()))
+
+	encoded, err := c.NewSetEvent(context.Background(), Entry{Key: "key", Namespace: "namespace"})
+	require.NoError(t, err)
+	require.NoError(t, c.Publish(context.Background(), encoded))
+
+	// the event is sent in binary mode with its data as body
+	assert.Equal(t, "Bearer token", header.Get("Authorization"))
+	assert.Equal(t, "1.0", header.Get("Ce-Specversion"))
+	assert.Equal(t, setEventType, header.Get("Ce-Type"))
+	assert.Equal(t, "cache", header.Get("Ce-Source"))
+	assert.Equal(t, dataSchema, header.Get("Ce-Dataschema"))
+	assert.NotEmpty(t, header.Get("Ce-Id"))
+	assert.Equal(t, "application/json", header.Get("Content-Type"))
+	assert.JSONEq(t, `{"key":"key","namespace":"namespace"}`, string(body))
+
+	// delete events are published from the outbox as well
+	encoded, err = c.NewDeleteEvent(context.Background(), Entry{Key: "key"})
+	require.NoError(t, err)
+	require.NoError(t, c.Publish(context.Background(), encoded))
+	assert.Equal(t, deleteEventType, header.Get("Ce-Type"))
+	assert.JSONEq(t, `{"key":"key"}`, string(body))
+
+	// an error status of the webhook is a failed delivery
+	status = http.StatusBadRequest
+	err = publishDelete(t, c, Entry{Key: "key"})
+	assert.ErrorContains(t, err, "failed to send cache_delete_event")
+}
+
+func TestNewHTTP_InvalidURL(t *testing.T) {
+	for _, url := range []string{"", "localhost:8080", "ftp://localhost/events", "http://"} {
+		_, err := NewHTTP(HTTPConfig{URL: url})
+		assert.ErrorContains(t, err, "invalid webhook url", url)
+	}
+}
@@ -0,0 +1,149 @@
+package event
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	cenats "github.com/cloudevents/sdk-go/protocol/nats/v2"
+	"github.com/cloudevents/sdk-go/v2/binding"
+	"github.com/cloudevents/sdk-go/v2/protocol"
+	"github.com/nats-io/nats.go"
+	"github.com/nats-io/nats.go/jetstream"
+)
+
+// JetStreamConfig configures publishing to NATS JetStream.
+type JetStreamConfig struct {
+	// Stream is the name of the stream which captures the subject.
+	// If set, it is verified at startup and expected by every publish.
+	Stream string
+	// CreateStream creates the stream for the subject if it doesn't exist.
+	CreateStream bool
+	// AckTimeout limits the time to wait for the acknowledgement of a publish.
+	AckTimeout time.Duration
+}
+
+// defaultAckTimeout is used without configured acknowledgement timeout.
+const defaultAckTimeout = 5 * time.Second
+
+// WithJetStream publishes events to JetStream and waits for their
+// acknowledgement instead of publishing them to core NATS.
+func WithJetStream(config JetStreamConfig) Option {
+	return func(c *Client) {
+		c.jetStream = &config
+	}
+}
+
+// jetStreamSender is a CloudEvents sender which publishes events to
+// JetStream in the structured mode of the core NATS sender. The event ID
+// is set as Nats-Msg-Id, so events sent twice are dropped by the server.
+type jetStreamSender struct {
+	js         jetstream.JetStream
+	subject    string
+	stream     string
+	ackTimeout time.Duration
+}
+
+func newJetStreamSender(ctx context.Context, conn *nats.Conn, subject string, config JetStreamConfig) (*jetStreamSender, error) {
+	js, err := jetstream.New(conn)
+	if err != nil {
+		return nil, err
+	}
+
+	if config.Stream != "" {
+		if err := ensureStream(ctx, js, config.Stream, subject, config.CreateStream); err != nil {
+			return nil, err
+		}
+	}
+
+	ackTimeout := config.AckTimeout
+	if ackTimeout <= 0 {
+		ackTimeout = defaultAckTimeout
+	}
+
+	return &jetStreamSender{
+		js:         js,
+		subject:    subject,
+		stream:     config.Stream,
+		ackTimeout: ackTimeout,
+	}, nil
+}
+
+// ensureStream verifies that the stream exists and captures the subject.
+// A missing stream is created if create is true.
+func ensureStream(ctx context.Context, js jetstream.JetStream, name, subject string, create bool) error {
+	stream, err := js.Stream(ctx, name)
+	if errors.Is(err, jetstream.ErrStreamNotFound) && create {
+		_, err = js.CreateStream(ctx, jetstream.StreamConfig{
+			Name:     name,
+			Subjects: []string{subject},
+		})
+		if err != nil {
+			return fmt.Errorf("cannot create stream %q: %w", name, err)
+		}
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("cannot get stream %q: %w", name, err)
+	}
+
+	for _, pattern := range stream.CachedInfo().Config.Subjects {
+		if subjectMatches(pattern, subject) {
+			return nil
+		}
+	}
+	return fmt.Errorf("stream %q does not capture subject %q", name, subject)
+}
+
+// subjectMatches reports whether the subject matches the pattern,
+// which may contain the wildcards * and >.
+func subjectMatches(pattern, subject string) bool {
+	patternTokens := strings.Split(pattern, ".")
+	subjectTokens := strings.Split(subject, ".")
+	for i, token := range patternTokens {
+		if token == ">" {
+			return len(subjectTokens) > i
+		}
+		if i >= len(subjectTokens) || (token != "*" && token != subjectTokens[i]) {
+			return false
+		}
+	}
+	return len(patternTokens) == len(subjectTokens)
+}
+
+func (s *jetStreamSender) Send(ctx context.Context, in binding.Message, transformers ...binding.Transformer) (err error) {
+	defer func() {
+		if err2 := in.Finish(err); err2 != nil && err == nil {
+			err = err2
+		}
+	}()
+
+	e, err := binding.ToEvent(ctx, in, transformers...)
+	if err != nil {
+		return err
+	}
+
+	body := new(bytes.Buffer)
+	if err := cenats.WriteMsg(ctx, binding.ToMessage(e), body); err != nil {
+		return err
+	}
+
+	opts := []jetstream.PublishOpt{jetstream.WithMsgID(e.ID())}
+	if s.stream != "" {
+		opts = append(opts, jetstream.WithExpectStream(s.stream))
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, s.ackTimeout)
+	defer cancel()
+
+	msg := &nats.Msg{Subject: s.subject, Data: body.Bytes()}
+	if _, err := s.js.PublishMsg(ctx, msg, opts...); err != nil {
+		return fmt.Errorf("event is not acknowledged: %w", err)
+	}
+	return nil
+}
+
+var _ protocol.Sender = (*jetStreamSender)(nil)
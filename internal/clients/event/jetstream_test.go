@@ -0,0 +1,177 @@
+package event
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+	"time"
+
+	cloudevents "github.com/cloudevents/sdk-go/v2"
+	"github.com/nats-io/nats-server/v2/server"
+	"github.com/nats-io/nats.go"
+	"github.com/nats-io/nats.go/jetstream"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// runServer starts an in-process NATS server with JetStream enabled.
+func runServer(t *testing.T) *server.Server {
+	t.Helper()
+
+	s, err := server.NewServer(&server.Options{
+		Host:      "127.0.0.1",
+		Port:      -1,
+		JetStream: true,
+		StoreDir:  t.TempDir(),
+	})
+	require.NoError(t, err)
+
+	go s.Start()
+	if !s.ReadyForConnections(5 * time.Second) {
+		t.Fatal("nats server is not ready")
+	}
+	t.Cleanup(s.Shutdown)
+	return s
+}
+
+func jetStream(t *testing.T, s *server.Server) jetstream.JetStream {
+	t.Helper()
+
+	conn, err := nats.Connect(s.ClientURL())
+	require.NoError(t, err)
+	t.Cleanup(conn.Close)
+
+	js, err := jetstream.New(conn)
+	require.NoError(t, err)
+	return js
+}
+
+func TestClient_SendJetStream(t *testing.T) {
+	s := runServer(t)
+	js := jetStream(t, s)
+
+	c, err := NewNATS(s.ClientURL(), "external", WithJetStream(JetStreamConfig{
+		Stream:       "CACHE",
+		CreateStream: true,
+	}))
+	require.NoError(t, err)
+	defer c.CLose(context.Background()) //nolint:errcheck
+
+	stream, err := js.Stream(context.Background(), "CACHE")
+	require.NoError(t, err)
+	assert.Equal(t, []string{"external"}, stream.CachedInfo().Config.Subjects)
+
+	encoded, err := c.NewSetEvent(context.Background(), Entry{Key: "key", Namespace: "namespace", Value: []byte(`{}`)})
+	require.NoError(t, err)
+	require.NoError(t, c.Publish(context.Background(), encoded))
+
+	// the event is stored in the stream with its ID as message ID
+	msg, err := stream.GetLastMsgForSubject(context.Background(), "external")
+	require.NoError(t, err)
+	e := cloudevents.NewEvent()
+	require.NoError(t, json.Unmarshal(msg.Data, &e))
+	assert.Equal(t, setEventType, e.Type())
+	assert.Equal(t, dataSchema, e.DataSchema())
+	assert.Equal(t, e.ID(), msg.Header.Get(jetstream.MsgIDHeader))
+
+	// an event published again, e.g. by the outbox relay after a failure,
+	// is deduplicated by the server
+	require.NoError(t, c.Publish(context.Background(), encoded))
+	info, err := stream.Info(context.Background())
+	require.NoError(t, err)
+	assert.Equal(t, uint64(1), info.State.Msgs)
+
+	// another event is stored as well
+	err = c.SendExpired(context.Background(), Entry{Key: "key"})
+	require.NoError(t, err)
+	info, err = stream.Info(context.Background())
+	require.NoError(t, err)
+	assert.Equal(t, uint64(2), info.State.Msgs)
+}
+
+func TestClient_SendJetStreamWithoutStream(t *testing.T) {
+	s := runServer(t)
+
+	// without a stream the publish is not acknowledged
+	c, err := NewNATS(s.ClientURL(), "external", WithJetStream(JetStreamConfig{AckTimeout: time.Second}))
+	require.NoError(t, err)
+	defer c.CLose(context.Background()) //nolint:errcheck
+
+	err = publishDelete(t, c, Entry{Key: "key"})
+	assert.ErrorContains(t, err, "failed to send cache_delete_event")
+}
+
+func TestNew_VerifyStream(t *testing.T) {
+	s := runServer(t)
+	js := jetStream(t, s)
+
+	_, err := js.CreateStream(context.Background(), jetstream.StreamConfig{Name: "EVENTS", Subjects: []string{"cache.>"}})
+	require.NoError(t, err)
+
+	tests := []struct {
+		name    string
+		subject string
+		config  JetStreamConfig
+		errtext string
+	}{
+		{
+			name:    "stream captures the subject",
+			subject: "cache.external",
+			config:  JetStreamConfig{Stream: "EVENTS"},
+		},
+		{
+			name:    "existing stream is not changed",
+			subject: "cache.external",
+			config:  JetStreamConfig{Stream: "EVENTS", CreateStream: true},
+		},
+		{
+			name:    "stream doesn't capture the subject",
+			subject: "external",
+			config:  JetStreamConfig{Stream: "EVENTS", CreateStream: true},
+			errtext: `stream "EVENTS" does not capture subject "external"`,
+		},
+		{
+			name:    "missing stream",
+			subject: "external",
+			config:  JetStreamConfig{Stream: "MISSING"},
+			errtext: `cannot get stream "MISSING"`,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			c, err := NewNATS(s.ClientURL(), test.subject, WithJetStream(test.config))
+			if test.errtext != "" {
+				assert.ErrorContains(t, err, test.errtext)
+				return
+			}
+			require.NoError(t, err)
+			assert.NoError(t, c.CLose(context.Background()))
+		})
+	}
+
+	stream, err := js.Stream(context.Background(), "EVENTS")
+	require.NoError(t, err)
+	assert.Equal(t, []string{"cache.>"}, stream.CachedInfo().Config.Subjects)
+}
+
+func TestSubjectMatches(t *testing.T) {
+	tests := []struct {
+		pattern string
+		subject string
+		match   bool
+	}{
+		{pattern: "external", subject: "external", match: true},
+		{pattern: "external", subject: "other", match: false},
+		{pattern: "cache.*", subject: "cache.external", match: true},
+		{pattern: "cache.*", subject: "cache.external.set", match: false},
+		{pattern: "cache.>", subject: "cache.external.set", match: true},
+		{pattern: "cache.>", subject: "cache", match: false},
+		{pattern: ">", subject: "external", match: true},
+		{pattern: "cache.external", subject: "cache", match: false},
+	}
+
+	for _, test := range tests {
+		assert.Equal(t, test.match, subjectMatches(test.pattern, test.subject), test.pattern+" "+test.subject)
+	}
+}
@@ -0,0 +1,104 @@
+package event
+
+import (
+	"context"
+	"errors"
+	"strings"
+
+	"github.com/IBM/sarama"
+	"github.com/cloudevents/sdk-go/protocol/kafka_sarama/v2"
+	"github.com/cloudevents/sdk-go/v2/binding"
+	"github.com/cloudevents/sdk-go/v2/protocol"
+)
+
+// NewKafka creates a client which produces events in the CloudEvents Kafka
+// binary mode to the topic: the event attributes are sent as ce_* headers
+// and the data as message value. Events are delivered once they are
+// acknowledged by all in-sync replicas.
+func NewKafka(brokers []string, topic string, opts ...Option) (*Client, error) {
+	if len(brokers) == 0 {
+		return nil, errors.New("kafka brokers are missing")
+	}
+	c := newClient(TransportKafka, opts)
+
+	config := sarama.NewConfig()
+	config.ClientID = "cache"
+	config.Producer.RequiredAcks = sarama.WaitForAll
+	config.Producer.Return.Successes = true
+
+	client, err := sarama.NewClient(brokers, config)
+	if err != nil {
+		return nil, err
+	}
+	producer, err := sarama.NewSyncProducerFromClient(client)
+	if err != nil {
+		client.Close() //nolint:errcheck
+		return nil, err
+	}
+
+	conn := kafkaConnection{client: client, producer: producer, topic: topic}
+	if err := c.setSender(newKafkaSender(topic, producer), conn); err != nil {
+		conn.Close(context.Background()) //nolint:errcheck
+		return nil, err
+	}
+	return c, nil
+}
+
+// kafkaSender is a CloudEvents sender which produces events with the key
+// fields of their entry as message key, so that all events of an entry are
+// written to the same partition and keep their order.
+type kafkaSender struct {
+	sender *kafka_sarama.Sender
+}
+
+func newKafkaSender(topic string, producer sarama.SyncProducer) *kafkaSender {
+	sender, _ := kafka_sarama.NewSenderFromSyncProducer(topic, producer)
+	return &kafkaSender{sender: sender}
+}
+
+func (s *kafkaSender) Send(ctx context.Context, in binding.Message, transformers ...binding.Transformer) (err error) {
+	defer func() {
+		if err2 := in.Finish(err); err2 != nil && err == nil {
+			err = err2
+		}
+	}()
+
+	e, err := binding.ToEvent(ctx, in, transformers...)
+	if err != nil {
+		return err
+	}
+
+	var data Data
+	if err := e.DataAs(&data); err == nil {
+		ctx = kafka_sarama.WithMessageKey(ctx, sarama.StringEncoder(messageKey(data)))
+	}
+	return s.sender.Send(ctx, binding.ToMessage(e))
+}
+
+var _ protocol.Sender = (*kafkaSender)(nil)
+
+// messageKeyEscaper escapes the parts of a message key like the cache service
+// escapes the parts of v2 storage keys.
+var messageKeyEscaper = strings.NewReplacer("%", "%25", ":", "%3A")
+
+// messageKey returns the v2 storage key of the entry, which is unambiguous,
+// so that distinct entries don't share a message key.
+func messageKey(data Data) string {
+	return "v2:" + messageKeyEscaper.Replace(data.Namespace) + ":" + messageKeyEscaper.Replace(data.Scope) + ":" + messageKeyEscaper.Replace(data.Key)
+}
+
+type kafkaConnection struct {
+	client   sarama.Client
+	producer sarama.SyncProducer
+	topic    string
+}
+
+// Ping refreshes the metadata of the topic, which requires a reachable broker.
+func (k kafkaConnection) Ping(_ context.Context) error {
+	return k.client.RefreshMetadata(k.topic)
+}
+
+func (k kafkaConnection) Close(_ context.Context) error {
+	// the producer doesn't close the client it is created from
+	return errors.Join(k.producer.Close(), k.client.Close())
+}
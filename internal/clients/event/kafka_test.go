@@ -0,0 +1,116 @@
+package event
+
+import (
+	"context"
+	"testing"
+
+	"github.com/IBM/sarama"
+	"github.com/IBM/sarama/mocks"
+	cloudevents "github.com/cloudevents/sdk-go/v2"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// runBroker starts a mock Kafka broker which leads the single partition of the topic.
+func runBroker(t *testing.T, topic string, produceErr sarama.KError) *sarama.MockBroker {
+	t.Helper()
+
+	broker := sarama.NewMockBroker(t, 1)
+	broker.SetHandlerByMap(map[string]sarama.MockResponse{
+		"MetadataRequest": sarama.NewMockMetadataResponse(t).
+			SetBroker(broker.Addr(), broker.BrokerID()).
+			SetLeader(topic, 0, broker.BrokerID()),
+		"ProduceRequest": sarama.NewMockProduceResponse(t).
+			SetVersion(3). // produce requests of the default Kafka version
+			SetError(topic, 0, produceErr),
+	})
+	t.Cleanup(broker.Close)
+	return broker
+}
+
+func produceRequests(broker *sarama.MockBroker) int {
+	n := 0
+	for _, rr := range broker.History() {
+		if _, ok := rr.Request.(*sarama.ProduceRequest); ok {
+			n++
+		}
+	}
+	return n
+}
+
+func TestNewKafka(t *testing.T) {
+	broker := runBroker(t, "external", sarama.ErrNoError)
+
+	c, err := NewKafka([]string{broker.Addr()}, "external")
+	require.NoError(t, err)
+	defer c.CLose(context.Background()) //nolint:errcheck
+	assert.Equal(t, TransportKafka, c.transport)
+	assert.NoError(t, c.Ping(context.Background()))
+
+	encoded, err := c.NewSetEvent(context.Background(), Entry{Key: "key", Namespace: "namespace"})
+	require.NoError(t, err)
+	require.NoError(t, c.Publish(context.Background(), encoded))
+	assert.Equal(t, 1, produceRequests(broker))
+}
+
+func TestNewKafka_NotAcknowledged(t *testing.T) {
+	broker := runBroker(t, "external", sarama.ErrNotEnoughReplicas)
+
+	c, err := NewKafka([]string{broker.Addr()}, "external")
+	require.NoError(t, err)
+	defer c.CLose(context.Background()) //nolint:errcheck
+
+	err = publishDelete(t, c, Entry{Key: "key"})
+	assert.ErrorContains(t, err, "failed to send cache_delete_event")
+}
+
+func TestNewKafka_MissingBrokers(t *testing.T) {
+	_, err := NewKafka(nil, "external")
+	assert.ErrorContains(t, err, "kafka brokers are missing")
+}
+
+func TestKafkaSender(t *testing.T) {
+	producer := mocks.NewSyncProducer(t, nil)
+	defer producer.Close() //nolint:errcheck
+
+	var msg *sarama.ProducerMessage
+	producer.ExpectSendMessageWithMessageCheckerFunctionAndSucceed(func(m *sarama.ProducerMessage) error {
+		msg = m
+		return nil
+	})
+
+	events, err := cloudevents.NewClient(newKafkaSender("external", producer))
+	require.NoError(t, err)
+	c := &Client{transport: TransportKafka, events: events}
+
+	err = publishDelete(t, c, Entry{Key: "key", Namespace: "namespace", Scope: "scope"})
+	require.NoError(t, err)
+	require.NotNil(t, msg)
+
+	// the event is produced in binary mode with the entry as message key
+	headers := map[string]string{}
+	for _, h := range msg.Headers {
+		headers[string(h.Key)] = string(h.Value)
+	}
+	assert.Equal(t, "external", msg.Topic)
+	assert.Equal(t, deleteEventType, headers["ce_type"])
+	assert.Equal(t, "cache", headers["ce_source"])
+	assert.Equal(t, dataSchema, headers["ce_dataschema"])
+	assert.Equal(t, "application/json", headers["content-type"])
+
+	key, err := msg.Key.Encode()
+	require.NoError(t, err)
+	assert.Equal(t, "v2:namespace:scope:key", string(key))
+	value, err := msg.Value.Encode()
+	require.NoError(t, err)
+	assert.JSONEq(t, `{"key":"key","namespace":"namespace","scope":"scope"}`, string(value))
+}
+
+func TestMessageKey(t *testing.T) {
+	assert.Equal(t, "v2:namespace::key", messageKey(Data{Key: "key", Namespace: "namespace"}))
+	assert.Equal(t, "v2:a%3Ab:c%25:d", messageKey(Data{Key: "d", Namespace: "a:b", Scope: "c%"}))
+
+	// entries whose parts contain separators have distinct keys
+	assert.NotEqual(t, messageKey(Data{Key: "a,b", Namespace: "c"}), messageKey(Data{Key: "a", Namespace: "b,c"}))
+	assert.NotEqual(t, messageKey(Data{Key: "a", Namespace: "b:c"}), messageKey(Data{Key: "c:a", Namespace: "b"}))
+}
@@ -0,0 +1,65 @@
+package event
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	cenats "github.com/cloudevents/sdk-go/protocol/nats/v2"
+	"github.com/cloudevents/sdk-go/v2/protocol"
+	"github.com/nats-io/nats.go"
+)
+
+// streamSetupTimeout limits the verification or creation of the JetStream stream.
+const streamSetupTimeout = 10 * time.Second
+
+// NewNATS creates a client which publishes events to the NATS subject,
+// or to JetStream if WithJetStream is given.
+func NewNATS(addr, subject string, opts ...Option) (*Client, error) {
+	if addr == "" {
+		return nil, errors.New("nats address is missing")
+	}
+	c := newClient(TransportNATS, opts)
+
+	conn, err := nats.Connect(addr)
+	if err != nil {
+		return nil, err
+	}
+
+	// create cloudevents nats sender
+	var sender protocol.Sender
+	if c.jetStream != nil {
+		ctx, cancel := context.WithTimeout(context.Background(), streamSetupTimeout)
+		defer cancel()
+		sender, err = newJetStreamSender(ctx, conn, subject, *c.jetStream)
+	} else {
+		sender, err = cenats.NewSenderFromConn(conn, subject)
+	}
+	if err != nil {
+		conn.Close()
+		return nil, err
+	}
+
+	if err := c.setSender(sender, natsConnection{conn}); err != nil {
+		conn.Close()
+		return nil, err
+	}
+	return c, nil
+}
+
+type natsConnection struct {
+	conn *nats.Conn
+}
+
+func (n natsConnection) Ping(_ context.Context) error {
+	if !n.conn.IsConnected() {
+		return fmt.Errorf("nats connection is %s", n.conn.Status())
+	}
+	return nil
+}
+
+func (n natsConnection) Close(_ context.Context) error {
+	n.conn.Close()
+	return nil
+}
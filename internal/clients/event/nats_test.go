@@ -0,0 +1,54 @@
+package event
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+	"time"
+
+	cloudevents "github.com/cloudevents/sdk-go/v2"
+	"github.com/nats-io/nats.go"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewNATS(t *testing.T) {
+	s := runServer(t)
+
+	conn, err := nats.Connect(s.ClientURL())
+	require.NoError(t, err)
+	defer conn.Close()
+	sub, err := conn.SubscribeSync("external")
+	require.NoError(t, err)
+
+	c, err := NewNATS(s.ClientURL(), "external")
+	require.NoError(t, err)
+	defer c.CLose(context.Background()) //nolint:errcheck
+	assert.NoError(t, c.Ping(context.Background()))
+
+	err = publishDelete(t, c, Entry{Key: "key", Namespace: "namespace"})
+	require.NoError(t, err)
+
+	msg, err := sub.NextMsg(5 * time.Second)
+	require.NoError(t, err)
+	e := cloudevents.NewEvent()
+	require.NoError(t, json.Unmarshal(msg.Data, &e))
+	assert.Equal(t, deleteEventType, e.Type())
+	assert.JSONEq(t, `{"key":"key","namespace":"namespace"}`, string(e.Data()))
+}
+
+func TestClient_PublishMalformed(t *testing.T) {
+	s := runServer(t)
+
+	c, err := NewNATS(s.ClientURL(), "external")
+	require.NoError(t, err)
+	defer c.CLose(context.Background()) //nolint:errcheck
+
+	err = c.Publish(context.Background(), []byte("{"))
+	assert.ErrorIs(t, err, ErrMalformedEvent)
+}
+
+func TestNewNATS_MissingAddr(t *testing.T) {
+	_, err := NewNATS("", "external")
+	assert.ErrorContains(t, err, "nats address is missing")
+}
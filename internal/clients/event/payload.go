@@ -0,0 +1,113 @@
+package event
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+)
+
+// dataSchema identifies the version of the Data payload. It changes
+// with every incompatible change of the payload.
+const dataSchema = "urn:eclipse-xfsc:redis-cache-service:event-data:v2"
+
+// PayloadMode specifies whether the value of an entry is part of its events.
+type PayloadMode string
+
+const (
+	// PayloadKey publishes the key fields of the entry only.
+	PayloadKey PayloadMode = "key"
+	// PayloadValue embeds the stored JSON value.
+	PayloadValue PayloadMode = "value"
+	// PayloadHash embeds the hex encoded SHA-256 hash of the stored JSON value.
+	PayloadHash PayloadMode = "hash"
+)
+
+// defaultNamespace holds the payload mode of namespaces without own mode.
+const defaultNamespace = "*"
+
+// Entry describes the cache entry of an event.
+type Entry struct {
+	Key       string
+	Namespace string
+	Scope     string
+	// TTL is the time to live of the stored entry, zero means no expiration.
+	TTL time.Duration
+	// StoredAt is the time when the entry is stored, zero if it is unknown.
+	StoredAt time.Time
+	// Value is the stored JSON value, if it is known.
+	Value []byte
+}
+
+// Data is the payload of all events.
+type Data struct {
+	Key       string          `json:"key"`
+	Namespace string          `json:"namespace,omitempty"`
+	Scope     string          `json:"scope,omitempty"`
+	TTL       int64           `json:"ttl,omitempty"` // seconds
+	StoredAt  *time.Time      `json:"storedAt,omitempty"`
+	Value     json.RawMessage `json:"value,omitempty"`
+	Hash      string          `json:"hash,omitempty"`
+}
+
+// Option configures optional behaviour of the Client.
+type Option func(*Client)
+
+// WithPayloadModes sets the payload mode per namespace, the mode
+// of "*" applies to all other namespaces. The default is PayloadKey.
+func WithPayloadModes(modes map[string]PayloadMode) Option {
+	return func(c *Client) {
+		c.payloadModes = modes
+	}
+}
+
+// ParsePayloadModes returns the payload modes of the configured namespaces.
+func ParsePayloadModes(modes map[string]string) (map[string]PayloadMode, error) {
+	parsed := make(map[string]PayloadMode, len(modes))
+	for namespace, mode := range modes {
+		switch m := PayloadMode(strings.ToLower(mode)); m {
+		case PayloadKey, PayloadValue, PayloadHash:
+			parsed[namespace] = m
+		default:
+			return nil, fmt.Errorf("unknown event payload mode %q of namespace %q", mode, namespace)
+		}
+	}
+	return parsed, nil
+}
+
+func (c *Client) payloadMode(namespace string) PayloadMode {
+	if mode, ok := c.payloadModes[namespace]; ok {
+		return mode
+	}
+	if mode, ok := c.payloadModes[defaultNamespace]; ok {
+		return mode
+	}
+	return PayloadKey
+}
+
+// newData creates the event payload of the entry.
+func newData(entry Entry, mode PayloadMode) *Data {
+	data := &Data{
+		Key:       entry.Key,
+		Namespace: entry.Namespace,
+		Scope:     entry.Scope,
+		TTL:       int64(entry.TTL / time.Second),
+	}
+	if !entry.StoredAt.IsZero() {
+		storedAt := entry.StoredAt.UTC()
+		data.StoredAt = &storedAt
+	}
+
+	if entry.Value != nil {
+		switch mode {
+		case PayloadValue:
+			data.Value = entry.Value
+		case PayloadHash:
+			sum := sha256.Sum256(entry.Value)
+			data.Hash = hex.EncodeToString(sum[:])
+		}
+	}
+	return data
+}
@@ -5,17 +5,43 @@ import (
 	"strings"
 	"time"
 
-	errors "github.com/eclipse-xfsc/microservice-core-go/pkg/err"
 	"github.com/redis/go-redis/v9"
+
+	errors "github.com/eclipse-xfsc/microservice-core-go/pkg/err"
 )
 
+// compareAndSetScript sets the value of KEYS[1] to ARGV[1] only if the SHA-1
+// of its current value equals ARGV[2]. ARGV[3] is the TTL in milliseconds.
+var compareAndSetScript = redis.NewScript(`
+local current = redis.call('GET', KEYS[1])
+if not current then
+	return -1
+end
+if redis.sha1hex(current) ~= ARGV[2] then
+	return 0
+end
+if tonumber(ARGV[3]) > 0 then
+	redis.call('SET', KEYS[1], ARGV[1], 'PX', ARGV[3])
+else
+	redis.call('SET', KEYS[1], ARGV[1])
+end
+return 1
+`)
+
+// maxUpdateRetries is the number of attempts of Update if the key
+// is modified concurrently.
+const maxUpdateRetries = 10
+
 type Client struct {
-	rdb        redis.Cmdable
+	rdb        redis.UniversalClient
 	defaultTTL time.Duration
+	cluster    bool
+
+	outbox outbox
 }
 
 func New(addr, user, pass string, db int, defaultTTL time.Duration, cluster bool) *Client {
-	var rdb redis.Cmdable
+	var rdb redis.UniversalClient
 	if cluster {
 		nodes := strings.Split(addr, ";")
 		rdb = redis.NewClusterClient(&redis.ClusterOptions{
@@ -39,14 +65,19 @@ func New(addr, user, pass string, db int, defaultTTL time.Duration, cluster bool
 			WriteTimeout: 5 * time.Second,
 		})
 	}
+	rdb.AddHook(metricsHook{})
 
 	return &Client{
 		rdb:        rdb,
 		defaultTTL: defaultTTL,
+		cluster:    cluster,
 	}
 }
 
-func (c *Client) Get(ctx context.Context, key string) ([]byte, error) {
+func (c *Client) Get(ctx context.Context, key string) (_ []byte, err error) {
+	ctx, span := startSpan(ctx, "GET")
+	defer func() { endSpan(span, err) }()
+
 	result := c.rdb.Get(ctx, key)
 	if result.Err() != nil {
 		if result.Err() == redis.Nil {
@@ -57,10 +88,413 @@ func (c *Client) Get(ctx context.Context, key string) ([]byte, error) {
 	return []byte(result.Val()), nil
 }
 
-func (c *Client) Set(ctx context.Context, key string, value []byte, ttl time.Duration) error {
+// GetEx returns the value of the key and resets its time to live to ttl, which
+// must be positive, in the same round trip. The tags of the key are kept alive
+// as long as the key, which takes a second round trip for tagged keys only.
+func (c *Client) GetEx(ctx context.Context, key string, ttl time.Duration) ([]byte, error) {
+	values, err := c.GetManyEx(ctx, []string{key}, ttl)
+	if err != nil {
+		return nil, err
+	}
+	if values[0] == nil {
+		return nil, errors.New(errors.NotFound)
+	}
+	return values[0], nil
+}
+
+// GetManyEx returns the values of the keys in the order of the keys and resets
+// their time to live to ttl, which must be positive. The value of a missing key
+// is nil. All GETEX commands are sent in a single pipeline, which is executed
+// in parallel on the nodes in cluster mode.
+func (c *Client) GetManyEx(ctx context.Context, keys []string, ttl time.Duration) (_ [][]byte, err error) {
+	ctx, span := startSpan(ctx, "GETEX")
+	defer func() { endSpan(span, err) }()
+
+	gets := make([]*redis.StringCmd, len(keys))
+	tags := make([]*redis.StringSliceCmd, len(keys))
+	_, err = c.rdb.Pipelined(ctx, func(pipe redis.Pipeliner) error {
+		for i, key := range keys {
+			gets[i] = pipe.GetEx(ctx, key, ttl)
+			tags[i] = pipe.SMembers(ctx, entryTagsKey(key))
+			pipe.PExpire(ctx, entryTagsKey(key), ttl)
+		}
+		return nil
+	})
+	if err != nil && err != redis.Nil {
+		return nil, err
+	}
+
+	values := make([][]byte, len(keys))
+	var tagged []int
+	for i, get := range gets {
+		if get.Err() == redis.Nil {
+			continue
+		}
+		if get.Err() != nil {
+			return nil, get.Err()
+		}
+		values[i] = []byte(get.Val())
+		if len(tags[i].Val()) > 0 {
+			tagged = append(tagged, i)
+		}
+	}
+
+	if len(tagged) > 0 {
+		_, err = c.rdb.Pipelined(ctx, func(pipe redis.Pipeliner) error {
+			for _, i := range tagged {
+				addTags(ctx, pipe, keys[i], tags[i].Val(), ttl)
+			}
+			return nil
+		})
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return values, nil
+}
+
+// Set stores the value under the key and replaces its tags, see SetTags.
+// The condition "nx" only sets the key if it does not exist and "xx" only if
+// it already exists. If the condition is not met, an error of kind errors.Exist
+// is returned. Without a condition the value and its tags are written in the
+// same pipeline. If obsolete is not empty, the key and tags of obsolete are
+// removed when the value is stored, without a condition in the same pipeline,
+// e.g. the legacy key of an entry which is migrated to a new key format.
+func (c *Client) Set(ctx context.Context, key string, value []byte, ttl time.Duration, condition string, tags []string, obsolete string) (err error) {
+	ctx, span := startSpan(ctx, "SET")
+	defer func() { endSpan(span, err) }()
+
+	if ttl == 0 {
+		ttl = c.defaultTTL
+	}
+
+	var mode string
+	switch condition {
+	case "":
+		var previous, obsoleteTags *redis.StringSliceCmd
+		_, err = c.rdb.Pipelined(ctx, func(pipe redis.Pipeliner) error {
+			pipe.Set(ctx, key, value, ttl)
+			previous = replaceTags(ctx, pipe, key, tags, ttl)
+			if obsolete != "" {
+				obsoleteTags = pipe.SMembers(ctx, entryTagsKey(obsolete))
+				pipe.Unlink(ctx, obsolete)
+				pipe.Unlink(ctx, entryTagsKey(obsolete))
+			}
+			return nil
+		})
+		if err != nil {
+			return err
+		}
+		keys := []string{key}
+		keyTags := [][]string{droppedTags(previous.Val(), tags)}
+		if obsolete != "" {
+			keys = append(keys, obsolete)
+			keyTags = append(keyTags, obsoleteTags.Val())
+		}
+		return c.untag(ctx, keys, keyTags)
+	case "nx":
+		mode = "NX"
+	case "xx":
+		mode = "XX"
+	default:
+		return errors.New(errors.BadRequest, "unknown set condition: "+condition)
+	}
+
+	// the tags must only be replaced if the value is stored
+	err = c.rdb.SetArgs(ctx, key, value, redis.SetArgs{Mode: mode, TTL: ttl}).Err()
+	if err == redis.Nil {
+		return errors.New(errors.Exist, "set condition not met")
+	}
+	if err != nil {
+		return err
+	}
+	if obsolete != "" {
+		if _, err := c.Unlink(ctx, []string{obsolete}); err != nil {
+			return err
+		}
+	}
+	return c.SetTags(ctx, key, tags, ttl)
+}
+
+// Delete removes the key and its tags.
+func (c *Client) Delete(ctx context.Context, key string) (err error) {
+	ctx, span := startSpan(ctx, "DEL")
+	defer func() { endSpan(span, err) }()
+
+	var del *redis.IntCmd
+	var tags *redis.StringSliceCmd
+	_, err = c.rdb.Pipelined(ctx, func(pipe redis.Pipeliner) error {
+		del = pipe.Del(ctx, key)
+		tags = pipe.SMembers(ctx, entryTagsKey(key))
+		pipe.Del(ctx, entryTagsKey(key))
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+	if err := c.untag(ctx, []string{key}, [][]string{tags.Val()}); err != nil {
+		return err
+	}
+	if del.Val() == 0 {
+		return errors.New(errors.NotFound)
+	}
+	return nil
+}
+
+// Unlink removes the keys and their tags in a single pipeline and returns the
+// number of removed keys. Memory is reclaimed in the background by Redis.
+// In cluster mode one UNLINK is sent per hash slot.
+func (c *Client) Unlink(ctx context.Context, keys []string) (_ int64, err error) {
+	ctx, span := startSpan(ctx, "UNLINK")
+	defer func() { endSpan(span, err) }()
+
+	if len(keys) == 0 {
+		return 0, nil
+	}
+
+	tagKeys := make([]string, len(keys))
+	for i, key := range keys {
+		tagKeys[i] = entryTagsKey(key)
+	}
+
+	var cmds []*redis.IntCmd
+	tags := make([]*redis.StringSliceCmd, len(keys))
+	_, err = c.rdb.Pipelined(ctx, func(pipe redis.Pipeliner) error {
+		for i := range keys {
+			tags[i] = pipe.SMembers(ctx, tagKeys[i])
+		}
+		cmds = c.unlinkBySlot(ctx, pipe, keys)
+		c.unlinkBySlot(ctx, pipe, tagKeys)
+		return nil
+	})
+	if err != nil {
+		return 0, err
+	}
+
+	keyTags := make([][]string, len(keys))
+	for i, cmd := range tags {
+		keyTags[i] = cmd.Val()
+	}
+	if err := c.untag(ctx, keys, keyTags); err != nil {
+		return 0, err
+	}
+
+	var unlinked int64
+	for _, cmd := range cmds {
+		unlinked += cmd.Val()
+	}
+	return unlinked, nil
+}
+
+// unlinkBySlot queues one UNLINK per hash slot of the keys.
+func (c *Client) unlinkBySlot(ctx context.Context, pipe redis.Pipeliner, keys []string) []*redis.IntCmd {
+	groups := c.groupBySlot(keys)
+	cmds := make([]*redis.IntCmd, len(groups))
+	for i, group := range groups {
+		groupKeys := make([]string, len(group))
+		for j, index := range group {
+			groupKeys[j] = keys[index]
+		}
+		cmds[i] = pipe.Unlink(ctx, groupKeys...)
+	}
+	return cmds
+}
+
+// Ping checks the connection to Redis.
+func (c *Client) Ping(ctx context.Context) error {
+	return c.rdb.Ping(ctx).Err()
+}
+
+// CompareAndSet atomically stores the value under the key if the SHA-1 hex digest
+// of the current value equals hash. If the key does not exist or its value has
+// been modified, an error of kind errors.Exist is returned.
+func (c *Client) CompareAndSet(ctx context.Context, key string, value []byte, ttl time.Duration, hash string) (err error) {
+	ctx, span := startSpan(ctx, "EVALSHA")
+	defer func() { endSpan(span, err) }()
+
 	if ttl == 0 {
 		ttl = c.defaultTTL
 	}
 
-	return c.rdb.Set(ctx, key, value, ttl).Err()
+	res, err := compareAndSetScript.Run(ctx, c.rdb, []string{key}, value, hash, ttl.Milliseconds()).Int()
+	if err != nil {
+		return err
+	}
+
+	switch res {
+	case -1:
+		return errors.New(errors.Exist, "key does not exist")
+	case 0:
+		return errors.New(errors.Exist, "value has been modified")
+	}
+	return nil
+}
+
+// Update replaces the value of the key with the result of fn applied to its
+// current value and returns the new value. The key is watched during the update,
+// which is retried if the key is modified concurrently, so no concurrent write
+// is lost. The TTL of the key is kept.
+func (c *Client) Update(ctx context.Context, key string, fn func([]byte) ([]byte, error)) (_ []byte, err error) {
+	ctx, span := startSpan(ctx, "UPDATE")
+	defer func() { endSpan(span, err) }()
+
+	var updated []byte
+	update := func(tx *redis.Tx) error {
+		current, err := tx.Get(ctx, key).Bytes()
+		if err == redis.Nil {
+			return errors.New(errors.NotFound)
+		}
+		if err != nil {
+			return err
+		}
+
+		updated, err = fn(current)
+		if err != nil {
+			return err
+		}
+
+		_, err = tx.TxPipelined(ctx, func(pipe redis.Pipeliner) error {
+			pipe.Set(ctx, key, updated, redis.KeepTTL)
+			return nil
+		})
+		return err
+	}
+
+	for i := 0; i < maxUpdateRetries; i++ {
+		err = c.rdb.Watch(ctx, update, key)
+		if err != redis.TxFailedErr {
+			if err != nil {
+				return nil, err
+			}
+			return updated, nil
+		}
+	}
+	return nil, errors.New(errors.Exist, "value is modified concurrently")
+}
+
+// GetMany returns the values of the keys in the order of the keys. The value of
+// a missing key is nil. Keys are fetched with one MGET per hash slot in cluster
+// mode, and all MGET commands are sent in a single pipeline.
+func (c *Client) GetMany(ctx context.Context, keys []string) (_ [][]byte, err error) {
+	ctx, span := startSpan(ctx, "MGET")
+	defer func() { endSpan(span, err) }()
+
+	groups := c.groupBySlot(keys)
+	cmds := make([]*redis.SliceCmd, len(groups))
+	_, err = c.rdb.Pipelined(ctx, func(pipe redis.Pipeliner) error {
+		for i, group := range groups {
+			groupKeys := make([]string, len(group))
+			for j, index := range group {
+				groupKeys[j] = keys[index]
+			}
+			cmds[i] = pipe.MGet(ctx, groupKeys...)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	values := make([][]byte, len(keys))
+	for i, group := range groups {
+		for j, v := range cmds[i].Val() {
+			if str, ok := v.(string); ok {
+				values[group[j]] = []byte(str)
+			}
+		}
+	}
+	return values, nil
+}
+
+// SetMany stores the values under the keys in a single pipeline and
+// returns the error of each SET command in the order of the keys.
+// Previous tags of the keys are dropped. The non-empty obsolete keys, which
+// may be nil, are removed with their tags in the same pipeline, see Set.
+func (c *Client) SetMany(ctx context.Context, keys []string, values [][]byte, ttls []time.Duration, obsolete []string) []error {
+	ctx, span := startSpan(ctx, "SET")
+
+	cmds := make([]*redis.StatusCmd, len(keys))
+	tags := make([]*redis.StringSliceCmd, len(keys))
+	var obsoleteKeys []string
+	var obsoleteTags []*redis.StringSliceCmd
+	_, _ = c.rdb.Pipelined(ctx, func(pipe redis.Pipeliner) error {
+		for i, key := range keys {
+			ttl := ttls[i]
+			if ttl == 0 {
+				ttl = c.defaultTTL
+			}
+			cmds[i] = pipe.Set(ctx, key, values[i], ttl)
+			tags[i] = replaceTags(ctx, pipe, key, nil, ttl)
+			if i < len(obsolete) && obsolete[i] != "" {
+				obsoleteKeys = append(obsoleteKeys, obsolete[i])
+				obsoleteTags = append(obsoleteTags, pipe.SMembers(ctx, entryTagsKey(obsolete[i])))
+				pipe.Unlink(ctx, obsolete[i])
+				pipe.Unlink(ctx, entryTagsKey(obsolete[i]))
+			}
+		}
+		return nil
+	})
+
+	var err error
+	errs := make([]error, len(keys))
+	keyTags := make([][]string, len(keys))
+	for i, cmd := range cmds {
+		errs[i] = cmd.Err()
+		if err == nil {
+			err = errs[i]
+		}
+		keyTags[i] = tags[i].Val()
+	}
+	if err == nil {
+		untagged := append([]string{}, keys...)
+		for i, key := range obsoleteKeys {
+			untagged = append(untagged, key)
+			keyTags = append(keyTags, obsoleteTags[i].Val())
+		}
+		err = c.untag(ctx, untagged, keyTags)
+	}
+	endSpan(span, err)
+
+	return errs
+}
+
+// Exists reports whether the key exists.
+func (c *Client) Exists(ctx context.Context, key string) (_ bool, err error) {
+	ctx, span := startSpan(ctx, "EXISTS")
+	defer func() { endSpan(span, err) }()
+
+	n, err := c.rdb.Exists(ctx, key).Result()
+	if err != nil {
+		return false, err
+	}
+	return n == 1, nil
+}
+
+// TTL returns the remaining time to live of the key.
+// A negative duration is returned if the key does not expire.
+func (c *Client) TTL(ctx context.Context, key string) (_ time.Duration, err error) {
+	ctx, span := startSpan(ctx, "PTTL")
+	defer func() { endSpan(span, err) }()
+
+	ttl, err := c.rdb.PTTL(ctx, key).Result()
+	if err != nil {
+		return 0, err
+	}
+
+	switch ttl {
+	case -2:
+		return 0, errors.New(errors.NotFound)
+	case -1:
+		return -1, nil
+	}
+	return ttl, nil
+}
+
+// Size returns the length in bytes of the value stored under the key.
+func (c *Client) Size(ctx context.Context, key string) (_ int64, err error) {
+	ctx, span := startSpan(ctx, "STRLEN")
+	defer func() { endSpan(span, err) }()
+
+	return c.rdb.StrLen(ctx, key).Result()
 }
@@ -0,0 +1,293 @@
+package redis
+
+import (
+	"context"
+	"crypto/sha1" //nolint:gosec
+	"encoding/hex"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/alicebob/miniredis/v2"
+	"github.com/redis/go-redis/v9"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	errors "github.com/eclipse-xfsc/microservice-core-go/pkg/err"
+)
+
+// runRedis starts an in-process Redis server and returns a client connected to it.
+func runRedis(t *testing.T) (*Client, *miniredis.Miniredis) {
+	t.Helper()
+
+	m := miniredis.RunT(t)
+	c := New(m.Addr(), "", "", 0, 0, false)
+	t.Cleanup(func() { _ = c.rdb.Close() })
+	return c, m
+}
+
+func sha1Hex(value string) string {
+	sum := sha1.Sum([]byte(value)) //nolint:gosec
+	return hex.EncodeToString(sum[:])
+}
+
+func TestClient_Set(t *testing.T) {
+	ctx := context.Background()
+	c, m := runRedis(t)
+
+	// xx requires an existing key, nx a missing one
+	err := c.Set(ctx, "key", []byte("v1"), 0, "xx", nil, "")
+	assert.True(t, errors.Is(errors.Exist, err))
+	assert.False(t, m.Exists("key"))
+
+	require.NoError(t, c.Set(ctx, "key", []byte("v1"), time.Minute, "nx", nil, ""))
+	assert.Equal(t, time.Minute, m.TTL("key"))
+
+	err = c.Set(ctx, "key", []byte("v2"), 0, "nx", nil, "")
+	assert.True(t, errors.Is(errors.Exist, err))
+
+	require.NoError(t, c.Set(ctx, "key", []byte("v2"), 0, "xx", nil, ""))
+	value, err := m.Get("key")
+	require.NoError(t, err)
+	assert.Equal(t, "v2", value)
+
+	err = c.Set(ctx, "key", []byte("v3"), 0, "if", nil, "")
+	assert.True(t, errors.Is(errors.BadRequest, err))
+}
+
+func TestClient_SetObsolete(t *testing.T) {
+	ctx := context.Background()
+	c, m := runRedis(t)
+
+	// lookups fall back to the obsolete key once the new one is gone,
+	// so it must not outlive the value written in its place
+	fallback := func(keys ...string) error {
+		var err error
+		for _, key := range keys {
+			if _, err = c.Get(ctx, key); err == nil || !errors.Is(errors.NotFound, err) {
+				break
+			}
+		}
+		return err
+	}
+
+	require.NoError(t, c.Set(ctx, "a,Login", []byte("old"), time.Hour, "", []string{"x"}, ""))
+	require.NoError(t, c.Set(ctx, "v2:Login::a", []byte("new"), time.Hour, "", []string{"x"}, "a,Login"))
+	assert.False(t, m.Exists("a,Login"))
+	assert.False(t, m.Exists(entryTagsKey("a,Login")))
+	members, err := m.ZMembers(tagKey("x"))
+	require.NoError(t, err)
+	assert.Equal(t, []string{"v2:Login::a"}, members)
+
+	n, err := c.InvalidateTag(ctx, "x")
+	require.NoError(t, err)
+	assert.Equal(t, int64(1), n)
+	assert.True(t, errors.Is(errors.NotFound, fallback("v2:Login::a", "a,Login")))
+
+	require.NoError(t, c.Set(ctx, "b,Login", []byte("old"), time.Hour, "", nil, ""))
+	require.NoError(t, c.Set(ctx, "v2:Login::b", []byte("new"), time.Minute, "", nil, "b,Login"))
+	m.FastForward(2 * time.Minute)
+	assert.True(t, errors.Is(errors.NotFound, fallback("v2:Login::b", "b,Login")))
+
+	require.NoError(t, c.Set(ctx, "c,Login", []byte("old"), time.Hour, "", nil, ""))
+	errs := c.SetMany(ctx, []string{"v2:Login::c"}, [][]byte{[]byte("new")}, []time.Duration{time.Minute}, []string{"c,Login"})
+	require.NoError(t, errs[0])
+	m.FastForward(2 * time.Minute)
+	assert.True(t, errors.Is(errors.NotFound, fallback("v2:Login::c", "c,Login")))
+}
+
+func TestClient_CompareAndSet(t *testing.T) {
+	ctx := context.Background()
+	c, m := runRedis(t)
+
+	err := c.CompareAndSet(ctx, "key", []byte("v2"), 0, sha1Hex("v1"))
+	assert.True(t, errors.Is(errors.Exist, err))
+	assert.False(t, m.Exists("key"))
+
+	require.NoError(t, m.Set("key", "v1"))
+
+	// the value is only replaced if the hash matches
+	err = c.CompareAndSet(ctx, "key", []byte("v2"), 0, sha1Hex("other"))
+	assert.True(t, errors.Is(errors.Exist, err))
+	value, _ := m.Get("key")
+	assert.Equal(t, "v1", value)
+
+	require.NoError(t, c.CompareAndSet(ctx, "key", []byte("v2"), time.Minute, sha1Hex("v1")))
+	value, _ = m.Get("key")
+	assert.Equal(t, "v2", value)
+	assert.Equal(t, time.Minute, m.TTL("key"))
+
+	// the previous hash no longer matches
+	err = c.CompareAndSet(ctx, "key", []byte("v3"), 0, sha1Hex("v1"))
+	assert.True(t, errors.Is(errors.Exist, err))
+}
+
+func TestClient_Update(t *testing.T) {
+	ctx := context.Background()
+	c, m := runRedis(t)
+
+	_, err := c.Update(ctx, "key", func(current []byte) ([]byte, error) { return current, nil })
+	assert.True(t, errors.Is(errors.NotFound, err))
+
+	require.NoError(t, m.Set("key", "1"))
+	m.SetTTL("key", time.Minute)
+
+	// a concurrent write is not lost, the update is retried with its value
+	other := redis.NewClient(&redis.Options{Addr: m.Addr()})
+	defer other.Close() //nolint:errcheck
+	calls := 0
+	updated, err := c.Update(ctx, "key", func(current []byte) ([]byte, error) {
+		calls++
+		if calls == 1 {
+			require.NoError(t, other.Set(ctx, "key", "10", redis.KeepTTL).Err())
+		}
+		return append(current, '0'), nil
+	})
+	require.NoError(t, err)
+	assert.Equal(t, 2, calls)
+	assert.Equal(t, "100", string(updated))
+	value, _ := m.Get("key")
+	assert.Equal(t, "100", value)
+	assert.Equal(t, time.Minute, m.TTL("key"))
+
+	// the update fails if the key is always modified concurrently
+	calls = 0
+	_, err = c.Update(ctx, "key", func(current []byte) ([]byte, error) {
+		calls++
+		require.NoError(t, other.Set(ctx, "key", fmt.Sprint(calls), redis.KeepTTL).Err())
+		return current, nil
+	})
+	assert.True(t, errors.Is(errors.Exist, err))
+	assert.Equal(t, maxUpdateRetries, calls)
+
+	// errors of fn are returned without changing the value
+	_, err = c.Update(ctx, "key", func(current []byte) ([]byte, error) {
+		return nil, errors.New(errors.BadRequest, "invalid patch")
+	})
+	assert.True(t, errors.Is(errors.BadRequest, err))
+}
+
+func TestClient_SetWithEvent(t *testing.T) {
+	ctx := context.Background()
+	c, m := runRedis(t)
+
+	events := func() []string {
+		var values []string
+		entries, err := m.Stream(outboxKeyPrefix)
+		if err != nil {
+			return nil
+		}
+		for _, e := range entries {
+			values = append(values, e.Values[1])
+		}
+		return values
+	}
+
+	tests := []struct {
+		name      string
+		condition string
+		hash      string
+		errkind   errors.Kind
+		stored    string
+	}{
+		{name: "xx with missing key", condition: "xx", errkind: errors.Exist},
+		{name: "hash with missing key", hash: sha1Hex("v1"), errkind: errors.Exist},
+		{name: "nx with missing key", condition: "nx", stored: "v1"},
+		{name: "nx with existing key", condition: "nx", errkind: errors.Exist, stored: "v1"},
+		{name: "modified value", hash: sha1Hex("other"), errkind: errors.Exist, stored: "v1"},
+		{name: "unmodified value", hash: sha1Hex("v1"), stored: "v2"},
+		{name: "xx with existing key", condition: "xx", stored: "v3"},
+		{name: "unknown condition", condition: "if", errkind: errors.BadRequest, stored: "v3"},
+	}
+
+	var expected []string
+	for i, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			value := fmt.Sprintf("v%d", len(expected)+1)
+			event := fmt.Sprintf("event%d", i)
+
+			err := c.SetWithEvent(ctx, "key", []byte(value), time.Minute, test.condition, test.hash, []byte(event))
+			if test.errkind != errors.Unknown {
+				assert.True(t, errors.Is(test.errkind, err), err)
+			} else {
+				require.NoError(t, err)
+				expected = append(expected, event)
+			}
+
+			// the event is only added together with the value
+			stored, _ := m.Get("key")
+			assert.Equal(t, test.stored, stored)
+			assert.Equal(t, expected, events())
+		})
+	}
+	assert.Equal(t, time.Minute, m.TTL("key"))
+}
+
+func TestClient_DeleteWithEvent(t *testing.T) {
+	ctx := context.Background()
+	c, m := runRedis(t)
+
+	err := c.DeleteWithEvent(ctx, "key", []byte("delete0"))
+	assert.True(t, errors.Is(errors.NotFound, err))
+	assert.False(t, m.Exists(outboxKeyPrefix))
+
+	require.NoError(t, c.SetWithEvent(ctx, "key", []byte("v1"), 0, "", "", []byte("set")))
+	require.NoError(t, c.SetTags(ctx, "key", []string{"tag"}, 0))
+	require.NoError(t, c.DeleteWithEvent(ctx, "key", []byte("delete1")))
+	assert.False(t, m.Exists("key"))
+	assert.False(t, m.Exists(entryTagsKey("key")))
+	assert.False(t, m.Exists(tagKey("tag")))
+
+	// the delete event is relayed after the set event of the key
+	var published []string
+	_, err = c.RelayOutbox(ctx, false, func(ctx context.Context, event []byte) error {
+		published = append(published, string(event))
+		return nil
+	})
+	require.NoError(t, err)
+	assert.Equal(t, []string{"set", "delete1"}, published)
+}
+
+func TestClient_RelayOutbox(t *testing.T) {
+	ctx := context.Background()
+	c, m := runRedis(t)
+
+	for i := 0; i < outboxBatchSize+5; i++ {
+		key := fmt.Sprintf("key%d", i)
+		require.NoError(t, c.SetWithEvent(ctx, key, []byte("{}"), 0, "", "", []byte(key)))
+	}
+	backlog, err := c.OutboxBacklog(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, int64(outboxBatchSize+5), backlog)
+
+	// a failed publish leaves the event and all following ones in the outbox
+	var published []string
+	relayed, err := c.RelayOutbox(ctx, false, func(ctx context.Context, event []byte) error {
+		if string(event) == "key3" {
+			return fmt.Errorf("broker unavailable")
+		}
+		published = append(published, string(event))
+		return nil
+	})
+	assert.Error(t, err)
+	assert.Equal(t, 3, relayed)
+	assert.Equal(t, []string{"key0", "key1", "key2"}, published)
+	backlog, _ = c.OutboxBacklog(ctx)
+	assert.Equal(t, int64(outboxBatchSize+2), backlog)
+
+	// the next relay continues in order with the failed event
+	published = nil
+	relayed, err = c.RelayOutbox(ctx, false, func(ctx context.Context, event []byte) error {
+		published = append(published, string(event))
+		return nil
+	})
+	require.NoError(t, err)
+	assert.Equal(t, outboxBatchSize+2, relayed)
+	assert.Equal(t, "key3", published[0])
+	assert.Equal(t, fmt.Sprintf("key%d", outboxBatchSize+4), published[len(published)-1])
+	backlog, _ = c.OutboxBacklog(ctx)
+	assert.Equal(t, int64(0), backlog)
+
+	// the lock of the stream is released
+	assert.False(t, m.Exists(outboxKeyPrefix+":lock"))
+}
@@ -0,0 +1,133 @@
+package redis
+
+import (
+	"context"
+	"time"
+
+	"github.com/redis/go-redis/v9"
+
+	errors "github.com/eclipse-xfsc/microservice-core-go/pkg/err"
+)
+
+const (
+	// jobKeyPrefix prefixes the hashes which hold the fields of a job.
+	jobKeyPrefix = "cache:job:"
+	// jobLockKeyPrefix prefixes the keys which hold the ID of the running job of a lock.
+	jobLockKeyPrefix = "cache:joblock:"
+	// jobLockTTL limits the time a lock is held without an update of its job,
+	// so that a job of a stopped instance doesn't block new jobs.
+	jobLockTTL = time.Minute
+	// maxJobStartRetries is the number of attempts to acquire a lock
+	// which is released while it is acquired.
+	maxJobStartRetries = 3
+)
+
+// extendLockScript sets the TTL of the lock KEYS[1] to ARGV[2] milliseconds
+// only if it is still held with the token ARGV[1].
+var extendLockScript = redis.NewScript(`
+if redis.call('GET', KEYS[1]) == ARGV[1] then
+	return redis.call('PEXPIRE', KEYS[1], ARGV[2])
+end
+return 0
+`)
+
+func jobKey(id string) string {
+	return jobKeyPrefix + id
+}
+
+func jobLockKey(lock string) string {
+	return jobLockKeyPrefix + lock
+}
+
+// StartJob stores the fields of the job, which expire after ttl, and acquires
+// the lock for it. If the lock is held by another running job, the new job is
+// dropped and the ID of the running job is returned instead of id.
+func (c *Client) StartJob(ctx context.Context, id, lock string, fields map[string]string, ttl time.Duration) (_ string, err error) {
+	ctx, span := startSpan(ctx, "JOB")
+	defer func() { endSpan(span, err) }()
+
+	// the job is stored first, so that the job of a held lock always exists
+	_, err = c.rdb.Pipelined(ctx, func(pipe redis.Pipeliner) error {
+		pipe.HSet(ctx, jobKey(id), fields)
+		pipe.PExpire(ctx, jobKey(id), ttl)
+		return nil
+	})
+	if err != nil {
+		return "", err
+	}
+
+	for i := 0; i < maxJobStartRetries; i++ {
+		locked, err := c.rdb.SetNX(ctx, jobLockKey(lock), id, jobLockTTL).Result()
+		if err != nil {
+			return "", err
+		}
+		if locked {
+			return id, nil
+		}
+
+		running, err := c.rdb.Get(ctx, jobLockKey(lock)).Result()
+		if err == redis.Nil {
+			// the running job finished in the meantime
+			continue
+		}
+		if err != nil {
+			return "", err
+		}
+		if err := c.rdb.Del(ctx, jobKey(id)).Err(); err != nil {
+			return "", err
+		}
+		return running, nil
+	}
+	return "", errors.New(errors.Exist, "job lock is acquired concurrently")
+}
+
+// UpdateJob sets the fields of the job, adds deleted to its "deleted" field
+// and extends the time to live of the job and of its lock, if the lock is
+// still held by the job.
+func (c *Client) UpdateJob(ctx context.Context, id, lock string, fields map[string]string, deleted int64, ttl time.Duration) (err error) {
+	ctx, span := startSpan(ctx, "JOB")
+	defer func() { endSpan(span, err) }()
+
+	_, err = c.rdb.Pipelined(ctx, func(pipe redis.Pipeliner) error {
+		if len(fields) > 0 {
+			pipe.HSet(ctx, jobKey(id), fields)
+		}
+		pipe.HIncrBy(ctx, jobKey(id), "deleted", deleted)
+		pipe.PExpire(ctx, jobKey(id), ttl)
+		extendLockScript.Eval(ctx, pipe, []string{jobLockKey(lock)}, id, jobLockTTL.Milliseconds())
+		return nil
+	})
+	return err
+}
+
+// FinishJob sets the final fields of the job, which expire after ttl,
+// and releases its lock.
+func (c *Client) FinishJob(ctx context.Context, id, lock string, fields map[string]string, ttl time.Duration) (err error) {
+	ctx, span := startSpan(ctx, "JOB")
+	defer func() { endSpan(span, err) }()
+
+	_, err = c.rdb.Pipelined(ctx, func(pipe redis.Pipeliner) error {
+		pipe.HSet(ctx, jobKey(id), fields)
+		pipe.PExpire(ctx, jobKey(id), ttl)
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+	return unlockScript.Run(ctx, c.rdb, []string{jobLockKey(lock)}, id).Err()
+}
+
+// GetJob returns the fields of the job.
+func (c *Client) GetJob(ctx context.Context, id string) (_ map[string]string, err error) {
+	ctx, span := startSpan(ctx, "HGETALL")
+	defer func() { endSpan(span, err) }()
+
+	fields, err := c.rdb.HGetAll(ctx, jobKey(id)).Result()
+	if err != nil {
+		return nil, err
+	}
+	if len(fields) == 0 {
+		return nil, errors.New(errors.NotFound)
+	}
+	return fields, nil
+}
@@ -0,0 +1,66 @@
+package redis
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	errors "github.com/eclipse-xfsc/microservice-core-go/pkg/err"
+)
+
+func TestClient_Jobs(t *testing.T) {
+	ctx := context.Background()
+	c, m := runRedis(t)
+
+	_, err := c.GetJob(ctx, "job1")
+	assert.True(t, errors.Is(errors.NotFound, err))
+
+	id, err := c.StartJob(ctx, "job1", "lock", map[string]string{"status": "running", "deleted": "0"}, time.Hour)
+	require.NoError(t, err)
+	assert.Equal(t, "job1", id)
+	assert.Equal(t, time.Hour, m.TTL(jobKey("job1")))
+	assert.Equal(t, jobLockTTL, m.TTL(jobLockKey("lock")))
+
+	// the running job of the lock is returned and the new job is dropped
+	id, err = c.StartJob(ctx, "job2", "lock", map[string]string{"status": "running"}, time.Hour)
+	require.NoError(t, err)
+	assert.Equal(t, "job1", id)
+	assert.False(t, m.Exists(jobKey("job2")))
+
+	// updates add to the deleted keys and extend the lock
+	m.FastForward(30 * time.Second)
+	require.NoError(t, c.UpdateJob(ctx, "job1", "lock", map[string]string{"updatedAt": "now"}, 3, time.Hour))
+	require.NoError(t, c.UpdateJob(ctx, "job1", "lock", nil, 2, time.Hour))
+	assert.Equal(t, jobLockTTL, m.TTL(jobLockKey("lock")))
+
+	fields, err := c.GetJob(ctx, "job1")
+	require.NoError(t, err)
+	assert.Equal(t, map[string]string{"status": "running", "deleted": "5", "updatedAt": "now"}, fields)
+
+	// a stale job doesn't extend the lock of another job
+	m.FastForward(30 * time.Second)
+	require.NoError(t, m.Set(jobLockKey("lock"), "job2"))
+	m.SetTTL(jobLockKey("lock"), 10*time.Second)
+	require.NoError(t, c.UpdateJob(ctx, "job1", "lock", nil, 0, time.Hour))
+	assert.Equal(t, 10*time.Second, m.TTL(jobLockKey("lock")))
+	require.NoError(t, m.Set(jobLockKey("lock"), "job1"))
+
+	// a finished job releases its lock
+	require.NoError(t, c.FinishJob(ctx, "job1", "lock", map[string]string{"status": "completed"}, time.Hour))
+	assert.False(t, m.Exists(jobLockKey("lock")))
+	fields, err = c.GetJob(ctx, "job1")
+	require.NoError(t, err)
+	assert.Equal(t, "completed", fields["status"])
+
+	id, err = c.StartJob(ctx, "job3", "lock", map[string]string{"status": "running"}, time.Hour)
+	require.NoError(t, err)
+	assert.Equal(t, "job3", id)
+
+	// finished jobs expire
+	m.FastForward(time.Hour)
+	_, err = c.GetJob(ctx, "job1")
+	assert.True(t, errors.Is(errors.NotFound, err))
+}
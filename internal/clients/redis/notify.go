@@ -0,0 +1,158 @@
+package redis
+
+import (
+	"context"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/redis/go-redis/v9"
+	"golang.org/x/sync/errgroup"
+)
+
+// expiredChannelPattern matches the keyevent notifications of expired keys in all databases.
+const expiredChannelPattern = "__keyevent@*__:expired"
+
+const (
+	// expiredClaimKeyPrefix prefixes the keys with which an instance claims
+	// the event of an expired key.
+	expiredClaimKeyPrefix = "cache:expired:"
+	// expiredClaimTTL is the time for which the event of an expired key is
+	// claimed. It only has to cover the delivery of the notification to all
+	// instances, so that a key which expires again later gets a new event.
+	expiredClaimTTL = 10 * time.Second
+)
+
+// notifyNode is a Redis server which publishes keyspace notifications.
+type notifyNode interface {
+	PSubscribe(ctx context.Context, channels ...string) *redis.PubSub
+	ConfigGet(ctx context.Context, parameter string) *redis.MapStringStringCmd
+}
+
+// SubscribeExpired calls fn with the key of every entry which expires in the
+// cache until ctx is done. Keyspace notifications are not propagated in a
+// cluster, so every master node is subscribed and fn may be called concurrently.
+// Keys of the tag index, the outbox, jobs and expiry claims are skipped.
+func (c *Client) SubscribeExpired(ctx context.Context, fn func(ctx context.Context, key string)) error {
+	nodes, err := c.notifyNodes(ctx)
+	if err != nil {
+		return err
+	}
+
+	g, ctx := errgroup.WithContext(ctx)
+	for _, node := range nodes {
+		g.Go(func() error {
+			return subscribeExpired(ctx, node, fn)
+		})
+	}
+	return g.Wait()
+}
+
+func subscribeExpired(ctx context.Context, node notifyNode, fn func(ctx context.Context, key string)) error {
+	pubsub := node.PSubscribe(ctx, expiredChannelPattern)
+	defer pubsub.Close() //nolint:errcheck
+
+	// wait for the confirmation, so that a failed subscription is returned
+	if _, err := pubsub.Receive(ctx); err != nil {
+		if ctx.Err() != nil {
+			return nil
+		}
+		return err
+	}
+
+	// the channel is closed with the subscription and reconnects on errors
+	ch := pubsub.Channel()
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case msg, ok := <-ch:
+			if !ok {
+				return nil
+			}
+			if IsInternalKey(msg.Payload) {
+				continue
+			}
+			fn(ctx, msg.Payload)
+		}
+	}
+}
+
+// ExpiredNotificationsEnabled reports whether all nodes publish keyevent
+// notifications of expired keys, which requires "E" and "x" (or "A") in the
+// notify-keyspace-events setting. An error is returned if the setting
+// cannot be read, e.g. because the CONFIG command is disabled.
+func (c *Client) ExpiredNotificationsEnabled(ctx context.Context) (bool, error) {
+	nodes, err := c.notifyNodes(ctx)
+	if err != nil {
+		return false, err
+	}
+
+	for _, node := range nodes {
+		config, err := node.ConfigGet(ctx, "notify-keyspace-events").Result()
+		if err != nil {
+			return false, err
+		}
+		if !expiredNotifications(config["notify-keyspace-events"]) {
+			return false, nil
+		}
+	}
+	return true, nil
+}
+
+// ClaimExpired reports whether the caller is the first instance to claim the
+// event of the expired key, so that only one instance publishes it.
+func (c *Client) ClaimExpired(ctx context.Context, key string) (_ bool, err error) {
+	ctx, span := startSpan(ctx, "SET")
+	defer func() { endSpan(span, err) }()
+
+	return c.rdb.SetNX(ctx, expiredClaimKeyPrefix+key, "", expiredClaimTTL).Result()
+}
+
+// internalKeyPrefixes are the prefixes of the keys used by the client itself.
+var internalKeyPrefixes = []string{
+	tagKeyPrefix,
+	entryTagsKeyPrefix,
+	outboxKeyPrefix,
+	jobKeyPrefix,
+	jobLockKeyPrefix,
+	expiredClaimKeyPrefix,
+}
+
+// IsInternalKey reports whether the key is used by the client itself rather
+// than holding a cache entry. Legacy storage keys begin with the key of their
+// entry, so entry keys with these prefixes cannot be told apart from them.
+func IsInternalKey(key string) bool {
+	for _, prefix := range internalKeyPrefixes {
+		if strings.HasPrefix(key, prefix) {
+			return true
+		}
+	}
+	return false
+}
+
+func expiredNotifications(flags string) bool {
+	return strings.Contains(flags, "E") && strings.ContainsAny(flags, "xA")
+}
+
+// notifyNodes returns the nodes whose keyspace notifications are subscribed.
+// In cluster mode these are all master nodes.
+func (c *Client) notifyNodes(ctx context.Context) ([]notifyNode, error) {
+	cluster, ok := c.rdb.(*redis.ClusterClient)
+	if !ok {
+		return []notifyNode{c.rdb}, nil
+	}
+
+	var mu sync.Mutex
+	var nodes []notifyNode
+	err := cluster.ForEachMaster(ctx, func(ctx context.Context, client *redis.Client) error {
+		mu.Lock()
+		defer mu.Unlock()
+		nodes = append(nodes, client)
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return nodes, nil
+}
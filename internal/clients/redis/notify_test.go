@@ -0,0 +1,51 @@
+package redis
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestExpiredNotifications(t *testing.T) {
+	assert.True(t, expiredNotifications("Ex"))
+	assert.True(t, expiredNotifications("xE"))
+	assert.True(t, expiredNotifications("AKE"))
+	assert.False(t, expiredNotifications(""))
+	assert.False(t, expiredNotifications("Kx"))
+	assert.False(t, expiredNotifications("E$"))
+}
+
+func TestClient_ClaimExpired(t *testing.T) {
+	ctx := context.Background()
+	c, m := runRedis(t)
+
+	claimed, err := c.ClaimExpired(ctx, "key")
+	require.NoError(t, err)
+	assert.True(t, claimed)
+
+	// another instance receiving the same notification
+	claimed, err = c.ClaimExpired(ctx, "key")
+	require.NoError(t, err)
+	assert.False(t, claimed)
+
+	// the key expiring again later gets a new event
+	m.FastForward(expiredClaimTTL)
+	claimed, err = c.ClaimExpired(ctx, "key")
+	require.NoError(t, err)
+	assert.True(t, claimed)
+	assert.True(t, IsInternalKey(expiredClaimKeyPrefix+"key"))
+}
+
+func TestIsInternalKey(t *testing.T) {
+	assert.True(t, IsInternalKey(tagKey("tag")))
+	assert.True(t, IsInternalKey(entryTagsKey("key,Login")))
+	assert.True(t, IsInternalKey(outboxKeyPrefix))
+	assert.True(t, IsInternalKey(jobKey("id")))
+	assert.True(t, IsInternalKey(jobLockKey("lock")))
+	assert.True(t, IsInternalKey(expiredClaimKeyPrefix+"key,Login"))
+	assert.False(t, IsInternalKey("key,Login"))
+	assert.False(t, IsInternalKey("v2:Login::key"))
+	assert.False(t, IsInternalKey("cache:key"))
+}
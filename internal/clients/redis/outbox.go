@@ -0,0 +1,319 @@
+package redis
+
+import (
+	"context"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/redis/go-redis/v9"
+
+	errors "github.com/eclipse-xfsc/microservice-core-go/pkg/err"
+)
+
+const (
+	// outboxKeyPrefix is the key of the outbox stream. In cluster mode every
+	// hash slot has its own stream, so that it can be written atomically with
+	// the values of the slot.
+	outboxKeyPrefix = "cache:outbox"
+	// outboxEventField is the stream entry field which holds the encoded event.
+	outboxEventField = "event"
+	// outboxBatchSize is the number of events which are read from a stream at once.
+	outboxBatchSize = 100
+	// outboxLockTTL limits the time a relay holds the lock of a stream.
+	outboxLockTTL = 30 * time.Second
+)
+
+// setOutboxScript sets KEYS[1] to ARGV[1] and adds the event ARGV[5] to the
+// outbox stream KEYS[2] in one transaction. ARGV[2] is the TTL in milliseconds.
+// If ARGV[4] is not empty, the value is only set if the SHA-1 of the current
+// value equals it (-1: missing, 0: modified), otherwise the condition ARGV[3]
+// "nx" or "xx" is checked (-2: not met).
+var setOutboxScript = redis.NewScript(`
+if ARGV[4] ~= '' then
+	local current = redis.call('GET', KEYS[1])
+	if not current then
+		return -1
+	end
+	if redis.sha1hex(current) ~= ARGV[4] then
+		return 0
+	end
+elseif ARGV[3] == 'nx' and redis.call('EXISTS', KEYS[1]) == 1 then
+	return -2
+elseif ARGV[3] == 'xx' and redis.call('EXISTS', KEYS[1]) == 0 then
+	return -2
+end
+if tonumber(ARGV[2]) > 0 then
+	redis.call('SET', KEYS[1], ARGV[1], 'PX', ARGV[2])
+else
+	redis.call('SET', KEYS[1], ARGV[1])
+end
+redis.call('XADD', KEYS[2], '*', 'event', ARGV[5])
+return 1
+`)
+
+// deleteOutboxScript deletes KEYS[1] and adds the event ARGV[1] to the outbox
+// stream KEYS[2] in one transaction. Nothing is added if the key is missing.
+var deleteOutboxScript = redis.NewScript(`
+if redis.call('DEL', KEYS[1]) == 0 then
+	return 0
+end
+redis.call('XADD', KEYS[2], '*', 'event', ARGV[1])
+return 1
+`)
+
+// unlockScript deletes the lock KEYS[1] only if it is still held with the token ARGV[1].
+var unlockScript = redis.NewScript(`
+if redis.call('GET', KEYS[1]) == ARGV[1] then
+	return redis.call('DEL', KEYS[1])
+end
+return 0
+`)
+
+// outbox tracks the streams which got events from this client since
+// they were relayed, so that they are relayed without a full sweep.
+type outbox struct {
+	mu    sync.Mutex
+	dirty map[string]struct{}
+}
+
+func (o *outbox) mark(stream string) {
+	o.mu.Lock()
+	defer o.mu.Unlock()
+	if o.dirty == nil {
+		o.dirty = map[string]struct{}{}
+	}
+	o.dirty[stream] = struct{}{}
+}
+
+func (o *outbox) take() []string {
+	o.mu.Lock()
+	defer o.mu.Unlock()
+	streams := make([]string, 0, len(o.dirty))
+	for stream := range o.dirty {
+		streams = append(streams, stream)
+	}
+	o.dirty = nil
+	return streams
+}
+
+var (
+	slotTagsOnce sync.Once
+	slotTags     [slotCount]string
+)
+
+// slotTag returns a hash tag which maps to the slot.
+func slotTag(s int) string {
+	slotTagsOnce.Do(func() {
+		for i, found := 0, 0; found < slotCount; i++ {
+			tag := strconv.FormatInt(int64(i), 36)
+			if t := crc16(tag) % slotCount; slotTags[t] == "" {
+				slotTags[t] = tag
+				found++
+			}
+		}
+	})
+	return slotTags[s]
+}
+
+// outboxKey returns the outbox stream which is in the same hash slot as the key.
+func (c *Client) outboxKey(key string) string {
+	if !c.cluster {
+		return outboxKeyPrefix
+	}
+	return outboxKeyPrefix + ":{" + slotTag(slot(key)) + "}"
+}
+
+// outboxKeys returns all outbox streams.
+func (c *Client) outboxKeys() []string {
+	if !c.cluster {
+		return []string{outboxKeyPrefix}
+	}
+	keys := make([]string, slotCount)
+	for s := range keys {
+		keys[s] = outboxKeyPrefix + ":{" + slotTag(s) + "}"
+	}
+	return keys
+}
+
+// SetWithEvent stores the value under the key like Set, or like CompareAndSet
+// if hash is not empty, and adds the encoded event to the outbox in the same
+// transaction. The event is published by RelayOutbox.
+func (c *Client) SetWithEvent(ctx context.Context, key string, value []byte, ttl time.Duration, condition, hash string, event []byte) (err error) {
+	ctx, span := startSpan(ctx, "EVALSHA")
+	defer func() { endSpan(span, err) }()
+
+	if ttl == 0 {
+		ttl = c.defaultTTL
+	}
+	if condition != "" && condition != "nx" && condition != "xx" {
+		return errors.New(errors.BadRequest, "unknown set condition: "+condition)
+	}
+
+	stream := c.outboxKey(key)
+	res, err := setOutboxScript.Run(ctx, c.rdb, []string{key, stream}, value, ttl.Milliseconds(), condition, hash, event).Int()
+	if err != nil {
+		return err
+	}
+
+	switch res {
+	case -2:
+		return errors.New(errors.Exist, "set condition not met")
+	case -1:
+		return errors.New(errors.Exist, "key does not exist")
+	case 0:
+		return errors.New(errors.Exist, "value has been modified")
+	}
+
+	if c.cluster {
+		c.outbox.mark(stream)
+	}
+	return nil
+}
+
+// DeleteWithEvent removes the key like Delete and adds the encoded event to
+// the outbox in the same transaction, so that it is relayed after the events
+// of previous writes of the key. If the key does not exist, no event is added
+// and an error of kind errors.NotFound is returned.
+func (c *Client) DeleteWithEvent(ctx context.Context, key string, event []byte) (err error) {
+	ctx, span := startSpan(ctx, "EVALSHA")
+	defer func() { endSpan(span, err) }()
+
+	stream := c.outboxKey(key)
+	res, err := deleteOutboxScript.Run(ctx, c.rdb, []string{key, stream}, event).Int()
+	if err != nil {
+		return err
+	}
+	if res == 1 && c.cluster {
+		c.outbox.mark(stream)
+	}
+
+	// tags are in other hash slots in cluster mode, so they are removed afterwards
+	if err := c.deleteTags(ctx, key); err != nil {
+		return err
+	}
+	if res == 0 {
+		return errors.New(errors.NotFound)
+	}
+	return nil
+}
+
+// RelayOutbox passes the events of the outbox to publish in the order in which
+// they were added and removes every event once it is published. The streams
+// written by this client are relayed, or all streams if all is true. A stream
+// is relayed by a single client at a time and left for the next relay at the
+// first error, so events are published at least once. The number of relayed
+// events is returned.
+func (c *Client) RelayOutbox(ctx context.Context, all bool, publish func(ctx context.Context, event []byte) error) (relayed int, err error) {
+	var streams []string
+	switch {
+	case !c.cluster:
+		streams = []string{outboxKeyPrefix}
+	case all:
+		lengths, err := c.outboxLengths(ctx)
+		if err != nil {
+			return 0, err
+		}
+		for stream := range lengths {
+			streams = append(streams, stream)
+		}
+	default:
+		streams = c.outbox.take()
+	}
+
+	for i, stream := range streams {
+		n, err := c.relayStream(ctx, stream, publish)
+		relayed += n
+		if err != nil {
+			// the remaining streams are relayed with the next call
+			if c.cluster {
+				for _, s := range streams[i:] {
+					c.outbox.mark(s)
+				}
+			}
+			return relayed, err
+		}
+	}
+	return relayed, nil
+}
+
+func (c *Client) relayStream(ctx context.Context, stream string, publish func(ctx context.Context, event []byte) error) (relayed int, err error) {
+	lock := stream + ":lock"
+	token := uuid.NewString()
+	locked, err := c.rdb.SetNX(ctx, lock, token, outboxLockTTL).Result()
+	if err != nil {
+		return 0, err
+	}
+	if !locked {
+		// another client relays the stream, which may have missed
+		// the latest events, so the stream is tried again
+		if c.cluster {
+			c.outbox.mark(stream)
+		}
+		return 0, nil
+	}
+	defer func() {
+		// the lock expires if it cannot be released
+		_ = unlockScript.Run(context.WithoutCancel(ctx), c.rdb, []string{lock}, token).Err()
+	}()
+
+	for {
+		messages, err := c.rdb.XRangeN(ctx, stream, "-", "+", outboxBatchSize).Result()
+		if err != nil {
+			return relayed, err
+		}
+
+		for _, msg := range messages {
+			event, _ := msg.Values[outboxEventField].(string)
+			if err := publish(ctx, []byte(event)); err != nil {
+				return relayed, err
+			}
+			if err := c.rdb.XDel(ctx, stream, msg.ID).Err(); err != nil {
+				return relayed, err
+			}
+			relayed++
+		}
+
+		if len(messages) < outboxBatchSize {
+			return relayed, nil
+		}
+	}
+}
+
+// OutboxBacklog returns the number of events in the outbox.
+func (c *Client) OutboxBacklog(ctx context.Context) (int64, error) {
+	lengths, err := c.outboxLengths(ctx)
+	if err != nil {
+		return 0, err
+	}
+
+	var backlog int64
+	for _, n := range lengths {
+		backlog += n
+	}
+	return backlog, nil
+}
+
+// outboxLengths returns the lengths of the outbox streams which are not empty.
+func (c *Client) outboxLengths(ctx context.Context) (map[string]int64, error) {
+	keys := c.outboxKeys()
+	cmds := make([]*redis.IntCmd, len(keys))
+	_, err := c.rdb.Pipelined(ctx, func(pipe redis.Pipeliner) error {
+		for i, key := range keys {
+			cmds[i] = pipe.XLen(ctx, key)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	lengths := map[string]int64{}
+	for i, cmd := range cmds {
+		if n := cmd.Val(); n > 0 {
+			lengths[keys[i]] = n
+		}
+	}
+	return lengths, nil
+}
@@ -0,0 +1,37 @@
+package redis
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSlotTag(t *testing.T) {
+	for s := 0; s < slotCount; s++ {
+		assert.Equal(t, s, slot(slotTag(s)))
+	}
+}
+
+func TestOutboxKey(t *testing.T) {
+	c := &Client{}
+	assert.Equal(t, "cache:outbox", c.outboxKey("key,namespace,scope"))
+	assert.Equal(t, []string{"cache:outbox"}, c.outboxKeys())
+
+	// in cluster mode the stream is in the slot of the key
+	c = &Client{cluster: true}
+	for _, key := range []string{"key,namespace,scope", "v2:namespace:scope:key", "{tag}key"} {
+		assert.Equal(t, slot(key), slot(c.outboxKey(key)), key)
+	}
+	assert.Len(t, c.outboxKeys(), slotCount)
+}
+
+func TestOutbox(t *testing.T) {
+	var o outbox
+	assert.Empty(t, o.take())
+
+	o.mark("a")
+	o.mark("b")
+	o.mark("a")
+	assert.ElementsMatch(t, []string{"a", "b"}, o.take())
+	assert.Empty(t, o.take())
+}
@@ -0,0 +1,111 @@
+package redis
+
+import (
+	"context"
+	"encoding/base64"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+
+	"github.com/redis/go-redis/v9"
+
+	errors "github.com/eclipse-xfsc/microservice-core-go/pkg/err"
+)
+
+// scanNode is a Redis server which is iterated with SCAN.
+type scanNode struct {
+	addr   string
+	client redis.Cmdable
+}
+
+// Scan returns keys matching the glob pattern match with a single SCAN call,
+// starting at the cursor returned by the previous call. In cluster mode all
+// master nodes are iterated one after another. The returned cursor is opaque
+// and empty when the iteration is complete; an empty cursor starts a new one.
+func (c *Client) Scan(ctx context.Context, cursor, match string, count int64) (_ []string, _ string, err error) {
+	ctx, span := startSpan(ctx, "SCAN")
+	defer func() { endSpan(span, err) }()
+
+	nodes, err := c.scanNodes(ctx)
+	if err != nil {
+		return nil, "", err
+	}
+
+	addr, pos, err := decodeScanCursor(cursor)
+	if err != nil {
+		return nil, "", err
+	}
+
+	index := 0
+	if addr != "" {
+		index = sort.Search(len(nodes), func(i int) bool { return nodes[i].addr >= addr })
+		if index == len(nodes) || nodes[index].addr != addr {
+			return nil, "", errors.New(errors.BadRequest, "invalid cursor: unknown node")
+		}
+	}
+
+	keys, next, err := nodes[index].client.Scan(ctx, pos, match, count).Result()
+	if err != nil {
+		return nil, "", err
+	}
+
+	if next == 0 {
+		index++
+		if index == len(nodes) {
+			return keys, "", nil
+		}
+	}
+	return keys, encodeScanCursor(nodes[index].addr, next), nil
+}
+
+// scanNodes returns the nodes to scan. In cluster mode these are
+// the master nodes sorted by address, so their order is stable.
+func (c *Client) scanNodes(ctx context.Context) ([]scanNode, error) {
+	cluster, ok := c.rdb.(*redis.ClusterClient)
+	if !ok {
+		return []scanNode{{client: c.rdb}}, nil
+	}
+
+	var mu sync.Mutex
+	var nodes []scanNode
+	err := cluster.ForEachMaster(ctx, func(ctx context.Context, client *redis.Client) error {
+		mu.Lock()
+		defer mu.Unlock()
+		nodes = append(nodes, scanNode{addr: client.Options().Addr, client: client})
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	sort.Slice(nodes, func(i, j int) bool { return nodes[i].addr < nodes[j].addr })
+	return nodes, nil
+}
+
+// encodeScanCursor combines the node address and its SCAN cursor into an opaque cursor.
+func encodeScanCursor(addr string, pos uint64) string {
+	return base64.RawURLEncoding.EncodeToString([]byte(addr + "#" + strconv.FormatUint(pos, 10)))
+}
+
+func decodeScanCursor(cursor string) (string, uint64, error) {
+	if cursor == "" {
+		return "", 0, nil
+	}
+
+	b, err := base64.RawURLEncoding.DecodeString(cursor)
+	if err != nil {
+		return "", 0, errors.New(errors.BadRequest, "invalid cursor", err)
+	}
+
+	i := strings.LastIndexByte(string(b), '#')
+	if i < 0 {
+		return "", 0, errors.New(errors.BadRequest, "invalid cursor")
+	}
+
+	pos, err := strconv.ParseUint(string(b[i+1:]), 10, 64)
+	if err != nil {
+		return "", 0, errors.New(errors.BadRequest, "invalid cursor", err)
+	}
+	return string(b[:i]), pos, nil
+}
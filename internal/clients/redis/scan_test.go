@@ -0,0 +1,32 @@
+package redis
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	errors "github.com/eclipse-xfsc/microservice-core-go/pkg/err"
+)
+
+func TestScanCursor(t *testing.T) {
+	addr, pos, err := decodeScanCursor("")
+	require.NoError(t, err)
+	assert.Equal(t, "", addr)
+	assert.Equal(t, uint64(0), pos)
+
+	addr, pos, err = decodeScanCursor(encodeScanCursor("10.0.0.1:6379", 42))
+	require.NoError(t, err)
+	assert.Equal(t, "10.0.0.1:6379", addr)
+	assert.Equal(t, uint64(42), pos)
+
+	addr, pos, err = decodeScanCursor(encodeScanCursor("", 7))
+	require.NoError(t, err)
+	assert.Equal(t, "", addr)
+	assert.Equal(t, uint64(7), pos)
+
+	for _, cursor := range []string{"%%%", "bm8tY3Vyc29y", encodeScanCursor("addr", 1) + "x"} {
+		_, _, err = decodeScanCursor(cursor)
+		assert.True(t, errors.Is(errors.BadRequest, err), cursor)
+	}
+}
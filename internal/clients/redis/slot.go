@@ -0,0 +1,60 @@
+package redis
+
+import "strings"
+
+// slotCount is the number of hash slots of a Redis cluster.
+const slotCount = 16384
+
+// slot returns the hash slot of the key as computed by Redis cluster:
+// CRC16 of the key, or of its hash tag if it contains one, modulo 16384.
+func slot(key string) int {
+	if start := strings.IndexByte(key, '{'); start >= 0 {
+		if end := strings.IndexByte(key[start+1:], '}'); end > 0 {
+			key = key[start+1 : start+1+end]
+		}
+	}
+	return int(crc16(key) % slotCount)
+}
+
+// crc16 implements the CRC16-CCITT (XModem) checksum used by Redis cluster.
+func crc16(s string) uint16 {
+	var crc uint16
+	for i := 0; i < len(s); i++ {
+		crc ^= uint16(s[i]) << 8
+		for j := 0; j < 8; j++ {
+			if crc&0x8000 != 0 {
+				crc = crc<<1 ^ 0x1021
+			} else {
+				crc <<= 1
+			}
+		}
+	}
+	return crc
+}
+
+// groupBySlot groups the indexes of the keys by their hash slot in cluster
+// mode, as multi-key commands fail if their keys belong to different slots.
+// Without cluster all indexes are returned as a single group.
+func (c *Client) groupBySlot(keys []string) [][]int {
+	if !c.cluster {
+		group := make([]int, len(keys))
+		for i := range keys {
+			group[i] = i
+		}
+		return [][]int{group}
+	}
+
+	var groups [][]int
+	slots := map[int]int{}
+	for i, key := range keys {
+		s := slot(key)
+		g, ok := slots[s]
+		if !ok {
+			g = len(groups)
+			slots[s] = g
+			groups = append(groups, nil)
+		}
+		groups[g] = append(groups[g], i)
+	}
+	return groups
+}
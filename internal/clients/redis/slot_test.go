@@ -0,0 +1,27 @@
+package redis
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSlot(t *testing.T) {
+	// expected values are returned by CLUSTER KEYSLOT
+	assert.Equal(t, 12182, slot("foo"))
+	assert.Equal(t, 11058, slot("somekey"))
+	assert.Equal(t, slot("user1000"), slot("{user1000}.following"))
+	assert.Equal(t, slot("user1000"), slot("{user1000}.followers"))
+	// empty hash tags are ignored
+	assert.Equal(t, slot("foo{}{bar}"), int(crc16("foo{}{bar}")%slotCount))
+}
+
+func TestGroupBySlot(t *testing.T) {
+	keys := []string{"{a}1", "{b}1", "{a}2", "{b}2", "{c}1"}
+
+	c := &Client{}
+	assert.Equal(t, [][]int{{0, 1, 2, 3, 4}}, c.groupBySlot(keys))
+
+	c = &Client{cluster: true}
+	assert.Equal(t, [][]int{{0, 2}, {1, 3}, {4}}, c.groupBySlot(keys))
+}
@@ -0,0 +1,220 @@
+package redis
+
+import (
+	"context"
+	"strconv"
+	"time"
+
+	"github.com/redis/go-redis/v9"
+)
+
+const (
+	// tagKeyPrefix prefixes the sorted sets which hold the keys of a tag,
+	// scored by their expiration time in Unix milliseconds.
+	tagKeyPrefix = "cache:tag:"
+	// entryTagsKeyPrefix prefixes the sets which hold the tags of a key.
+	entryTagsKeyPrefix = "cache:tags:"
+)
+
+// tagBatchSize is the number of tagged keys which are invalidated in one pipeline.
+const tagBatchSize = 500
+
+// addTagScript adds ARGV[1] with the expiration time ARGV[2] to the tag set
+// KEYS[1], removes expired keys from it and lets the set expire with its last key.
+// ARGV[3] is the current time in Unix milliseconds.
+var addTagScript = redis.NewScript(`
+redis.call('ZADD', KEYS[1], ARGV[2], ARGV[1])
+redis.call('ZREMRANGEBYSCORE', KEYS[1], '-inf', '(' .. ARGV[3])
+local last = redis.call('ZRANGE', KEYS[1], -1, -1, 'WITHSCORES')
+if last[2] == 'inf' then
+	redis.call('PERSIST', KEYS[1])
+else
+	redis.call('PEXPIREAT', KEYS[1], last[2])
+end
+return 1
+`)
+
+func tagKey(tag string) string {
+	return tagKeyPrefix + tag
+}
+
+func entryTagsKey(key string) string {
+	return entryTagsKeyPrefix + key
+}
+
+// SetTags replaces the tags of the key. The key is removed from the sets of
+// its previous tags and added to the sets of the new ones, which expire with
+// the key after ttl. Without tags only the previous tags are removed.
+// Commands are sent in pipelines, as tag sets and keys belong to different
+// hash slots in cluster mode. The previous tags are read in the same pipeline,
+// so a second round trip is only needed if tags are dropped.
+func (c *Client) SetTags(ctx context.Context, key string, tags []string, ttl time.Duration) (err error) {
+	ctx, span := startSpan(ctx, "TAG")
+	defer func() { endSpan(span, err) }()
+
+	if ttl == 0 {
+		ttl = c.defaultTTL
+	}
+
+	var previous *redis.StringSliceCmd
+	_, err = c.rdb.Pipelined(ctx, func(pipe redis.Pipeliner) error {
+		previous = replaceTags(ctx, pipe, key, tags, ttl)
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+	return c.untag(ctx, []string{key}, [][]string{droppedTags(previous.Val(), tags)})
+}
+
+// replaceTags queues the commands which replace the tags of the key with
+// tags, kept for ttl, and returns the command which reads the previous tags.
+func replaceTags(ctx context.Context, pipe redis.Pipeliner, key string, tags []string, ttl time.Duration) *redis.StringSliceCmd {
+	previous := pipe.SMembers(ctx, entryTagsKey(key))
+	pipe.Del(ctx, entryTagsKey(key))
+	if len(tags) == 0 {
+		return previous
+	}
+
+	members := make([]interface{}, len(tags))
+	for i, tag := range tags {
+		members[i] = tag
+	}
+	addTags(ctx, pipe, key, tags, ttl)
+	pipe.SAdd(ctx, entryTagsKey(key), members...)
+	if ttl > 0 {
+		pipe.PExpire(ctx, entryTagsKey(key), ttl)
+	}
+	return previous
+}
+
+// droppedTags returns the previous tags which are not in tags.
+func droppedTags(previous, tags []string) []string {
+	current := make(map[string]bool, len(tags))
+	for _, tag := range tags {
+		current[tag] = true
+	}
+
+	var dropped []string
+	for _, tag := range previous {
+		if !current[tag] {
+			dropped = append(dropped, tag)
+		}
+	}
+	return dropped
+}
+
+// deleteTags removes the tags of the key.
+func (c *Client) deleteTags(ctx context.Context, key string) error {
+	var tags *redis.StringSliceCmd
+	_, err := c.rdb.Pipelined(ctx, func(pipe redis.Pipeliner) error {
+		tags = pipe.SMembers(ctx, entryTagsKey(key))
+		pipe.Del(ctx, entryTagsKey(key))
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+	return c.untag(ctx, []string{key}, [][]string{tags.Val()})
+}
+
+// untag removes each key from the sets of its tags in a single pipeline,
+// which is only sent if there is a tag to remove.
+func (c *Client) untag(ctx context.Context, keys []string, tags [][]string) error {
+	var n int
+	for _, keyTags := range tags {
+		n += len(keyTags)
+	}
+	if n == 0 {
+		return nil
+	}
+
+	_, err := c.rdb.Pipelined(ctx, func(pipe redis.Pipeliner) error {
+		for i, key := range keys {
+			for _, tag := range tags[i] {
+				pipe.ZRem(ctx, tagKey(tag), key)
+			}
+		}
+		return nil
+	})
+	return err
+}
+
+// addTags queues the commands which add the key to the sets of the tags,
+// so that it is kept in them for ttl.
+func addTags(ctx context.Context, pipe redis.Pipeliner, key string, tags []string, ttl time.Duration) {
+	now := time.Now()
+	expiration := "+inf"
+	if ttl > 0 {
+		expiration = strconv.FormatInt(now.Add(ttl).UnixMilli(), 10)
+	}
+
+	for _, tag := range tags {
+		addTagScript.Eval(ctx, pipe, []string{tagKey(tag)}, key, expiration, now.UnixMilli())
+	}
+}
+
+// InvalidateTag deletes all keys which carry the tag and the tag set itself,
+// and returns the number of deleted keys. Keys which have been overwritten
+// without the tag since they were tagged are not deleted.
+func (c *Client) InvalidateTag(ctx context.Context, tag string) (_ int64, err error) {
+	ctx, span := startSpan(ctx, "INVALIDATE")
+	defer func() { endSpan(span, err) }()
+
+	keys, err := c.rdb.ZRangeByScore(ctx, tagKey(tag), &redis.ZRangeBy{
+		Min: strconv.FormatInt(time.Now().UnixMilli(), 10),
+		Max: "+inf",
+	}).Result()
+	if err != nil {
+		return 0, err
+	}
+
+	var deleted int64
+	for start := 0; start < len(keys); start += tagBatchSize {
+		end := min(start+tagBatchSize, len(keys))
+		n, err := c.invalidateKeys(ctx, tag, keys[start:end])
+		if err != nil {
+			return deleted, err
+		}
+		deleted += n
+	}
+
+	if err := c.rdb.Del(ctx, tagKey(tag)).Err(); err != nil {
+		return deleted, err
+	}
+	return deleted, nil
+}
+
+// invalidateKeys deletes the keys which still carry the tag together with their tags.
+func (c *Client) invalidateKeys(ctx context.Context, tag string, keys []string) (int64, error) {
+	tagged := make([]*redis.BoolCmd, len(keys))
+	_, err := c.rdb.Pipelined(ctx, func(pipe redis.Pipeliner) error {
+		for i, key := range keys {
+			tagged[i] = pipe.SIsMember(ctx, entryTagsKey(key), tag)
+		}
+		return nil
+	})
+	if err != nil {
+		return 0, err
+	}
+
+	var unlinked []*redis.IntCmd
+	_, err = c.rdb.Pipelined(ctx, func(pipe redis.Pipeliner) error {
+		for i, key := range keys {
+			if tagged[i].Val() {
+				unlinked = append(unlinked, pipe.Unlink(ctx, key))
+				pipe.Unlink(ctx, entryTagsKey(key))
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return 0, err
+	}
+
+	var deleted int64
+	for _, cmd := range unlinked {
+		deleted += cmd.Val()
+	}
+	return deleted, nil
+}
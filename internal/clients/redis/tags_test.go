@@ -0,0 +1,60 @@
+package redis
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestClient_Tags(t *testing.T) {
+	ctx := context.Background()
+	c, m := runRedis(t)
+
+	tagged := func(tag string) []string {
+		if !m.Exists(tagKey(tag)) {
+			return nil
+		}
+		members, err := m.ZMembers(tagKey(tag))
+		require.NoError(t, err)
+		return members
+	}
+
+	require.NoError(t, c.Set(ctx, "a", []byte("1"), time.Minute, "", []string{"x", "y"}, ""))
+	require.NoError(t, c.Set(ctx, "b", []byte("1"), time.Minute, "", []string{"x"}, ""))
+	assert.ElementsMatch(t, []string{"a", "b"}, tagged("x"))
+	assert.Equal(t, []string{"a"}, tagged("y"))
+
+	// overwriting a key removes it from the sets of its dropped tags
+	require.NoError(t, c.Set(ctx, "a", []byte("2"), time.Minute, "xx", []string{"x"}, ""))
+	assert.Nil(t, tagged("y"))
+	members, _ := m.Members(entryTagsKey("a"))
+	assert.Equal(t, []string{"x"}, members)
+
+	// a key which isn't stored keeps its tags
+	require.Error(t, c.Set(ctx, "a", []byte("3"), time.Minute, "nx", nil, ""))
+	assert.ElementsMatch(t, []string{"a", "b"}, tagged("x"))
+
+	require.NoError(t, c.SetTags(ctx, "b", []string{"z"}, time.Minute))
+	assert.Equal(t, []string{"a"}, tagged("x"))
+	assert.Equal(t, []string{"b"}, tagged("z"))
+
+	// deleted keys are removed from their tags
+	require.NoError(t, c.Delete(ctx, "a"))
+	assert.Nil(t, tagged("x"))
+	assert.False(t, m.Exists(entryTagsKey("a")))
+
+	n, err := c.Unlink(ctx, []string{"b", "c"})
+	require.NoError(t, err)
+	assert.Equal(t, int64(1), n)
+	assert.Nil(t, tagged("z"))
+	assert.False(t, m.Exists(entryTagsKey("b")))
+
+	require.NoError(t, c.Set(ctx, "d", []byte("1"), 0, "", []string{"x"}, ""))
+	errs := c.SetMany(ctx, []string{"d"}, [][]byte{[]byte("2")}, []time.Duration{0}, nil)
+	assert.NoError(t, errs[0])
+	assert.Nil(t, tagged("x"))
+	assert.False(t, m.Exists(entryTagsKey("d")))
+}
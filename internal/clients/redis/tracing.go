@@ -0,0 +1,36 @@
+package redis
+
+import (
+	"context"
+
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/trace"
+
+	errors "github.com/eclipse-xfsc/microservice-core-go/pkg/err"
+)
+
+const tracerName = "github.com/eclipse-xfsc/redis-cache-service/internal/clients/redis"
+
+// startSpan starts a client span for a Redis operation. Keys are not recorded
+// as attributes, because they may contain personal data.
+func startSpan(ctx context.Context, operation string) (context.Context, trace.Span) {
+	return otel.Tracer(tracerName).Start(ctx, "redis."+operation,
+		trace.WithSpanKind(trace.SpanKindClient),
+		trace.WithAttributes(
+			attribute.String("db.system", "redis"),
+			attribute.String("db.operation", operation),
+		),
+	)
+}
+
+// endSpan records the error of a Redis operation, if any, and ends the span.
+// Missing keys are an expected outcome and are not recorded as errors.
+func endSpan(span trace.Span, err error) {
+	if err != nil && !errors.Is(errors.NotFound, err) {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+	}
+	span.End()
+}
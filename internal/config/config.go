@@ -4,9 +4,14 @@ import "time"
 
 type Config struct {
 	HTTP    httpConfig
+	Cache   cacheConfig
 	Redis   redisConfig
 	Nats    natsConfig
+	Webhook webhookConfig
+	Kafka   kafkaConfig
+	Events  eventsConfig
 	Metrics metricsConfig
+	Tracing tracingConfig
 	Auth    authConfig
 
 	LogLevel string `envconfig:"LOG_LEVEL" default:"INFO"`
@@ -21,6 +26,23 @@ type httpConfig struct {
 	WriteTimeout time.Duration `envconfig:"HTTP_WRITE_TIMEOUT" default:"10s"`
 }
 
+type cacheConfig struct {
+	// KeyFormat specifies how cache keys are composed: "legacy" (comma separated) or "v2"
+	KeyFormat string `envconfig:"CACHE_KEY_FORMAT" default:"legacy"`
+	// LegacyKeyFallback enables reading entries stored with the legacy key format
+	// when they are not found under the configured one (migration mode)
+	LegacyKeyFallback bool `envconfig:"CACHE_KEY_LEGACY_FALLBACK" default:"false"`
+	// SlidingTTL maps namespaces to the TTL to which their entries are reset on
+	// every read (sliding expiration), e.g. "Login:30m,Session:1h"
+	SlidingTTL map[string]time.Duration `envconfig:"CACHE_SLIDING_TTL"`
+	// TTLPolicyFile is the path of a YAML file with the default TTL,
+	// maximum TTL and TTL requirement of namespaces
+	TTLPolicyFile string `envconfig:"CACHE_TTL_POLICY_FILE"`
+	// ExpiredEvents enables publishing events for expired entries, which are
+	// received from Redis keyspace notifications
+	ExpiredEvents bool `envconfig:"CACHE_EXPIRED_EVENTS" default:"true"`
+}
+
 type redisConfig struct {
 	// Addr specifies network address of Redis server
 	Addr    string        `envconfig:"REDIS_ADDR" required:"true"`
@@ -32,15 +54,76 @@ type redisConfig struct {
 }
 
 type natsConfig struct {
-	// Addr specifies network address of NATS server
-	Addr string `envconfig:"NATS_ADDR" required:"true"`
+	// Addr specifies network address of NATS server, it is required by the nats transport
+	Addr string `envconfig:"NATS_ADDR"`
 	// Subject specifies NATS subject to publish events to
 	Subject string `envconfig:"NATS_SUBJECT" default:"external"`
+	// JetStream publishes events to NATS JetStream and waits for their acknowledgement
+	JetStream bool `envconfig:"NATS_JETSTREAM" default:"false"`
+	// Stream specifies the JetStream stream which captures the subject,
+	// it is verified at startup if set
+	Stream string `envconfig:"NATS_STREAM"`
+	// CreateStream creates the JetStream stream if it doesn't exist
+	CreateStream bool `envconfig:"NATS_STREAM_CREATE" default:"false"`
+	// AckTimeout limits the time to wait for the acknowledgement of a JetStream publish
+	AckTimeout time.Duration `envconfig:"NATS_ACK_TIMEOUT" default:"5s"`
+}
+
+type webhookConfig struct {
+	// URL specifies the webhook which receives the events of the http transport
+	URL string `envconfig:"WEBHOOK_URL"`
+	// Timeout limits the time of a webhook request
+	Timeout time.Duration `envconfig:"WEBHOOK_TIMEOUT" default:"10s"`
+	// Headers are added to every webhook request, e.g. "Authorization:Bearer token"
+	Headers map[string]string `envconfig:"WEBHOOK_HEADERS"`
+}
+
+type kafkaConfig struct {
+	// Brokers is a comma separated list of Kafka broker addresses, it is required by the kafka transport
+	Brokers []string `envconfig:"KAFKA_BROKERS"`
+	// Topic specifies Kafka topic to produce events to
+	Topic string `envconfig:"KAFKA_TOPIC" default:"external"`
+}
+
+type eventsConfig struct {
+	// Transport specifies how events are published: "nats", "http" (webhook),
+	// "kafka" or "none" to run without message broker and external input
+	Transport string `envconfig:"EVENTS_TRANSPORT" default:"nats"`
+	// Payload maps namespaces to the payload mode of their events: "key" (default),
+	// "value" to embed the stored value or "hash" to embed its SHA-256 hash,
+	// the mode of "*" applies to all other namespaces, e.g. "Login:hash,Profile:value"
+	Payload map[string]string `envconfig:"EVENTS_PAYLOAD"`
+	// OutboxInterval is the time between two relays of the events which are
+	// stored in the outbox together with external input
+	OutboxInterval time.Duration `envconfig:"EVENTS_OUTBOX_INTERVAL" default:"1s"`
+	// OutboxSweepInterval is the time between two relays of all outbox streams,
+	// including the ones written by other instances, it also updates the backlog metric
+	OutboxSweepInterval time.Duration `envconfig:"EVENTS_OUTBOX_SWEEP_INTERVAL" default:"30s"`
+	// OutboxMaxBackoff limits the time between two relays after publish errors
+	OutboxMaxBackoff time.Duration `envconfig:"EVENTS_OUTBOX_MAX_BACKOFF" default:"1m"`
+	// BrokerOptional reports the message broker in the readiness checks without
+	// failing readiness, as events wait in the outbox while it is unavailable
+	BrokerOptional bool `envconfig:"EVENTS_BROKER_OPTIONAL" default:"false"`
 }
 
 type metricsConfig struct {
 	// Addr specifies the address to expose prometheus metrics
 	Addr string `envconfig:"METRICS_ADDR" default:":2112"`
+	// Namespaces is a comma separated allow-list of cache namespaces used as metric labels,
+	// all other namespaces are reported as "other" to keep the label cardinality bounded
+	Namespaces []string `envconfig:"METRICS_NAMESPACES"`
+}
+
+type tracingConfig struct {
+	// Exporter specifies where spans are exported: "none", "otlp" or "stdout"
+	Exporter string `envconfig:"TRACING_EXPORTER" default:"none"`
+	// OTLPEndpoint specifies the host:port of the OTLP HTTP collector,
+	// if empty the standard OTEL_EXPORTER_OTLP_* variables are used
+	OTLPEndpoint string `envconfig:"TRACING_OTLP_ENDPOINT"`
+	// OTLPInsecure disables TLS for the connection to the OTLP collector
+	OTLPInsecure bool `envconfig:"TRACING_OTLP_INSECURE" default:"false"`
+	// SampleRatio specifies the ratio of sampled traces without a sampled parent
+	SampleRatio float64 `envconfig:"TRACING_SAMPLE_RATIO" default:"1"`
 }
 
 type authConfig struct {
@@ -0,0 +1,176 @@
+// Package metrics defines the Prometheus collectors of the cache service.
+//
+// All collectors are registered in the default Prometheus registry, which
+// is exposed on the metrics address. Namespace labels are limited to the
+// namespaces configured with SetNamespaces, so that clients cannot create
+// an unbounded number of time series.
+package metrics
+
+import (
+	"context"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+	goa "goa.design/goa/v3/pkg"
+
+	"github.com/eclipse-xfsc/redis-cache-service/internal/service"
+)
+
+const (
+	metricsNamespace = "cache"
+
+	// NamespaceNone is the label value for requests without namespace.
+	NamespaceNone = "none"
+	// NamespaceOther is the label value for namespaces which are not in the allow-list.
+	NamespaceOther = "other"
+
+	resultSuccess = "success"
+	resultFailure = "failure"
+)
+
+var (
+	requestsTotal = promauto.NewCounterVec(prometheus.CounterOpts{
+		Namespace: metricsNamespace,
+		Name:      "requests_total",
+		Help:      "Total number of cache API requests by method and status.",
+	}, []string{"method", "status"})
+
+	requestDuration = promauto.NewHistogramVec(prometheus.HistogramOpts{
+		Namespace: metricsNamespace,
+		Name:      "request_duration_seconds",
+		Help:      "Duration of cache API requests by method.",
+		Buckets:   prometheus.DefBuckets,
+	}, []string{"method"})
+
+	lookupsTotal = promauto.NewCounterVec(prometheus.CounterOpts{
+		Namespace: metricsNamespace,
+		Name:      "lookups_total",
+		Help:      "Total number of cache lookups by namespace and result (hit or miss).",
+	}, []string{"namespace", "result"})
+
+	valueSize = promauto.NewHistogramVec(prometheus.HistogramOpts{
+		Namespace: metricsNamespace,
+		Name:      "value_size_bytes",
+		Help:      "Size of the cached values by namespace and operation.",
+		Buckets:   prometheus.ExponentialBuckets(64, 4, 10), // 64B ... 16MB
+	}, []string{"namespace", "operation"})
+
+	redisCommandDuration = promauto.NewHistogramVec(prometheus.HistogramOpts{
+		Namespace: metricsNamespace,
+		Name:      "redis_command_duration_seconds",
+		Help:      "Duration of Redis commands by command name.",
+		Buckets:   []float64{.0005, .001, .0025, .005, .01, .025, .05, .1, .25, .5, 1},
+	}, []string{"command"})
+
+	eventsPublished = promauto.NewCounterVec(prometheus.CounterOpts{
+		Namespace: metricsNamespace,
+		Name:      "events_published_total",
+		Help:      "Total number of published events by type and result (success or failure).",
+	}, []string{"type", "result"})
+
+	outboxBacklog = promauto.NewGauge(prometheus.GaugeOpts{
+		Namespace: metricsNamespace,
+		Name:      "outbox_backlog",
+		Help:      "Number of events in the outbox which are not yet published.",
+	})
+)
+
+var (
+	mu         sync.RWMutex
+	namespaces = map[string]struct{}{}
+)
+
+// SetNamespaces sets the allow-list of namespaces which are used as label values.
+// All other namespaces are reported as NamespaceOther.
+func SetNamespaces(allowed []string) {
+	mu.Lock()
+	defer mu.Unlock()
+
+	namespaces = make(map[string]struct{}, len(allowed))
+	for _, ns := range allowed {
+		namespaces[ns] = struct{}{}
+	}
+}
+
+// NamespaceLabel returns the label value for the given namespace.
+func NamespaceLabel(namespace *string) string {
+	if namespace == nil || *namespace == "" {
+		return NamespaceNone
+	}
+
+	mu.RLock()
+	defer mu.RUnlock()
+
+	if _, ok := namespaces[*namespace]; ok {
+		return *namespace
+	}
+	return NamespaceOther
+}
+
+// Endpoint is a goa endpoint middleware which records the number
+// and the duration of requests per service method.
+func Endpoint(e goa.Endpoint) goa.Endpoint {
+	return func(ctx context.Context, req interface{}) (interface{}, error) {
+		method, _ := ctx.Value(goa.MethodKey).(string)
+
+		start := time.Now()
+		res, err := e(ctx, req)
+		requestDuration.WithLabelValues(method).Observe(time.Since(start).Seconds())
+
+		status := "ok"
+		if err != nil {
+			status = errorStatus(ctx, err)
+		}
+		requestsTotal.WithLabelValues(method, status).Inc()
+
+		return res, err
+	}
+}
+
+// errorStatus returns the status label of an error. Errors defined in
+// the design, e.g. not_modified, are labeled with their name and all
+// other errors with their HTTP status code.
+func errorStatus(ctx context.Context, err error) string {
+	if namer, ok := err.(goa.GoaErrorNamer); ok {
+		if _, isServiceErr := err.(*goa.ServiceError); !isServiceErr {
+			return namer.GoaErrorName()
+		}
+	}
+	return strconv.Itoa(service.NewErrorResponse(ctx, err).StatusCode())
+}
+
+// ObserveLookup records a cache hit or miss for the given namespace.
+func ObserveLookup(namespace *string, hit bool) {
+	result := "miss"
+	if hit {
+		result = "hit"
+	}
+	lookupsTotal.WithLabelValues(NamespaceLabel(namespace), result).Inc()
+}
+
+// ObserveValueSize records the size of a value read from or written to the cache.
+func ObserveValueSize(namespace *string, operation string, size int) {
+	valueSize.WithLabelValues(NamespaceLabel(namespace), operation).Observe(float64(size))
+}
+
+// ObserveRedisCommand records the duration of a Redis command.
+func ObserveRedisCommand(command string, duration time.Duration) {
+	redisCommandDuration.WithLabelValues(command).Observe(duration.Seconds())
+}
+
+// ObserveEventPublish records the result of publishing an event.
+func ObserveEventPublish(eventType string, err error) {
+	result := resultSuccess
+	if err != nil {
+		result = resultFailure
+	}
+	eventsPublished.WithLabelValues(eventType, result).Inc()
+}
+
+// SetOutboxBacklog records the number of unpublished events in the outbox.
+func SetOutboxBacklog(backlog int64) {
+	outboxBacklog.Set(float64(backlog))
+}
@@ -0,0 +1,21 @@
+package metrics_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	ptr "github.com/eclipse-xfsc/microservice-core-go/pkg/ptr"
+	"github.com/eclipse-xfsc/redis-cache-service/internal/metrics"
+)
+
+func TestNamespaceLabel(t *testing.T) {
+	metrics.SetNamespaces([]string{"Login", "Issuance"})
+	defer metrics.SetNamespaces(nil)
+
+	assert.Equal(t, metrics.NamespaceNone, metrics.NamespaceLabel(nil))
+	assert.Equal(t, metrics.NamespaceNone, metrics.NamespaceLabel(ptr.String("")))
+	assert.Equal(t, "Login", metrics.NamespaceLabel(ptr.String("Login")))
+	assert.Equal(t, metrics.NamespaceOther, metrics.NamespaceLabel(ptr.String("login")))
+	assert.Equal(t, metrics.NamespaceOther, metrics.NamespaceLabel(ptr.String("did:web:random")))
+}
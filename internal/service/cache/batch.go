@@ -0,0 +1,196 @@
+package cache
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"time"
+
+	"go.uber.org/zap"
+
+	errors "github.com/eclipse-xfsc/microservice-core-go/pkg/err"
+	"github.com/eclipse-xfsc/redis-cache-service/gen/cache"
+	"github.com/eclipse-xfsc/redis-cache-service/internal/metrics"
+)
+
+// entryRef identifies a cache entry by the fields of a request.
+type entryRef struct {
+	key       string
+	namespace *string
+	scope     *string
+}
+
+// BatchGet returns multiple values from the cache with a status for each item.
+// The scope of an item is used as is and is not split into multiple scopes.
+func (s *Service) BatchGet(ctx context.Context, req *cache.CacheBatchGetRequest) ([]*cache.CacheBatchGetResult, error) {
+	logger := s.logger.With(zap.String("operation", "batchGet"))
+
+	results := make([]*cache.CacheBatchGetResult, len(req.Items))
+	refs := make([]entryRef, 0, len(req.Items))
+	indexes := make([]int, 0, len(req.Items))
+	for i, item := range req.Items {
+		results[i] = &cache.CacheBatchGetResult{
+			Key:       item.Key,
+			Namespace: item.Namespace,
+			Scope:     item.Scope,
+		}
+		if msg := invalidKey(item.Key); msg != "" {
+			setBatchGetError(results[i], http.StatusBadRequest, msg)
+			continue
+		}
+		refs = append(refs, entryRef{key: item.Key, namespace: item.Namespace, scope: item.Scope})
+		indexes = append(indexes, i)
+	}
+
+	if len(refs) == 0 {
+		return results, nil
+	}
+
+	values, err := s.readMany(ctx, refs, 0)
+	if err != nil {
+		logger.Error("error getting values from cache", zap.Error(err))
+		return nil, errors.New("error getting values from cache", err)
+	}
+
+	for i, value := range values {
+		res := results[indexes[i]]
+		if value == nil {
+			setBatchGetError(res, http.StatusNotFound, "key not found in cache")
+			continue
+		}
+
+		decodedValue, err := unmarshalCacheData(value)
+		if err != nil {
+			setBatchGetError(res, http.StatusInternalServerError, "cannot decode json value from cache")
+			continue
+		}
+		res.Status = http.StatusOK
+		res.Data = decodedValue
+	}
+
+	return results, nil
+}
+
+// BatchSet stores multiple values in the cache with a status for each item.
+func (s *Service) BatchSet(ctx context.Context, req *cache.CacheBatchSetRequest) ([]*cache.CacheBatchSetResult, error) {
+	logger := s.logger.With(zap.String("operation", "batchSet"))
+
+	results := make([]*cache.CacheBatchSetResult, len(req.Items))
+	keys := make([]string, 0, len(req.Items))
+	values := make([][]byte, 0, len(req.Items))
+	ttls := make([]time.Duration, 0, len(req.Items))
+	obsolete := make([]string, 0, len(req.Items))
+	indexes := make([]int, 0, len(req.Items))
+	for i, item := range req.Items {
+		results[i] = &cache.CacheBatchSetResult{
+			Key:       item.Key,
+			Namespace: item.Namespace,
+			Scope:     item.Scope,
+		}
+		if msg := invalidKey(item.Key); msg != "" {
+			setBatchSetError(results[i], http.StatusBadRequest, msg)
+			continue
+		}
+
+		value, err := json.Marshal(item.Data)
+		if err != nil {
+			setBatchSetError(results[i], http.StatusBadRequest, "cannot encode payload to json")
+			continue
+		}
+
+		var ttl time.Duration
+		if item.TTL != nil {
+			ttl = time.Duration(*item.TTL) * time.Second
+		}
+		ttl, err = s.applyTTLPolicy(item.Namespace, ttl)
+		if err != nil {
+			setBatchSetError(results[i], http.StatusBadRequest, err.Error())
+			continue
+		}
+
+		keys = append(keys, s.cacheKey(item.Key, item.Namespace, item.Scope))
+		obsolete = append(obsolete, s.obsoleteKey(item.Key, item.Namespace, item.Scope))
+		values = append(values, value)
+		ttls = append(ttls, ttl)
+		indexes = append(indexes, i)
+	}
+
+	if len(keys) == 0 {
+		return results, nil
+	}
+
+	for i, err := range s.cache.SetMany(ctx, keys, values, ttls, obsolete) {
+		res := results[indexes[i]]
+		if err != nil {
+			logger.Error("error storing value in cache", zap.Error(err))
+			setBatchSetError(res, http.StatusInternalServerError, "error storing value in cache")
+			continue
+		}
+		res.Status = http.StatusCreated
+		metrics.ObserveValueSize(res.Namespace, "set", len(values[i]))
+	}
+
+	return results, nil
+}
+
+// readMany returns the stored bytes of multiple cache entries in the order
+// of the given references. The value of a missing entry is nil. If touch is
+// positive, the TTL of the entries is reset to it.
+func (s *Service) readMany(ctx context.Context, refs []entryRef, touch time.Duration) ([][]byte, error) {
+	getMany := s.cache.GetMany
+	if touch > 0 {
+		getMany = func(ctx context.Context, keys []string) ([][]byte, error) {
+			return s.cache.GetManyEx(ctx, keys, touch)
+		}
+	}
+
+	keys := make([]string, len(refs))
+	for i, ref := range refs {
+		keys[i] = s.cacheKey(ref.key, ref.namespace, ref.scope)
+	}
+
+	values, err := getMany(ctx, keys)
+	if err != nil {
+		return nil, err
+	}
+
+	// retry missing entries with their legacy keys in migration mode
+	if s.legacyFallback && s.keyFormat != KeyFormatLegacy {
+		var legacyKeys []string
+		var missing []int
+		for i, value := range values {
+			if value == nil {
+				legacyKeys = append(legacyKeys, makeCacheKey(refs[i].key, refs[i].namespace, refs[i].scope))
+				missing = append(missing, i)
+			}
+		}
+		if len(legacyKeys) > 0 {
+			legacyValues, err := getMany(ctx, legacyKeys)
+			if err != nil {
+				return nil, err
+			}
+			for i, value := range legacyValues {
+				values[missing[i]] = value
+			}
+		}
+	}
+
+	for i, value := range values {
+		metrics.ObserveLookup(refs[i].namespace, value != nil)
+		if value != nil {
+			metrics.ObserveValueSize(refs[i].namespace, "get", len(value))
+		}
+	}
+
+	return values, nil
+}
+
+func setBatchGetError(res *cache.CacheBatchGetResult, status int, msg string) {
+	res.Status = status
+	res.Error = &msg
+}
+
+func setBatchSetError(res *cache.CacheBatchSetResult, status int, msg string) {
+	res.Status = status
+	res.Error = &msg
+}
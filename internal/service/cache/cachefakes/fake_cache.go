@@ -10,6 +10,75 @@ import (
 )
 
 type FakeCache struct {
+	CompareAndSetStub        func(context.Context, string, []byte, time.Duration, string) error
+	compareAndSetMutex       sync.RWMutex
+	compareAndSetArgsForCall []struct {
+		arg1 context.Context
+		arg2 string
+		arg3 []byte
+		arg4 time.Duration
+		arg5 string
+	}
+	compareAndSetReturns struct {
+		result1 error
+	}
+	compareAndSetReturnsOnCall map[int]struct {
+		result1 error
+	}
+	DeleteStub        func(context.Context, string) error
+	deleteMutex       sync.RWMutex
+	deleteArgsForCall []struct {
+		arg1 context.Context
+		arg2 string
+	}
+	deleteReturns struct {
+		result1 error
+	}
+	deleteReturnsOnCall map[int]struct {
+		result1 error
+	}
+	DeleteWithEventStub        func(context.Context, string, []byte) error
+	deleteWithEventMutex       sync.RWMutex
+	deleteWithEventArgsForCall []struct {
+		arg1 context.Context
+		arg2 string
+		arg3 []byte
+	}
+	deleteWithEventReturns struct {
+		result1 error
+	}
+	deleteWithEventReturnsOnCall map[int]struct {
+		result1 error
+	}
+	ExistsStub        func(context.Context, string) (bool, error)
+	existsMutex       sync.RWMutex
+	existsArgsForCall []struct {
+		arg1 context.Context
+		arg2 string
+	}
+	existsReturns struct {
+		result1 bool
+		result2 error
+	}
+	existsReturnsOnCall map[int]struct {
+		result1 bool
+		result2 error
+	}
+	FinishJobStub        func(context.Context, string, string, map[string]string, time.Duration) error
+	finishJobMutex       sync.RWMutex
+	finishJobArgsForCall []struct {
+		arg1 context.Context
+		arg2 string
+		arg3 string
+		arg4 map[string]string
+		arg5 time.Duration
+	}
+	finishJobReturns struct {
+		result1 error
+	}
+	finishJobReturnsOnCall map[int]struct {
+		result1 error
+	}
 	GetStub        func(context.Context, string) ([]byte, error)
 	getMutex       sync.RWMutex
 	getArgsForCall []struct {
@@ -24,13 +93,106 @@ type FakeCache struct {
 		result1 []byte
 		result2 error
 	}
-	SetStub        func(context.Context, string, []byte, time.Duration) error
+	GetExStub        func(context.Context, string, time.Duration) ([]byte, error)
+	getExMutex       sync.RWMutex
+	getExArgsForCall []struct {
+		arg1 context.Context
+		arg2 string
+		arg3 time.Duration
+	}
+	getExReturns struct {
+		result1 []byte
+		result2 error
+	}
+	getExReturnsOnCall map[int]struct {
+		result1 []byte
+		result2 error
+	}
+	GetJobStub        func(context.Context, string) (map[string]string, error)
+	getJobMutex       sync.RWMutex
+	getJobArgsForCall []struct {
+		arg1 context.Context
+		arg2 string
+	}
+	getJobReturns struct {
+		result1 map[string]string
+		result2 error
+	}
+	getJobReturnsOnCall map[int]struct {
+		result1 map[string]string
+		result2 error
+	}
+	GetManyStub        func(context.Context, []string) ([][]byte, error)
+	getManyMutex       sync.RWMutex
+	getManyArgsForCall []struct {
+		arg1 context.Context
+		arg2 []string
+	}
+	getManyReturns struct {
+		result1 [][]byte
+		result2 error
+	}
+	getManyReturnsOnCall map[int]struct {
+		result1 [][]byte
+		result2 error
+	}
+	GetManyExStub        func(context.Context, []string, time.Duration) ([][]byte, error)
+	getManyExMutex       sync.RWMutex
+	getManyExArgsForCall []struct {
+		arg1 context.Context
+		arg2 []string
+		arg3 time.Duration
+	}
+	getManyExReturns struct {
+		result1 [][]byte
+		result2 error
+	}
+	getManyExReturnsOnCall map[int]struct {
+		result1 [][]byte
+		result2 error
+	}
+	InvalidateTagStub        func(context.Context, string) (int64, error)
+	invalidateTagMutex       sync.RWMutex
+	invalidateTagArgsForCall []struct {
+		arg1 context.Context
+		arg2 string
+	}
+	invalidateTagReturns struct {
+		result1 int64
+		result2 error
+	}
+	invalidateTagReturnsOnCall map[int]struct {
+		result1 int64
+		result2 error
+	}
+	ScanStub        func(context.Context, string, string, int64) ([]string, string, error)
+	scanMutex       sync.RWMutex
+	scanArgsForCall []struct {
+		arg1 context.Context
+		arg2 string
+		arg3 string
+		arg4 int64
+	}
+	scanReturns struct {
+		result1 []string
+		result2 string
+		result3 error
+	}
+	scanReturnsOnCall map[int]struct {
+		result1 []string
+		result2 string
+		result3 error
+	}
+	SetStub        func(context.Context, string, []byte, time.Duration, string, []string, string) error
 	setMutex       sync.RWMutex
 	setArgsForCall []struct {
 		arg1 context.Context
 		arg2 string
 		arg3 []byte
 		arg4 time.Duration
+		arg5 string
+		arg6 []string
+		arg7 string
 	}
 	setReturns struct {
 		result1 error
@@ -38,95 +200,297 @@ type FakeCache struct {
 	setReturnsOnCall map[int]struct {
 		result1 error
 	}
+	SetManyStub        func(context.Context, []string, [][]byte, []time.Duration, []string) []error
+	setManyMutex       sync.RWMutex
+	setManyArgsForCall []struct {
+		arg1 context.Context
+		arg2 []string
+		arg3 [][]byte
+		arg4 []time.Duration
+		arg5 []string
+	}
+	setManyReturns struct {
+		result1 []error
+	}
+	setManyReturnsOnCall map[int]struct {
+		result1 []error
+	}
+	SetTagsStub        func(context.Context, string, []string, time.Duration) error
+	setTagsMutex       sync.RWMutex
+	setTagsArgsForCall []struct {
+		arg1 context.Context
+		arg2 string
+		arg3 []string
+		arg4 time.Duration
+	}
+	setTagsReturns struct {
+		result1 error
+	}
+	setTagsReturnsOnCall map[int]struct {
+		result1 error
+	}
+	SetWithEventStub        func(context.Context, string, []byte, time.Duration, string, string, []byte) error
+	setWithEventMutex       sync.RWMutex
+	setWithEventArgsForCall []struct {
+		arg1 context.Context
+		arg2 string
+		arg3 []byte
+		arg4 time.Duration
+		arg5 string
+		arg6 string
+		arg7 []byte
+	}
+	setWithEventReturns struct {
+		result1 error
+	}
+	setWithEventReturnsOnCall map[int]struct {
+		result1 error
+	}
+	SizeStub        func(context.Context, string) (int64, error)
+	sizeMutex       sync.RWMutex
+	sizeArgsForCall []struct {
+		arg1 context.Context
+		arg2 string
+	}
+	sizeReturns struct {
+		result1 int64
+		result2 error
+	}
+	sizeReturnsOnCall map[int]struct {
+		result1 int64
+		result2 error
+	}
+	StartJobStub        func(context.Context, string, string, map[string]string, time.Duration) (string, error)
+	startJobMutex       sync.RWMutex
+	startJobArgsForCall []struct {
+		arg1 context.Context
+		arg2 string
+		arg3 string
+		arg4 map[string]string
+		arg5 time.Duration
+	}
+	startJobReturns struct {
+		result1 string
+		result2 error
+	}
+	startJobReturnsOnCall map[int]struct {
+		result1 string
+		result2 error
+	}
+	TTLStub        func(context.Context, string) (time.Duration, error)
+	tTLMutex       sync.RWMutex
+	tTLArgsForCall []struct {
+		arg1 context.Context
+		arg2 string
+	}
+	tTLReturns struct {
+		result1 time.Duration
+		result2 error
+	}
+	tTLReturnsOnCall map[int]struct {
+		result1 time.Duration
+		result2 error
+	}
+	UnlinkStub        func(context.Context, []string) (int64, error)
+	unlinkMutex       sync.RWMutex
+	unlinkArgsForCall []struct {
+		arg1 context.Context
+		arg2 []string
+	}
+	unlinkReturns struct {
+		result1 int64
+		result2 error
+	}
+	unlinkReturnsOnCall map[int]struct {
+		result1 int64
+		result2 error
+	}
+	UpdateStub        func(context.Context, string, func([]byte) ([]byte, error)) ([]byte, error)
+	updateMutex       sync.RWMutex
+	updateArgsForCall []struct {
+		arg1 context.Context
+		arg2 string
+		arg3 func([]byte) ([]byte, error)
+	}
+	updateReturns struct {
+		result1 []byte
+		result2 error
+	}
+	updateReturnsOnCall map[int]struct {
+		result1 []byte
+		result2 error
+	}
+	UpdateJobStub        func(context.Context, string, string, map[string]string, int64, time.Duration) error
+	updateJobMutex       sync.RWMutex
+	updateJobArgsForCall []struct {
+		arg1 context.Context
+		arg2 string
+		arg3 string
+		arg4 map[string]string
+		arg5 int64
+		arg6 time.Duration
+	}
+	updateJobReturns struct {
+		result1 error
+	}
+	updateJobReturnsOnCall map[int]struct {
+		result1 error
+	}
 	invocations      map[string][][]interface{}
 	invocationsMutex sync.RWMutex
 }
 
-func (fake *FakeCache) Get(arg1 context.Context, arg2 string) ([]byte, error) {
-	fake.getMutex.Lock()
-	ret, specificReturn := fake.getReturnsOnCall[len(fake.getArgsForCall)]
-	fake.getArgsForCall = append(fake.getArgsForCall, struct {
+func (fake *FakeCache) CompareAndSet(arg1 context.Context, arg2 string, arg3 []byte, arg4 time.Duration, arg5 string) error {
+	var arg3Copy []byte
+	if arg3 != nil {
+		arg3Copy = make([]byte, len(arg3))
+		copy(arg3Copy, arg3)
+	}
+	fake.compareAndSetMutex.Lock()
+	ret, specificReturn := fake.compareAndSetReturnsOnCall[len(fake.compareAndSetArgsForCall)]
+	fake.compareAndSetArgsForCall = append(fake.compareAndSetArgsForCall, struct {
+		arg1 context.Context
+		arg2 string
+		arg3 []byte
+		arg4 time.Duration
+		arg5 string
+	}{arg1, arg2, arg3Copy, arg4, arg5})
+	stub := fake.CompareAndSetStub
+	fakeReturns := fake.compareAndSetReturns
+	fake.recordInvocation("CompareAndSet", []interface{}{arg1, arg2, arg3Copy, arg4, arg5})
+	fake.compareAndSetMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3, arg4, arg5)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *FakeCache) CompareAndSetCallCount() int {
+	fake.compareAndSetMutex.RLock()
+	defer fake.compareAndSetMutex.RUnlock()
+	return len(fake.compareAndSetArgsForCall)
+}
+
+func (fake *FakeCache) CompareAndSetCalls(stub func(context.Context, string, []byte, time.Duration, string) error) {
+	fake.compareAndSetMutex.Lock()
+	defer fake.compareAndSetMutex.Unlock()
+	fake.CompareAndSetStub = stub
+}
+
+func (fake *FakeCache) CompareAndSetArgsForCall(i int) (context.Context, string, []byte, time.Duration, string) {
+	fake.compareAndSetMutex.RLock()
+	defer fake.compareAndSetMutex.RUnlock()
+	argsForCall := fake.compareAndSetArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3, argsForCall.arg4, argsForCall.arg5
+}
+
+func (fake *FakeCache) CompareAndSetReturns(result1 error) {
+	fake.compareAndSetMutex.Lock()
+	defer fake.compareAndSetMutex.Unlock()
+	fake.CompareAndSetStub = nil
+	fake.compareAndSetReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeCache) CompareAndSetReturnsOnCall(i int, result1 error) {
+	fake.compareAndSetMutex.Lock()
+	defer fake.compareAndSetMutex.Unlock()
+	fake.CompareAndSetStub = nil
+	if fake.compareAndSetReturnsOnCall == nil {
+		fake.compareAndSetReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.compareAndSetReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeCache) Delete(arg1 context.Context, arg2 string) error {
+	fake.deleteMutex.Lock()
+	ret, specificReturn := fake.deleteReturnsOnCall[len(fake.deleteArgsForCall)]
+	fake.deleteArgsForCall = append(fake.deleteArgsForCall, struct {
 		arg1 context.Context
 		arg2 string
 	}{arg1, arg2})
-	stub := fake.GetStub
-	fakeReturns := fake.getReturns
-	fake.recordInvocation("Get", []interface{}{arg1, arg2})
-	fake.getMutex.Unlock()
+	stub := fake.DeleteStub
+	fakeReturns := fake.deleteReturns
+	fake.recordInvocation("Delete", []interface{}{arg1, arg2})
+	fake.deleteMutex.Unlock()
 	if stub != nil {
 		return stub(arg1, arg2)
 	}
 	if specificReturn {
-		return ret.result1, ret.result2
+		return ret.result1
 	}
-	return fakeReturns.result1, fakeReturns.result2
+	return fakeReturns.result1
 }
 
-func (fake *FakeCache) GetCallCount() int {
-	fake.getMutex.RLock()
-	defer fake.getMutex.RUnlock()
-	return len(fake.getArgsForCall)
+func (fake *FakeCache) DeleteCallCount() int {
+	fake.deleteMutex.RLock()
+	defer fake.deleteMutex.RUnlock()
+	return len(fake.deleteArgsForCall)
 }
 
-func (fake *FakeCache) GetCalls(stub func(context.Context, string) ([]byte, error)) {
-	fake.getMutex.Lock()
-	defer fake.getMutex.Unlock()
-	fake.GetStub = stub
+func (fake *FakeCache) DeleteCalls(stub func(context.Context, string) error) {
+	fake.deleteMutex.Lock()
+	defer fake.deleteMutex.Unlock()
+	fake.DeleteStub = stub
 }
 
-func (fake *FakeCache) GetArgsForCall(i int) (context.Context, string) {
-	fake.getMutex.RLock()
-	defer fake.getMutex.RUnlock()
-	argsForCall := fake.getArgsForCall[i]
+func (fake *FakeCache) DeleteArgsForCall(i int) (context.Context, string) {
+	fake.deleteMutex.RLock()
+	defer fake.deleteMutex.RUnlock()
+	argsForCall := fake.deleteArgsForCall[i]
 	return argsForCall.arg1, argsForCall.arg2
 }
 
-func (fake *FakeCache) GetReturns(result1 []byte, result2 error) {
-	fake.getMutex.Lock()
-	defer fake.getMutex.Unlock()
-	fake.GetStub = nil
-	fake.getReturns = struct {
-		result1 []byte
-		result2 error
-	}{result1, result2}
+func (fake *FakeCache) DeleteReturns(result1 error) {
+	fake.deleteMutex.Lock()
+	defer fake.deleteMutex.Unlock()
+	fake.DeleteStub = nil
+	fake.deleteReturns = struct {
+		result1 error
+	}{result1}
 }
 
-func (fake *FakeCache) GetReturnsOnCall(i int, result1 []byte, result2 error) {
-	fake.getMutex.Lock()
-	defer fake.getMutex.Unlock()
-	fake.GetStub = nil
-	if fake.getReturnsOnCall == nil {
-		fake.getReturnsOnCall = make(map[int]struct {
-			result1 []byte
-			result2 error
+func (fake *FakeCache) DeleteReturnsOnCall(i int, result1 error) {
+	fake.deleteMutex.Lock()
+	defer fake.deleteMutex.Unlock()
+	fake.DeleteStub = nil
+	if fake.deleteReturnsOnCall == nil {
+		fake.deleteReturnsOnCall = make(map[int]struct {
+			result1 error
 		})
 	}
-	fake.getReturnsOnCall[i] = struct {
-		result1 []byte
-		result2 error
-	}{result1, result2}
+	fake.deleteReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
 }
 
-func (fake *FakeCache) Set(arg1 context.Context, arg2 string, arg3 []byte, arg4 time.Duration) error {
+func (fake *FakeCache) DeleteWithEvent(arg1 context.Context, arg2 string, arg3 []byte) error {
 	var arg3Copy []byte
 	if arg3 != nil {
 		arg3Copy = make([]byte, len(arg3))
 		copy(arg3Copy, arg3)
 	}
-	fake.setMutex.Lock()
-	ret, specificReturn := fake.setReturnsOnCall[len(fake.setArgsForCall)]
-	fake.setArgsForCall = append(fake.setArgsForCall, struct {
+	fake.deleteWithEventMutex.Lock()
+	ret, specificReturn := fake.deleteWithEventReturnsOnCall[len(fake.deleteWithEventArgsForCall)]
+	fake.deleteWithEventArgsForCall = append(fake.deleteWithEventArgsForCall, struct {
 		arg1 context.Context
 		arg2 string
 		arg3 []byte
-		arg4 time.Duration
-	}{arg1, arg2, arg3Copy, arg4})
-	stub := fake.SetStub
-	fakeReturns := fake.setReturns
-	fake.recordInvocation("Set", []interface{}{arg1, arg2, arg3Copy, arg4})
-	fake.setMutex.Unlock()
+	}{arg1, arg2, arg3Copy})
+	stub := fake.DeleteWithEventStub
+	fakeReturns := fake.deleteWithEventReturns
+	fake.recordInvocation("DeleteWithEvent", []interface{}{arg1, arg2, arg3Copy})
+	fake.deleteWithEventMutex.Unlock()
 	if stub != nil {
-		return stub(arg1, arg2, arg3, arg4)
+		return stub(arg1, arg2, arg3)
 	}
 	if specificReturn {
 		return ret.result1
@@ -134,40 +498,719 @@ func (fake *FakeCache) Set(arg1 context.Context, arg2 string, arg3 []byte, arg4
 	return fakeReturns.result1
 }
 
-func (fake *FakeCache) SetCallCount() int {
-	fake.setMutex.RLock()
-	defer fake.setMutex.RUnlock()
-	return len(fake.setArgsForCall)
+func (fake *FakeCache) DeleteWithEventCallCount() int {
+	fake.deleteWithEventMutex.RLock()
+	defer fake.deleteWithEventMutex.RUnlock()
+	return len(fake.deleteWithEventArgsForCall)
 }
 
-func (fake *FakeCache) SetCalls(stub func(context.Context, string, []byte, time.Duration) error) {
-	fake.setMutex.Lock()
-	defer fake.setMutex.Unlock()
-	fake.SetStub = stub
+func (fake *FakeCache) DeleteWithEventCalls(stub func(context.Context, string, []byte) error) {
+	fake.deleteWithEventMutex.Lock()
+	defer fake.deleteWithEventMutex.Unlock()
+	fake.DeleteWithEventStub = stub
 }
 
-func (fake *FakeCache) SetArgsForCall(i int) (context.Context, string, []byte, time.Duration) {
-	fake.setMutex.RLock()
-	defer fake.setMutex.RUnlock()
-	argsForCall := fake.setArgsForCall[i]
-	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3, argsForCall.arg4
+func (fake *FakeCache) DeleteWithEventArgsForCall(i int) (context.Context, string, []byte) {
+	fake.deleteWithEventMutex.RLock()
+	defer fake.deleteWithEventMutex.RUnlock()
+	argsForCall := fake.deleteWithEventArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
 }
 
-func (fake *FakeCache) SetReturns(result1 error) {
-	fake.setMutex.Lock()
-	defer fake.setMutex.Unlock()
-	fake.SetStub = nil
-	fake.setReturns = struct {
+func (fake *FakeCache) DeleteWithEventReturns(result1 error) {
+	fake.deleteWithEventMutex.Lock()
+	defer fake.deleteWithEventMutex.Unlock()
+	fake.DeleteWithEventStub = nil
+	fake.deleteWithEventReturns = struct {
 		result1 error
 	}{result1}
 }
 
-func (fake *FakeCache) SetReturnsOnCall(i int, result1 error) {
-	fake.setMutex.Lock()
-	defer fake.setMutex.Unlock()
-	fake.SetStub = nil
-	if fake.setReturnsOnCall == nil {
-		fake.setReturnsOnCall = make(map[int]struct {
+func (fake *FakeCache) DeleteWithEventReturnsOnCall(i int, result1 error) {
+	fake.deleteWithEventMutex.Lock()
+	defer fake.deleteWithEventMutex.Unlock()
+	fake.DeleteWithEventStub = nil
+	if fake.deleteWithEventReturnsOnCall == nil {
+		fake.deleteWithEventReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.deleteWithEventReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeCache) Exists(arg1 context.Context, arg2 string) (bool, error) {
+	fake.existsMutex.Lock()
+	ret, specificReturn := fake.existsReturnsOnCall[len(fake.existsArgsForCall)]
+	fake.existsArgsForCall = append(fake.existsArgsForCall, struct {
+		arg1 context.Context
+		arg2 string
+	}{arg1, arg2})
+	stub := fake.ExistsStub
+	fakeReturns := fake.existsReturns
+	fake.recordInvocation("Exists", []interface{}{arg1, arg2})
+	fake.existsMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeCache) ExistsCallCount() int {
+	fake.existsMutex.RLock()
+	defer fake.existsMutex.RUnlock()
+	return len(fake.existsArgsForCall)
+}
+
+func (fake *FakeCache) ExistsCalls(stub func(context.Context, string) (bool, error)) {
+	fake.existsMutex.Lock()
+	defer fake.existsMutex.Unlock()
+	fake.ExistsStub = stub
+}
+
+func (fake *FakeCache) ExistsArgsForCall(i int) (context.Context, string) {
+	fake.existsMutex.RLock()
+	defer fake.existsMutex.RUnlock()
+	argsForCall := fake.existsArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeCache) ExistsReturns(result1 bool, result2 error) {
+	fake.existsMutex.Lock()
+	defer fake.existsMutex.Unlock()
+	fake.ExistsStub = nil
+	fake.existsReturns = struct {
+		result1 bool
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeCache) ExistsReturnsOnCall(i int, result1 bool, result2 error) {
+	fake.existsMutex.Lock()
+	defer fake.existsMutex.Unlock()
+	fake.ExistsStub = nil
+	if fake.existsReturnsOnCall == nil {
+		fake.existsReturnsOnCall = make(map[int]struct {
+			result1 bool
+			result2 error
+		})
+	}
+	fake.existsReturnsOnCall[i] = struct {
+		result1 bool
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeCache) FinishJob(arg1 context.Context, arg2 string, arg3 string, arg4 map[string]string, arg5 time.Duration) error {
+	fake.finishJobMutex.Lock()
+	ret, specificReturn := fake.finishJobReturnsOnCall[len(fake.finishJobArgsForCall)]
+	fake.finishJobArgsForCall = append(fake.finishJobArgsForCall, struct {
+		arg1 context.Context
+		arg2 string
+		arg3 string
+		arg4 map[string]string
+		arg5 time.Duration
+	}{arg1, arg2, arg3, arg4, arg5})
+	stub := fake.FinishJobStub
+	fakeReturns := fake.finishJobReturns
+	fake.recordInvocation("FinishJob", []interface{}{arg1, arg2, arg3, arg4, arg5})
+	fake.finishJobMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3, arg4, arg5)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *FakeCache) FinishJobCallCount() int {
+	fake.finishJobMutex.RLock()
+	defer fake.finishJobMutex.RUnlock()
+	return len(fake.finishJobArgsForCall)
+}
+
+func (fake *FakeCache) FinishJobCalls(stub func(context.Context, string, string, map[string]string, time.Duration) error) {
+	fake.finishJobMutex.Lock()
+	defer fake.finishJobMutex.Unlock()
+	fake.FinishJobStub = stub
+}
+
+func (fake *FakeCache) FinishJobArgsForCall(i int) (context.Context, string, string, map[string]string, time.Duration) {
+	fake.finishJobMutex.RLock()
+	defer fake.finishJobMutex.RUnlock()
+	argsForCall := fake.finishJobArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3, argsForCall.arg4, argsForCall.arg5
+}
+
+func (fake *FakeCache) FinishJobReturns(result1 error) {
+	fake.finishJobMutex.Lock()
+	defer fake.finishJobMutex.Unlock()
+	fake.FinishJobStub = nil
+	fake.finishJobReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeCache) FinishJobReturnsOnCall(i int, result1 error) {
+	fake.finishJobMutex.Lock()
+	defer fake.finishJobMutex.Unlock()
+	fake.FinishJobStub = nil
+	if fake.finishJobReturnsOnCall == nil {
+		fake.finishJobReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.finishJobReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeCache) Get(arg1 context.Context, arg2 string) ([]byte, error) {
+	fake.getMutex.Lock()
+	ret, specificReturn := fake.getReturnsOnCall[len(fake.getArgsForCall)]
+	fake.getArgsForCall = append(fake.getArgsForCall, struct {
+		arg1 context.Context
+		arg2 string
+	}{arg1, arg2})
+	stub := fake.GetStub
+	fakeReturns := fake.getReturns
+	fake.recordInvocation("Get", []interface{}{arg1, arg2})
+	fake.getMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeCache) GetCallCount() int {
+	fake.getMutex.RLock()
+	defer fake.getMutex.RUnlock()
+	return len(fake.getArgsForCall)
+}
+
+func (fake *FakeCache) GetCalls(stub func(context.Context, string) ([]byte, error)) {
+	fake.getMutex.Lock()
+	defer fake.getMutex.Unlock()
+	fake.GetStub = stub
+}
+
+func (fake *FakeCache) GetArgsForCall(i int) (context.Context, string) {
+	fake.getMutex.RLock()
+	defer fake.getMutex.RUnlock()
+	argsForCall := fake.getArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeCache) GetReturns(result1 []byte, result2 error) {
+	fake.getMutex.Lock()
+	defer fake.getMutex.Unlock()
+	fake.GetStub = nil
+	fake.getReturns = struct {
+		result1 []byte
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeCache) GetReturnsOnCall(i int, result1 []byte, result2 error) {
+	fake.getMutex.Lock()
+	defer fake.getMutex.Unlock()
+	fake.GetStub = nil
+	if fake.getReturnsOnCall == nil {
+		fake.getReturnsOnCall = make(map[int]struct {
+			result1 []byte
+			result2 error
+		})
+	}
+	fake.getReturnsOnCall[i] = struct {
+		result1 []byte
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeCache) GetEx(arg1 context.Context, arg2 string, arg3 time.Duration) ([]byte, error) {
+	fake.getExMutex.Lock()
+	ret, specificReturn := fake.getExReturnsOnCall[len(fake.getExArgsForCall)]
+	fake.getExArgsForCall = append(fake.getExArgsForCall, struct {
+		arg1 context.Context
+		arg2 string
+		arg3 time.Duration
+	}{arg1, arg2, arg3})
+	stub := fake.GetExStub
+	fakeReturns := fake.getExReturns
+	fake.recordInvocation("GetEx", []interface{}{arg1, arg2, arg3})
+	fake.getExMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeCache) GetExCallCount() int {
+	fake.getExMutex.RLock()
+	defer fake.getExMutex.RUnlock()
+	return len(fake.getExArgsForCall)
+}
+
+func (fake *FakeCache) GetExCalls(stub func(context.Context, string, time.Duration) ([]byte, error)) {
+	fake.getExMutex.Lock()
+	defer fake.getExMutex.Unlock()
+	fake.GetExStub = stub
+}
+
+func (fake *FakeCache) GetExArgsForCall(i int) (context.Context, string, time.Duration) {
+	fake.getExMutex.RLock()
+	defer fake.getExMutex.RUnlock()
+	argsForCall := fake.getExArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
+func (fake *FakeCache) GetExReturns(result1 []byte, result2 error) {
+	fake.getExMutex.Lock()
+	defer fake.getExMutex.Unlock()
+	fake.GetExStub = nil
+	fake.getExReturns = struct {
+		result1 []byte
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeCache) GetExReturnsOnCall(i int, result1 []byte, result2 error) {
+	fake.getExMutex.Lock()
+	defer fake.getExMutex.Unlock()
+	fake.GetExStub = nil
+	if fake.getExReturnsOnCall == nil {
+		fake.getExReturnsOnCall = make(map[int]struct {
+			result1 []byte
+			result2 error
+		})
+	}
+	fake.getExReturnsOnCall[i] = struct {
+		result1 []byte
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeCache) GetJob(arg1 context.Context, arg2 string) (map[string]string, error) {
+	fake.getJobMutex.Lock()
+	ret, specificReturn := fake.getJobReturnsOnCall[len(fake.getJobArgsForCall)]
+	fake.getJobArgsForCall = append(fake.getJobArgsForCall, struct {
+		arg1 context.Context
+		arg2 string
+	}{arg1, arg2})
+	stub := fake.GetJobStub
+	fakeReturns := fake.getJobReturns
+	fake.recordInvocation("GetJob", []interface{}{arg1, arg2})
+	fake.getJobMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeCache) GetJobCallCount() int {
+	fake.getJobMutex.RLock()
+	defer fake.getJobMutex.RUnlock()
+	return len(fake.getJobArgsForCall)
+}
+
+func (fake *FakeCache) GetJobCalls(stub func(context.Context, string) (map[string]string, error)) {
+	fake.getJobMutex.Lock()
+	defer fake.getJobMutex.Unlock()
+	fake.GetJobStub = stub
+}
+
+func (fake *FakeCache) GetJobArgsForCall(i int) (context.Context, string) {
+	fake.getJobMutex.RLock()
+	defer fake.getJobMutex.RUnlock()
+	argsForCall := fake.getJobArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeCache) GetJobReturns(result1 map[string]string, result2 error) {
+	fake.getJobMutex.Lock()
+	defer fake.getJobMutex.Unlock()
+	fake.GetJobStub = nil
+	fake.getJobReturns = struct {
+		result1 map[string]string
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeCache) GetJobReturnsOnCall(i int, result1 map[string]string, result2 error) {
+	fake.getJobMutex.Lock()
+	defer fake.getJobMutex.Unlock()
+	fake.GetJobStub = nil
+	if fake.getJobReturnsOnCall == nil {
+		fake.getJobReturnsOnCall = make(map[int]struct {
+			result1 map[string]string
+			result2 error
+		})
+	}
+	fake.getJobReturnsOnCall[i] = struct {
+		result1 map[string]string
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeCache) GetMany(arg1 context.Context, arg2 []string) ([][]byte, error) {
+	var arg2Copy []string
+	if arg2 != nil {
+		arg2Copy = make([]string, len(arg2))
+		copy(arg2Copy, arg2)
+	}
+	fake.getManyMutex.Lock()
+	ret, specificReturn := fake.getManyReturnsOnCall[len(fake.getManyArgsForCall)]
+	fake.getManyArgsForCall = append(fake.getManyArgsForCall, struct {
+		arg1 context.Context
+		arg2 []string
+	}{arg1, arg2Copy})
+	stub := fake.GetManyStub
+	fakeReturns := fake.getManyReturns
+	fake.recordInvocation("GetMany", []interface{}{arg1, arg2Copy})
+	fake.getManyMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeCache) GetManyCallCount() int {
+	fake.getManyMutex.RLock()
+	defer fake.getManyMutex.RUnlock()
+	return len(fake.getManyArgsForCall)
+}
+
+func (fake *FakeCache) GetManyCalls(stub func(context.Context, []string) ([][]byte, error)) {
+	fake.getManyMutex.Lock()
+	defer fake.getManyMutex.Unlock()
+	fake.GetManyStub = stub
+}
+
+func (fake *FakeCache) GetManyArgsForCall(i int) (context.Context, []string) {
+	fake.getManyMutex.RLock()
+	defer fake.getManyMutex.RUnlock()
+	argsForCall := fake.getManyArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeCache) GetManyReturns(result1 [][]byte, result2 error) {
+	fake.getManyMutex.Lock()
+	defer fake.getManyMutex.Unlock()
+	fake.GetManyStub = nil
+	fake.getManyReturns = struct {
+		result1 [][]byte
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeCache) GetManyReturnsOnCall(i int, result1 [][]byte, result2 error) {
+	fake.getManyMutex.Lock()
+	defer fake.getManyMutex.Unlock()
+	fake.GetManyStub = nil
+	if fake.getManyReturnsOnCall == nil {
+		fake.getManyReturnsOnCall = make(map[int]struct {
+			result1 [][]byte
+			result2 error
+		})
+	}
+	fake.getManyReturnsOnCall[i] = struct {
+		result1 [][]byte
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeCache) GetManyEx(arg1 context.Context, arg2 []string, arg3 time.Duration) ([][]byte, error) {
+	var arg2Copy []string
+	if arg2 != nil {
+		arg2Copy = make([]string, len(arg2))
+		copy(arg2Copy, arg2)
+	}
+	fake.getManyExMutex.Lock()
+	ret, specificReturn := fake.getManyExReturnsOnCall[len(fake.getManyExArgsForCall)]
+	fake.getManyExArgsForCall = append(fake.getManyExArgsForCall, struct {
+		arg1 context.Context
+		arg2 []string
+		arg3 time.Duration
+	}{arg1, arg2Copy, arg3})
+	stub := fake.GetManyExStub
+	fakeReturns := fake.getManyExReturns
+	fake.recordInvocation("GetManyEx", []interface{}{arg1, arg2Copy, arg3})
+	fake.getManyExMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeCache) GetManyExCallCount() int {
+	fake.getManyExMutex.RLock()
+	defer fake.getManyExMutex.RUnlock()
+	return len(fake.getManyExArgsForCall)
+}
+
+func (fake *FakeCache) GetManyExCalls(stub func(context.Context, []string, time.Duration) ([][]byte, error)) {
+	fake.getManyExMutex.Lock()
+	defer fake.getManyExMutex.Unlock()
+	fake.GetManyExStub = stub
+}
+
+func (fake *FakeCache) GetManyExArgsForCall(i int) (context.Context, []string, time.Duration) {
+	fake.getManyExMutex.RLock()
+	defer fake.getManyExMutex.RUnlock()
+	argsForCall := fake.getManyExArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
+func (fake *FakeCache) GetManyExReturns(result1 [][]byte, result2 error) {
+	fake.getManyExMutex.Lock()
+	defer fake.getManyExMutex.Unlock()
+	fake.GetManyExStub = nil
+	fake.getManyExReturns = struct {
+		result1 [][]byte
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeCache) GetManyExReturnsOnCall(i int, result1 [][]byte, result2 error) {
+	fake.getManyExMutex.Lock()
+	defer fake.getManyExMutex.Unlock()
+	fake.GetManyExStub = nil
+	if fake.getManyExReturnsOnCall == nil {
+		fake.getManyExReturnsOnCall = make(map[int]struct {
+			result1 [][]byte
+			result2 error
+		})
+	}
+	fake.getManyExReturnsOnCall[i] = struct {
+		result1 [][]byte
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeCache) InvalidateTag(arg1 context.Context, arg2 string) (int64, error) {
+	fake.invalidateTagMutex.Lock()
+	ret, specificReturn := fake.invalidateTagReturnsOnCall[len(fake.invalidateTagArgsForCall)]
+	fake.invalidateTagArgsForCall = append(fake.invalidateTagArgsForCall, struct {
+		arg1 context.Context
+		arg2 string
+	}{arg1, arg2})
+	stub := fake.InvalidateTagStub
+	fakeReturns := fake.invalidateTagReturns
+	fake.recordInvocation("InvalidateTag", []interface{}{arg1, arg2})
+	fake.invalidateTagMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeCache) InvalidateTagCallCount() int {
+	fake.invalidateTagMutex.RLock()
+	defer fake.invalidateTagMutex.RUnlock()
+	return len(fake.invalidateTagArgsForCall)
+}
+
+func (fake *FakeCache) InvalidateTagCalls(stub func(context.Context, string) (int64, error)) {
+	fake.invalidateTagMutex.Lock()
+	defer fake.invalidateTagMutex.Unlock()
+	fake.InvalidateTagStub = stub
+}
+
+func (fake *FakeCache) InvalidateTagArgsForCall(i int) (context.Context, string) {
+	fake.invalidateTagMutex.RLock()
+	defer fake.invalidateTagMutex.RUnlock()
+	argsForCall := fake.invalidateTagArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeCache) InvalidateTagReturns(result1 int64, result2 error) {
+	fake.invalidateTagMutex.Lock()
+	defer fake.invalidateTagMutex.Unlock()
+	fake.InvalidateTagStub = nil
+	fake.invalidateTagReturns = struct {
+		result1 int64
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeCache) InvalidateTagReturnsOnCall(i int, result1 int64, result2 error) {
+	fake.invalidateTagMutex.Lock()
+	defer fake.invalidateTagMutex.Unlock()
+	fake.InvalidateTagStub = nil
+	if fake.invalidateTagReturnsOnCall == nil {
+		fake.invalidateTagReturnsOnCall = make(map[int]struct {
+			result1 int64
+			result2 error
+		})
+	}
+	fake.invalidateTagReturnsOnCall[i] = struct {
+		result1 int64
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeCache) Scan(arg1 context.Context, arg2 string, arg3 string, arg4 int64) ([]string, string, error) {
+	fake.scanMutex.Lock()
+	ret, specificReturn := fake.scanReturnsOnCall[len(fake.scanArgsForCall)]
+	fake.scanArgsForCall = append(fake.scanArgsForCall, struct {
+		arg1 context.Context
+		arg2 string
+		arg3 string
+		arg4 int64
+	}{arg1, arg2, arg3, arg4})
+	stub := fake.ScanStub
+	fakeReturns := fake.scanReturns
+	fake.recordInvocation("Scan", []interface{}{arg1, arg2, arg3, arg4})
+	fake.scanMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3, arg4)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	return fakeReturns.result1, fakeReturns.result2, fakeReturns.result3
+}
+
+func (fake *FakeCache) ScanCallCount() int {
+	fake.scanMutex.RLock()
+	defer fake.scanMutex.RUnlock()
+	return len(fake.scanArgsForCall)
+}
+
+func (fake *FakeCache) ScanCalls(stub func(context.Context, string, string, int64) ([]string, string, error)) {
+	fake.scanMutex.Lock()
+	defer fake.scanMutex.Unlock()
+	fake.ScanStub = stub
+}
+
+func (fake *FakeCache) ScanArgsForCall(i int) (context.Context, string, string, int64) {
+	fake.scanMutex.RLock()
+	defer fake.scanMutex.RUnlock()
+	argsForCall := fake.scanArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3, argsForCall.arg4
+}
+
+func (fake *FakeCache) ScanReturns(result1 []string, result2 string, result3 error) {
+	fake.scanMutex.Lock()
+	defer fake.scanMutex.Unlock()
+	fake.ScanStub = nil
+	fake.scanReturns = struct {
+		result1 []string
+		result2 string
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeCache) ScanReturnsOnCall(i int, result1 []string, result2 string, result3 error) {
+	fake.scanMutex.Lock()
+	defer fake.scanMutex.Unlock()
+	fake.ScanStub = nil
+	if fake.scanReturnsOnCall == nil {
+		fake.scanReturnsOnCall = make(map[int]struct {
+			result1 []string
+			result2 string
+			result3 error
+		})
+	}
+	fake.scanReturnsOnCall[i] = struct {
+		result1 []string
+		result2 string
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeCache) Set(arg1 context.Context, arg2 string, arg3 []byte, arg4 time.Duration, arg5 string, arg6 []string, arg7 string) error {
+	var arg3Copy []byte
+	if arg3 != nil {
+		arg3Copy = make([]byte, len(arg3))
+		copy(arg3Copy, arg3)
+	}
+	var arg6Copy []string
+	if arg6 != nil {
+		arg6Copy = make([]string, len(arg6))
+		copy(arg6Copy, arg6)
+	}
+	fake.setMutex.Lock()
+	ret, specificReturn := fake.setReturnsOnCall[len(fake.setArgsForCall)]
+	fake.setArgsForCall = append(fake.setArgsForCall, struct {
+		arg1 context.Context
+		arg2 string
+		arg3 []byte
+		arg4 time.Duration
+		arg5 string
+		arg6 []string
+		arg7 string
+	}{arg1, arg2, arg3Copy, arg4, arg5, arg6Copy, arg7})
+	stub := fake.SetStub
+	fakeReturns := fake.setReturns
+	fake.recordInvocation("Set", []interface{}{arg1, arg2, arg3Copy, arg4, arg5, arg6Copy, arg7})
+	fake.setMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3, arg4, arg5, arg6, arg7)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *FakeCache) SetCallCount() int {
+	fake.setMutex.RLock()
+	defer fake.setMutex.RUnlock()
+	return len(fake.setArgsForCall)
+}
+
+func (fake *FakeCache) SetCalls(stub func(context.Context, string, []byte, time.Duration, string, []string, string) error) {
+	fake.setMutex.Lock()
+	defer fake.setMutex.Unlock()
+	fake.SetStub = stub
+}
+
+func (fake *FakeCache) SetArgsForCall(i int) (context.Context, string, []byte, time.Duration, string, []string, string) {
+	fake.setMutex.RLock()
+	defer fake.setMutex.RUnlock()
+	argsForCall := fake.setArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3, argsForCall.arg4, argsForCall.arg5, argsForCall.arg6, argsForCall.arg7
+}
+
+func (fake *FakeCache) SetReturns(result1 error) {
+	fake.setMutex.Lock()
+	defer fake.setMutex.Unlock()
+	fake.SetStub = nil
+	fake.setReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeCache) SetReturnsOnCall(i int, result1 error) {
+	fake.setMutex.Lock()
+	defer fake.setMutex.Unlock()
+	fake.SetStub = nil
+	if fake.setReturnsOnCall == nil {
+		fake.setReturnsOnCall = make(map[int]struct {
 			result1 error
 		})
 	}
@@ -176,13 +1219,684 @@ func (fake *FakeCache) SetReturnsOnCall(i int, result1 error) {
 	}{result1}
 }
 
+func (fake *FakeCache) SetMany(arg1 context.Context, arg2 []string, arg3 [][]byte, arg4 []time.Duration, arg5 []string) []error {
+	var arg2Copy []string
+	if arg2 != nil {
+		arg2Copy = make([]string, len(arg2))
+		copy(arg2Copy, arg2)
+	}
+	var arg3Copy [][]byte
+	if arg3 != nil {
+		arg3Copy = make([][]byte, len(arg3))
+		copy(arg3Copy, arg3)
+	}
+	var arg4Copy []time.Duration
+	if arg4 != nil {
+		arg4Copy = make([]time.Duration, len(arg4))
+		copy(arg4Copy, arg4)
+	}
+	var arg5Copy []string
+	if arg5 != nil {
+		arg5Copy = make([]string, len(arg5))
+		copy(arg5Copy, arg5)
+	}
+	fake.setManyMutex.Lock()
+	ret, specificReturn := fake.setManyReturnsOnCall[len(fake.setManyArgsForCall)]
+	fake.setManyArgsForCall = append(fake.setManyArgsForCall, struct {
+		arg1 context.Context
+		arg2 []string
+		arg3 [][]byte
+		arg4 []time.Duration
+		arg5 []string
+	}{arg1, arg2Copy, arg3Copy, arg4Copy, arg5Copy})
+	stub := fake.SetManyStub
+	fakeReturns := fake.setManyReturns
+	fake.recordInvocation("SetMany", []interface{}{arg1, arg2Copy, arg3Copy, arg4Copy, arg5Copy})
+	fake.setManyMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3, arg4, arg5)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *FakeCache) SetManyCallCount() int {
+	fake.setManyMutex.RLock()
+	defer fake.setManyMutex.RUnlock()
+	return len(fake.setManyArgsForCall)
+}
+
+func (fake *FakeCache) SetManyCalls(stub func(context.Context, []string, [][]byte, []time.Duration, []string) []error) {
+	fake.setManyMutex.Lock()
+	defer fake.setManyMutex.Unlock()
+	fake.SetManyStub = stub
+}
+
+func (fake *FakeCache) SetManyArgsForCall(i int) (context.Context, []string, [][]byte, []time.Duration, []string) {
+	fake.setManyMutex.RLock()
+	defer fake.setManyMutex.RUnlock()
+	argsForCall := fake.setManyArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3, argsForCall.arg4, argsForCall.arg5
+}
+
+func (fake *FakeCache) SetManyReturns(result1 []error) {
+	fake.setManyMutex.Lock()
+	defer fake.setManyMutex.Unlock()
+	fake.SetManyStub = nil
+	fake.setManyReturns = struct {
+		result1 []error
+	}{result1}
+}
+
+func (fake *FakeCache) SetManyReturnsOnCall(i int, result1 []error) {
+	fake.setManyMutex.Lock()
+	defer fake.setManyMutex.Unlock()
+	fake.SetManyStub = nil
+	if fake.setManyReturnsOnCall == nil {
+		fake.setManyReturnsOnCall = make(map[int]struct {
+			result1 []error
+		})
+	}
+	fake.setManyReturnsOnCall[i] = struct {
+		result1 []error
+	}{result1}
+}
+
+func (fake *FakeCache) SetTags(arg1 context.Context, arg2 string, arg3 []string, arg4 time.Duration) error {
+	var arg3Copy []string
+	if arg3 != nil {
+		arg3Copy = make([]string, len(arg3))
+		copy(arg3Copy, arg3)
+	}
+	fake.setTagsMutex.Lock()
+	ret, specificReturn := fake.setTagsReturnsOnCall[len(fake.setTagsArgsForCall)]
+	fake.setTagsArgsForCall = append(fake.setTagsArgsForCall, struct {
+		arg1 context.Context
+		arg2 string
+		arg3 []string
+		arg4 time.Duration
+	}{arg1, arg2, arg3Copy, arg4})
+	stub := fake.SetTagsStub
+	fakeReturns := fake.setTagsReturns
+	fake.recordInvocation("SetTags", []interface{}{arg1, arg2, arg3Copy, arg4})
+	fake.setTagsMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3, arg4)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *FakeCache) SetTagsCallCount() int {
+	fake.setTagsMutex.RLock()
+	defer fake.setTagsMutex.RUnlock()
+	return len(fake.setTagsArgsForCall)
+}
+
+func (fake *FakeCache) SetTagsCalls(stub func(context.Context, string, []string, time.Duration) error) {
+	fake.setTagsMutex.Lock()
+	defer fake.setTagsMutex.Unlock()
+	fake.SetTagsStub = stub
+}
+
+func (fake *FakeCache) SetTagsArgsForCall(i int) (context.Context, string, []string, time.Duration) {
+	fake.setTagsMutex.RLock()
+	defer fake.setTagsMutex.RUnlock()
+	argsForCall := fake.setTagsArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3, argsForCall.arg4
+}
+
+func (fake *FakeCache) SetTagsReturns(result1 error) {
+	fake.setTagsMutex.Lock()
+	defer fake.setTagsMutex.Unlock()
+	fake.SetTagsStub = nil
+	fake.setTagsReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeCache) SetTagsReturnsOnCall(i int, result1 error) {
+	fake.setTagsMutex.Lock()
+	defer fake.setTagsMutex.Unlock()
+	fake.SetTagsStub = nil
+	if fake.setTagsReturnsOnCall == nil {
+		fake.setTagsReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.setTagsReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeCache) SetWithEvent(arg1 context.Context, arg2 string, arg3 []byte, arg4 time.Duration, arg5 string, arg6 string, arg7 []byte) error {
+	var arg3Copy []byte
+	if arg3 != nil {
+		arg3Copy = make([]byte, len(arg3))
+		copy(arg3Copy, arg3)
+	}
+	var arg7Copy []byte
+	if arg7 != nil {
+		arg7Copy = make([]byte, len(arg7))
+		copy(arg7Copy, arg7)
+	}
+	fake.setWithEventMutex.Lock()
+	ret, specificReturn := fake.setWithEventReturnsOnCall[len(fake.setWithEventArgsForCall)]
+	fake.setWithEventArgsForCall = append(fake.setWithEventArgsForCall, struct {
+		arg1 context.Context
+		arg2 string
+		arg3 []byte
+		arg4 time.Duration
+		arg5 string
+		arg6 string
+		arg7 []byte
+	}{arg1, arg2, arg3Copy, arg4, arg5, arg6, arg7Copy})
+	stub := fake.SetWithEventStub
+	fakeReturns := fake.setWithEventReturns
+	fake.recordInvocation("SetWithEvent", []interface{}{arg1, arg2, arg3Copy, arg4, arg5, arg6, arg7Copy})
+	fake.setWithEventMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3, arg4, arg5, arg6, arg7)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *FakeCache) SetWithEventCallCount() int {
+	fake.setWithEventMutex.RLock()
+	defer fake.setWithEventMutex.RUnlock()
+	return len(fake.setWithEventArgsForCall)
+}
+
+func (fake *FakeCache) SetWithEventCalls(stub func(context.Context, string, []byte, time.Duration, string, string, []byte) error) {
+	fake.setWithEventMutex.Lock()
+	defer fake.setWithEventMutex.Unlock()
+	fake.SetWithEventStub = stub
+}
+
+func (fake *FakeCache) SetWithEventArgsForCall(i int) (context.Context, string, []byte, time.Duration, string, string, []byte) {
+	fake.setWithEventMutex.RLock()
+	defer fake.setWithEventMutex.RUnlock()
+	argsForCall := fake.setWithEventArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3, argsForCall.arg4, argsForCall.arg5, argsForCall.arg6, argsForCall.arg7
+}
+
+func (fake *FakeCache) SetWithEventReturns(result1 error) {
+	fake.setWithEventMutex.Lock()
+	defer fake.setWithEventMutex.Unlock()
+	fake.SetWithEventStub = nil
+	fake.setWithEventReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeCache) SetWithEventReturnsOnCall(i int, result1 error) {
+	fake.setWithEventMutex.Lock()
+	defer fake.setWithEventMutex.Unlock()
+	fake.SetWithEventStub = nil
+	if fake.setWithEventReturnsOnCall == nil {
+		fake.setWithEventReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.setWithEventReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeCache) Size(arg1 context.Context, arg2 string) (int64, error) {
+	fake.sizeMutex.Lock()
+	ret, specificReturn := fake.sizeReturnsOnCall[len(fake.sizeArgsForCall)]
+	fake.sizeArgsForCall = append(fake.sizeArgsForCall, struct {
+		arg1 context.Context
+		arg2 string
+	}{arg1, arg2})
+	stub := fake.SizeStub
+	fakeReturns := fake.sizeReturns
+	fake.recordInvocation("Size", []interface{}{arg1, arg2})
+	fake.sizeMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeCache) SizeCallCount() int {
+	fake.sizeMutex.RLock()
+	defer fake.sizeMutex.RUnlock()
+	return len(fake.sizeArgsForCall)
+}
+
+func (fake *FakeCache) SizeCalls(stub func(context.Context, string) (int64, error)) {
+	fake.sizeMutex.Lock()
+	defer fake.sizeMutex.Unlock()
+	fake.SizeStub = stub
+}
+
+func (fake *FakeCache) SizeArgsForCall(i int) (context.Context, string) {
+	fake.sizeMutex.RLock()
+	defer fake.sizeMutex.RUnlock()
+	argsForCall := fake.sizeArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeCache) SizeReturns(result1 int64, result2 error) {
+	fake.sizeMutex.Lock()
+	defer fake.sizeMutex.Unlock()
+	fake.SizeStub = nil
+	fake.sizeReturns = struct {
+		result1 int64
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeCache) SizeReturnsOnCall(i int, result1 int64, result2 error) {
+	fake.sizeMutex.Lock()
+	defer fake.sizeMutex.Unlock()
+	fake.SizeStub = nil
+	if fake.sizeReturnsOnCall == nil {
+		fake.sizeReturnsOnCall = make(map[int]struct {
+			result1 int64
+			result2 error
+		})
+	}
+	fake.sizeReturnsOnCall[i] = struct {
+		result1 int64
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeCache) StartJob(arg1 context.Context, arg2 string, arg3 string, arg4 map[string]string, arg5 time.Duration) (string, error) {
+	fake.startJobMutex.Lock()
+	ret, specificReturn := fake.startJobReturnsOnCall[len(fake.startJobArgsForCall)]
+	fake.startJobArgsForCall = append(fake.startJobArgsForCall, struct {
+		arg1 context.Context
+		arg2 string
+		arg3 string
+		arg4 map[string]string
+		arg5 time.Duration
+	}{arg1, arg2, arg3, arg4, arg5})
+	stub := fake.StartJobStub
+	fakeReturns := fake.startJobReturns
+	fake.recordInvocation("StartJob", []interface{}{arg1, arg2, arg3, arg4, arg5})
+	fake.startJobMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3, arg4, arg5)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeCache) StartJobCallCount() int {
+	fake.startJobMutex.RLock()
+	defer fake.startJobMutex.RUnlock()
+	return len(fake.startJobArgsForCall)
+}
+
+func (fake *FakeCache) StartJobCalls(stub func(context.Context, string, string, map[string]string, time.Duration) (string, error)) {
+	fake.startJobMutex.Lock()
+	defer fake.startJobMutex.Unlock()
+	fake.StartJobStub = stub
+}
+
+func (fake *FakeCache) StartJobArgsForCall(i int) (context.Context, string, string, map[string]string, time.Duration) {
+	fake.startJobMutex.RLock()
+	defer fake.startJobMutex.RUnlock()
+	argsForCall := fake.startJobArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3, argsForCall.arg4, argsForCall.arg5
+}
+
+func (fake *FakeCache) StartJobReturns(result1 string, result2 error) {
+	fake.startJobMutex.Lock()
+	defer fake.startJobMutex.Unlock()
+	fake.StartJobStub = nil
+	fake.startJobReturns = struct {
+		result1 string
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeCache) StartJobReturnsOnCall(i int, result1 string, result2 error) {
+	fake.startJobMutex.Lock()
+	defer fake.startJobMutex.Unlock()
+	fake.StartJobStub = nil
+	if fake.startJobReturnsOnCall == nil {
+		fake.startJobReturnsOnCall = make(map[int]struct {
+			result1 string
+			result2 error
+		})
+	}
+	fake.startJobReturnsOnCall[i] = struct {
+		result1 string
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeCache) TTL(arg1 context.Context, arg2 string) (time.Duration, error) {
+	fake.tTLMutex.Lock()
+	ret, specificReturn := fake.tTLReturnsOnCall[len(fake.tTLArgsForCall)]
+	fake.tTLArgsForCall = append(fake.tTLArgsForCall, struct {
+		arg1 context.Context
+		arg2 string
+	}{arg1, arg2})
+	stub := fake.TTLStub
+	fakeReturns := fake.tTLReturns
+	fake.recordInvocation("TTL", []interface{}{arg1, arg2})
+	fake.tTLMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeCache) TTLCallCount() int {
+	fake.tTLMutex.RLock()
+	defer fake.tTLMutex.RUnlock()
+	return len(fake.tTLArgsForCall)
+}
+
+func (fake *FakeCache) TTLCalls(stub func(context.Context, string) (time.Duration, error)) {
+	fake.tTLMutex.Lock()
+	defer fake.tTLMutex.Unlock()
+	fake.TTLStub = stub
+}
+
+func (fake *FakeCache) TTLArgsForCall(i int) (context.Context, string) {
+	fake.tTLMutex.RLock()
+	defer fake.tTLMutex.RUnlock()
+	argsForCall := fake.tTLArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeCache) TTLReturns(result1 time.Duration, result2 error) {
+	fake.tTLMutex.Lock()
+	defer fake.tTLMutex.Unlock()
+	fake.TTLStub = nil
+	fake.tTLReturns = struct {
+		result1 time.Duration
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeCache) TTLReturnsOnCall(i int, result1 time.Duration, result2 error) {
+	fake.tTLMutex.Lock()
+	defer fake.tTLMutex.Unlock()
+	fake.TTLStub = nil
+	if fake.tTLReturnsOnCall == nil {
+		fake.tTLReturnsOnCall = make(map[int]struct {
+			result1 time.Duration
+			result2 error
+		})
+	}
+	fake.tTLReturnsOnCall[i] = struct {
+		result1 time.Duration
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeCache) Unlink(arg1 context.Context, arg2 []string) (int64, error) {
+	var arg2Copy []string
+	if arg2 != nil {
+		arg2Copy = make([]string, len(arg2))
+		copy(arg2Copy, arg2)
+	}
+	fake.unlinkMutex.Lock()
+	ret, specificReturn := fake.unlinkReturnsOnCall[len(fake.unlinkArgsForCall)]
+	fake.unlinkArgsForCall = append(fake.unlinkArgsForCall, struct {
+		arg1 context.Context
+		arg2 []string
+	}{arg1, arg2Copy})
+	stub := fake.UnlinkStub
+	fakeReturns := fake.unlinkReturns
+	fake.recordInvocation("Unlink", []interface{}{arg1, arg2Copy})
+	fake.unlinkMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeCache) UnlinkCallCount() int {
+	fake.unlinkMutex.RLock()
+	defer fake.unlinkMutex.RUnlock()
+	return len(fake.unlinkArgsForCall)
+}
+
+func (fake *FakeCache) UnlinkCalls(stub func(context.Context, []string) (int64, error)) {
+	fake.unlinkMutex.Lock()
+	defer fake.unlinkMutex.Unlock()
+	fake.UnlinkStub = stub
+}
+
+func (fake *FakeCache) UnlinkArgsForCall(i int) (context.Context, []string) {
+	fake.unlinkMutex.RLock()
+	defer fake.unlinkMutex.RUnlock()
+	argsForCall := fake.unlinkArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeCache) UnlinkReturns(result1 int64, result2 error) {
+	fake.unlinkMutex.Lock()
+	defer fake.unlinkMutex.Unlock()
+	fake.UnlinkStub = nil
+	fake.unlinkReturns = struct {
+		result1 int64
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeCache) UnlinkReturnsOnCall(i int, result1 int64, result2 error) {
+	fake.unlinkMutex.Lock()
+	defer fake.unlinkMutex.Unlock()
+	fake.UnlinkStub = nil
+	if fake.unlinkReturnsOnCall == nil {
+		fake.unlinkReturnsOnCall = make(map[int]struct {
+			result1 int64
+			result2 error
+		})
+	}
+	fake.unlinkReturnsOnCall[i] = struct {
+		result1 int64
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeCache) Update(arg1 context.Context, arg2 string, arg3 func([]byte) ([]byte, error)) ([]byte, error) {
+	fake.updateMutex.Lock()
+	ret, specificReturn := fake.updateReturnsOnCall[len(fake.updateArgsForCall)]
+	fake.updateArgsForCall = append(fake.updateArgsForCall, struct {
+		arg1 context.Context
+		arg2 string
+		arg3 func([]byte) ([]byte, error)
+	}{arg1, arg2, arg3})
+	stub := fake.UpdateStub
+	fakeReturns := fake.updateReturns
+	fake.recordInvocation("Update", []interface{}{arg1, arg2, arg3})
+	fake.updateMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeCache) UpdateCallCount() int {
+	fake.updateMutex.RLock()
+	defer fake.updateMutex.RUnlock()
+	return len(fake.updateArgsForCall)
+}
+
+func (fake *FakeCache) UpdateCalls(stub func(context.Context, string, func([]byte) ([]byte, error)) ([]byte, error)) {
+	fake.updateMutex.Lock()
+	defer fake.updateMutex.Unlock()
+	fake.UpdateStub = stub
+}
+
+func (fake *FakeCache) UpdateArgsForCall(i int) (context.Context, string, func([]byte) ([]byte, error)) {
+	fake.updateMutex.RLock()
+	defer fake.updateMutex.RUnlock()
+	argsForCall := fake.updateArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
+func (fake *FakeCache) UpdateReturns(result1 []byte, result2 error) {
+	fake.updateMutex.Lock()
+	defer fake.updateMutex.Unlock()
+	fake.UpdateStub = nil
+	fake.updateReturns = struct {
+		result1 []byte
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeCache) UpdateReturnsOnCall(i int, result1 []byte, result2 error) {
+	fake.updateMutex.Lock()
+	defer fake.updateMutex.Unlock()
+	fake.UpdateStub = nil
+	if fake.updateReturnsOnCall == nil {
+		fake.updateReturnsOnCall = make(map[int]struct {
+			result1 []byte
+			result2 error
+		})
+	}
+	fake.updateReturnsOnCall[i] = struct {
+		result1 []byte
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeCache) UpdateJob(arg1 context.Context, arg2 string, arg3 string, arg4 map[string]string, arg5 int64, arg6 time.Duration) error {
+	fake.updateJobMutex.Lock()
+	ret, specificReturn := fake.updateJobReturnsOnCall[len(fake.updateJobArgsForCall)]
+	fake.updateJobArgsForCall = append(fake.updateJobArgsForCall, struct {
+		arg1 context.Context
+		arg2 string
+		arg3 string
+		arg4 map[string]string
+		arg5 int64
+		arg6 time.Duration
+	}{arg1, arg2, arg3, arg4, arg5, arg6})
+	stub := fake.UpdateJobStub
+	fakeReturns := fake.updateJobReturns
+	fake.recordInvocation("UpdateJob", []interface{}{arg1, arg2, arg3, arg4, arg5, arg6})
+	fake.updateJobMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3, arg4, arg5, arg6)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *FakeCache) UpdateJobCallCount() int {
+	fake.updateJobMutex.RLock()
+	defer fake.updateJobMutex.RUnlock()
+	return len(fake.updateJobArgsForCall)
+}
+
+func (fake *FakeCache) UpdateJobCalls(stub func(context.Context, string, string, map[string]string, int64, time.Duration) error) {
+	fake.updateJobMutex.Lock()
+	defer fake.updateJobMutex.Unlock()
+	fake.UpdateJobStub = stub
+}
+
+func (fake *FakeCache) UpdateJobArgsForCall(i int) (context.Context, string, string, map[string]string, int64, time.Duration) {
+	fake.updateJobMutex.RLock()
+	defer fake.updateJobMutex.RUnlock()
+	argsForCall := fake.updateJobArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3, argsForCall.arg4, argsForCall.arg5, argsForCall.arg6
+}
+
+func (fake *FakeCache) UpdateJobReturns(result1 error) {
+	fake.updateJobMutex.Lock()
+	defer fake.updateJobMutex.Unlock()
+	fake.UpdateJobStub = nil
+	fake.updateJobReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeCache) UpdateJobReturnsOnCall(i int, result1 error) {
+	fake.updateJobMutex.Lock()
+	defer fake.updateJobMutex.Unlock()
+	fake.UpdateJobStub = nil
+	if fake.updateJobReturnsOnCall == nil {
+		fake.updateJobReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.updateJobReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
 func (fake *FakeCache) Invocations() map[string][][]interface{} {
 	fake.invocationsMutex.RLock()
 	defer fake.invocationsMutex.RUnlock()
+	fake.compareAndSetMutex.RLock()
+	defer fake.compareAndSetMutex.RUnlock()
+	fake.deleteMutex.RLock()
+	defer fake.deleteMutex.RUnlock()
+	fake.deleteWithEventMutex.RLock()
+	defer fake.deleteWithEventMutex.RUnlock()
+	fake.existsMutex.RLock()
+	defer fake.existsMutex.RUnlock()
+	fake.finishJobMutex.RLock()
+	defer fake.finishJobMutex.RUnlock()
 	fake.getMutex.RLock()
 	defer fake.getMutex.RUnlock()
+	fake.getExMutex.RLock()
+	defer fake.getExMutex.RUnlock()
+	fake.getJobMutex.RLock()
+	defer fake.getJobMutex.RUnlock()
+	fake.getManyMutex.RLock()
+	defer fake.getManyMutex.RUnlock()
+	fake.getManyExMutex.RLock()
+	defer fake.getManyExMutex.RUnlock()
+	fake.invalidateTagMutex.RLock()
+	defer fake.invalidateTagMutex.RUnlock()
+	fake.scanMutex.RLock()
+	defer fake.scanMutex.RUnlock()
 	fake.setMutex.RLock()
 	defer fake.setMutex.RUnlock()
+	fake.setManyMutex.RLock()
+	defer fake.setManyMutex.RUnlock()
+	fake.setTagsMutex.RLock()
+	defer fake.setTagsMutex.RUnlock()
+	fake.setWithEventMutex.RLock()
+	defer fake.setWithEventMutex.RUnlock()
+	fake.sizeMutex.RLock()
+	defer fake.sizeMutex.RUnlock()
+	fake.startJobMutex.RLock()
+	defer fake.startJobMutex.RUnlock()
+	fake.tTLMutex.RLock()
+	defer fake.tTLMutex.RUnlock()
+	fake.unlinkMutex.RLock()
+	defer fake.unlinkMutex.RUnlock()
+	fake.updateMutex.RLock()
+	defer fake.updateMutex.RUnlock()
+	fake.updateJobMutex.RLock()
+	defer fake.updateJobMutex.RUnlock()
 	copiedInvocations := map[string][][]interface{}{}
 	for key, value := range fake.invocations {
 		copiedInvocations[key] = value
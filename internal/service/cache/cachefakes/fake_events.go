@@ -5,93 +5,180 @@ import (
 	"context"
 	"sync"
 
+	"github.com/eclipse-xfsc/redis-cache-service/internal/clients/event"
 	"github.com/eclipse-xfsc/redis-cache-service/internal/service/cache"
 )
 
 type FakeEvents struct {
-	SendStub        func(context.Context, string) error
-	sendMutex       sync.RWMutex
-	sendArgsForCall []struct {
+	NewDeleteEventStub        func(context.Context, event.Entry) ([]byte, error)
+	newDeleteEventMutex       sync.RWMutex
+	newDeleteEventArgsForCall []struct {
 		arg1 context.Context
-		arg2 string
+		arg2 event.Entry
 	}
-	sendReturns struct {
-		result1 error
+	newDeleteEventReturns struct {
+		result1 []byte
+		result2 error
 	}
-	sendReturnsOnCall map[int]struct {
-		result1 error
+	newDeleteEventReturnsOnCall map[int]struct {
+		result1 []byte
+		result2 error
+	}
+	NewSetEventStub        func(context.Context, event.Entry) ([]byte, error)
+	newSetEventMutex       sync.RWMutex
+	newSetEventArgsForCall []struct {
+		arg1 context.Context
+		arg2 event.Entry
+	}
+	newSetEventReturns struct {
+		result1 []byte
+		result2 error
+	}
+	newSetEventReturnsOnCall map[int]struct {
+		result1 []byte
+		result2 error
 	}
 	invocations      map[string][][]interface{}
 	invocationsMutex sync.RWMutex
 }
 
-func (fake *FakeEvents) Send(arg1 context.Context, arg2 string) error {
-	fake.sendMutex.Lock()
-	ret, specificReturn := fake.sendReturnsOnCall[len(fake.sendArgsForCall)]
-	fake.sendArgsForCall = append(fake.sendArgsForCall, struct {
+func (fake *FakeEvents) NewDeleteEvent(arg1 context.Context, arg2 event.Entry) ([]byte, error) {
+	fake.newDeleteEventMutex.Lock()
+	ret, specificReturn := fake.newDeleteEventReturnsOnCall[len(fake.newDeleteEventArgsForCall)]
+	fake.newDeleteEventArgsForCall = append(fake.newDeleteEventArgsForCall, struct {
+		arg1 context.Context
+		arg2 event.Entry
+	}{arg1, arg2})
+	stub := fake.NewDeleteEventStub
+	fakeReturns := fake.newDeleteEventReturns
+	fake.recordInvocation("NewDeleteEvent", []interface{}{arg1, arg2})
+	fake.newDeleteEventMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeEvents) NewDeleteEventCallCount() int {
+	fake.newDeleteEventMutex.RLock()
+	defer fake.newDeleteEventMutex.RUnlock()
+	return len(fake.newDeleteEventArgsForCall)
+}
+
+func (fake *FakeEvents) NewDeleteEventCalls(stub func(context.Context, event.Entry) ([]byte, error)) {
+	fake.newDeleteEventMutex.Lock()
+	defer fake.newDeleteEventMutex.Unlock()
+	fake.NewDeleteEventStub = stub
+}
+
+func (fake *FakeEvents) NewDeleteEventArgsForCall(i int) (context.Context, event.Entry) {
+	fake.newDeleteEventMutex.RLock()
+	defer fake.newDeleteEventMutex.RUnlock()
+	argsForCall := fake.newDeleteEventArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeEvents) NewDeleteEventReturns(result1 []byte, result2 error) {
+	fake.newDeleteEventMutex.Lock()
+	defer fake.newDeleteEventMutex.Unlock()
+	fake.NewDeleteEventStub = nil
+	fake.newDeleteEventReturns = struct {
+		result1 []byte
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeEvents) NewDeleteEventReturnsOnCall(i int, result1 []byte, result2 error) {
+	fake.newDeleteEventMutex.Lock()
+	defer fake.newDeleteEventMutex.Unlock()
+	fake.NewDeleteEventStub = nil
+	if fake.newDeleteEventReturnsOnCall == nil {
+		fake.newDeleteEventReturnsOnCall = make(map[int]struct {
+			result1 []byte
+			result2 error
+		})
+	}
+	fake.newDeleteEventReturnsOnCall[i] = struct {
+		result1 []byte
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeEvents) NewSetEvent(arg1 context.Context, arg2 event.Entry) ([]byte, error) {
+	fake.newSetEventMutex.Lock()
+	ret, specificReturn := fake.newSetEventReturnsOnCall[len(fake.newSetEventArgsForCall)]
+	fake.newSetEventArgsForCall = append(fake.newSetEventArgsForCall, struct {
 		arg1 context.Context
-		arg2 string
+		arg2 event.Entry
 	}{arg1, arg2})
-	stub := fake.SendStub
-	fakeReturns := fake.sendReturns
-	fake.recordInvocation("Send", []interface{}{arg1, arg2})
-	fake.sendMutex.Unlock()
+	stub := fake.NewSetEventStub
+	fakeReturns := fake.newSetEventReturns
+	fake.recordInvocation("NewSetEvent", []interface{}{arg1, arg2})
+	fake.newSetEventMutex.Unlock()
 	if stub != nil {
 		return stub(arg1, arg2)
 	}
 	if specificReturn {
-		return ret.result1
+		return ret.result1, ret.result2
 	}
-	return fakeReturns.result1
+	return fakeReturns.result1, fakeReturns.result2
 }
 
-func (fake *FakeEvents) SendCallCount() int {
-	fake.sendMutex.RLock()
-	defer fake.sendMutex.RUnlock()
-	return len(fake.sendArgsForCall)
+func (fake *FakeEvents) NewSetEventCallCount() int {
+	fake.newSetEventMutex.RLock()
+	defer fake.newSetEventMutex.RUnlock()
+	return len(fake.newSetEventArgsForCall)
 }
 
-func (fake *FakeEvents) SendCalls(stub func(context.Context, string) error) {
-	fake.sendMutex.Lock()
-	defer fake.sendMutex.Unlock()
-	fake.SendStub = stub
+func (fake *FakeEvents) NewSetEventCalls(stub func(context.Context, event.Entry) ([]byte, error)) {
+	fake.newSetEventMutex.Lock()
+	defer fake.newSetEventMutex.Unlock()
+	fake.NewSetEventStub = stub
 }
 
-func (fake *FakeEvents) SendArgsForCall(i int) (context.Context, string) {
-	fake.sendMutex.RLock()
-	defer fake.sendMutex.RUnlock()
-	argsForCall := fake.sendArgsForCall[i]
+func (fake *FakeEvents) NewSetEventArgsForCall(i int) (context.Context, event.Entry) {
+	fake.newSetEventMutex.RLock()
+	defer fake.newSetEventMutex.RUnlock()
+	argsForCall := fake.newSetEventArgsForCall[i]
 	return argsForCall.arg1, argsForCall.arg2
 }
 
-func (fake *FakeEvents) SendReturns(result1 error) {
-	fake.sendMutex.Lock()
-	defer fake.sendMutex.Unlock()
-	fake.SendStub = nil
-	fake.sendReturns = struct {
-		result1 error
-	}{result1}
+func (fake *FakeEvents) NewSetEventReturns(result1 []byte, result2 error) {
+	fake.newSetEventMutex.Lock()
+	defer fake.newSetEventMutex.Unlock()
+	fake.NewSetEventStub = nil
+	fake.newSetEventReturns = struct {
+		result1 []byte
+		result2 error
+	}{result1, result2}
 }
 
-func (fake *FakeEvents) SendReturnsOnCall(i int, result1 error) {
-	fake.sendMutex.Lock()
-	defer fake.sendMutex.Unlock()
-	fake.SendStub = nil
-	if fake.sendReturnsOnCall == nil {
-		fake.sendReturnsOnCall = make(map[int]struct {
-			result1 error
+func (fake *FakeEvents) NewSetEventReturnsOnCall(i int, result1 []byte, result2 error) {
+	fake.newSetEventMutex.Lock()
+	defer fake.newSetEventMutex.Unlock()
+	fake.NewSetEventStub = nil
+	if fake.newSetEventReturnsOnCall == nil {
+		fake.newSetEventReturnsOnCall = make(map[int]struct {
+			result1 []byte
+			result2 error
 		})
 	}
-	fake.sendReturnsOnCall[i] = struct {
-		result1 error
-	}{result1}
+	fake.newSetEventReturnsOnCall[i] = struct {
+		result1 []byte
+		result2 error
+	}{result1, result2}
 }
 
 func (fake *FakeEvents) Invocations() map[string][][]interface{} {
 	fake.invocationsMutex.RLock()
 	defer fake.invocationsMutex.RUnlock()
-	fake.sendMutex.RLock()
-	defer fake.sendMutex.RUnlock()
+	fake.newDeleteEventMutex.RLock()
+	defer fake.newDeleteEventMutex.RUnlock()
+	fake.newSetEventMutex.RLock()
+	defer fake.newSetEventMutex.RUnlock()
 	copiedInvocations := map[string][][]interface{}{}
 	for key, value := range fake.invocations {
 		copiedInvocations[key] = value
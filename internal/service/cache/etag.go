@@ -0,0 +1,36 @@
+package cache
+
+import (
+	"crypto/sha1" //nolint:gosec
+	"encoding/hex"
+	"strings"
+)
+
+// makeETag returns the strong entity tag of a stored value. It is the
+// SHA-1 of the value, which is also available to Lua scripts in Redis
+// as redis.sha1hex, so that tags can be compared atomically in Redis.
+func makeETag(value []byte) string {
+	sum := sha1.Sum(value) //nolint:gosec
+	return `"` + hex.EncodeToString(sum[:]) + `"`
+}
+
+// etagHash returns the hash of an entity tag, stripping the quotes
+// and the weak validator prefix.
+func etagHash(etag string) string {
+	etag = strings.TrimSpace(etag)
+	etag = strings.TrimPrefix(etag, "W/")
+	return strings.Trim(etag, `"`)
+}
+
+// matchETag reports whether an If-None-Match header value
+// matches the entity tag. The header may contain a list
+// of tags or "*", which matches any tag.
+func matchETag(header, etag string) bool {
+	for _, tag := range strings.Split(header, ",") {
+		tag = strings.TrimSpace(tag)
+		if tag == "*" || etagHash(tag) == etagHash(etag) {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,218 @@
+package cache
+
+import (
+	"context"
+	"strconv"
+	"time"
+
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+
+	errors "github.com/eclipse-xfsc/microservice-core-go/pkg/err"
+	"github.com/eclipse-xfsc/redis-cache-service/gen/cache"
+)
+
+// Statuses of background jobs.
+const (
+	jobRunning   = "running"
+	jobCompleted = "completed"
+	jobFailed    = "failed"
+)
+
+// jobRetention is the time for which finished jobs can still be requested.
+const jobRetention = time.Hour
+
+// deleteBatchSize is the number of keys scanned and unlinked at once by delete jobs.
+const deleteBatchSize = 500
+
+// jobStaleAfter is the time after which a running job without progress is
+// reported as failed, e.g. because the instance running it has stopped.
+const jobStaleAfter = 2 * time.Minute
+
+// jobLock returns the name of the lock which allows only one running job
+// per namespace and scope across all instances.
+func jobLock(namespace, scope string) string {
+	return "deleteNamespace:" + keyPartEscaper.Replace(namespace) + ":" + keyPartEscaper.Replace(scope)
+}
+
+// startJob stores a new job for the namespace and scope and reports whether it
+// has been created. If such a job is already running, that job is returned instead.
+func (s *Service) startJob(ctx context.Context, namespace, scope string) (*cache.CacheJob, bool, error) {
+	now := time.Now().UTC().Format(time.RFC3339)
+	job := &cache.CacheJob{
+		ID:        uuid.NewString(),
+		Namespace: namespace,
+		Status:    jobRunning,
+		StartedAt: now,
+	}
+	if scope != "" {
+		job.Scope = &scope
+	}
+
+	fields := map[string]string{
+		"id":        job.ID,
+		"namespace": namespace,
+		"scope":     scope,
+		"status":    jobRunning,
+		"deleted":   "0",
+		"startedAt": now,
+		"updatedAt": now,
+	}
+	id, err := s.cache.StartJob(ctx, job.ID, jobLock(namespace, scope), fields, jobRetention)
+	if err != nil {
+		return nil, false, err
+	}
+	if id != job.ID {
+		running, err := s.getJob(ctx, id)
+		if err != nil {
+			return nil, false, err
+		}
+		return running, false, nil
+	}
+	return job, true, nil
+}
+
+// progress adds the number of deleted keys to the job.
+func (s *Service) progress(ctx context.Context, id, lock string, deleted int64) error {
+	fields := map[string]string{"updatedAt": time.Now().UTC().Format(time.RFC3339)}
+	return s.cache.UpdateJob(ctx, id, lock, fields, deleted, jobRetention)
+}
+
+// finish sets the final status of the job depending on err.
+func (s *Service) finish(ctx context.Context, id, lock string, err error) error {
+	now := time.Now().UTC().Format(time.RFC3339)
+	fields := map[string]string{
+		"status":     jobCompleted,
+		"updatedAt":  now,
+		"finishedAt": now,
+	}
+	if err != nil {
+		fields["status"] = jobFailed
+		fields["error"] = err.Error()
+	}
+	return s.cache.FinishJob(ctx, id, lock, fields, jobRetention)
+}
+
+// getJob reads the job from the cache. A running job which hasn't made
+// progress for jobStaleAfter is reported as failed.
+func (s *Service) getJob(ctx context.Context, id string) (*cache.CacheJob, error) {
+	fields, err := s.cache.GetJob(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	job := &cache.CacheJob{
+		ID:        fields["id"],
+		Namespace: fields["namespace"],
+		Status:    fields["status"],
+		StartedAt: fields["startedAt"],
+	}
+	job.Deleted, _ = strconv.ParseInt(fields["deleted"], 10, 64)
+	if scope := fields["scope"]; scope != "" {
+		job.Scope = &scope
+	}
+	if finishedAt := fields["finishedAt"]; finishedAt != "" {
+		job.FinishedAt = &finishedAt
+	}
+	if msg := fields["error"]; msg != "" {
+		job.Error = &msg
+	}
+
+	if job.Status == jobRunning {
+		updatedAt, err := time.Parse(time.RFC3339, fields["updatedAt"])
+		if err != nil || time.Since(updatedAt) > jobStaleAfter {
+			msg := "job has been interrupted"
+			job.Status = jobFailed
+			job.Error = &msg
+		}
+	}
+	return job, nil
+}
+
+// DeleteNamespace starts a background job which deletes all entries of the
+// namespace, or of the namespace and scope. The job is returned immediately
+// and its progress can be requested with Job.
+func (s *Service) DeleteNamespace(ctx context.Context, req *cache.CacheDeleteNamespaceRequest) (*cache.CacheJob, error) {
+	if req.Namespace == "" {
+		return nil, errors.New(errors.BadRequest, "missing namespace")
+	}
+
+	var scope string
+	if req.Scope != nil {
+		scope = *req.Scope
+	}
+
+	job, started, err := s.startJob(ctx, req.Namespace, scope)
+	if err != nil {
+		s.logger.Error("error starting job", zap.Error(err))
+		return nil, errors.New("error starting job", err)
+	}
+	if started {
+		s.logger.Info("deleting namespace", zap.String("job", job.ID), zap.String("namespace", req.Namespace), zap.String("scope", scope))
+		go s.deleteNamespace(context.WithoutCancel(ctx), job.ID, req.Namespace, scope)
+	}
+	return job, nil
+}
+
+// Job returns the status of a background job.
+func (s *Service) Job(ctx context.Context, req *cache.CacheJobRequest) (*cache.CacheJob, error) {
+	job, err := s.getJob(ctx, req.ID)
+	if err != nil {
+		if errors.Is(errors.NotFound, err) {
+			return nil, errors.New(errors.NotFound, "job not found")
+		}
+		s.logger.Error("error getting job", zap.String("job", req.ID), zap.Error(err))
+		return nil, errors.New("error getting job", err)
+	}
+	return job, nil
+}
+
+// deleteNamespace deletes the entries of all key formats in which they may be
+// stored, so that no stale entry is found by a legacy fallback lookup.
+func (s *Service) deleteNamespace(ctx context.Context, id, namespace, scope string) {
+	logger := s.logger.With(zap.String("operation", "deleteNamespace"), zap.String("job", id))
+
+	lock := jobLock(namespace, scope)
+	var err error
+	for _, format := range s.keyFormats() {
+		if err = s.deleteKeys(ctx, id, lock, format, namespace, scope); err != nil {
+			logger.Error("error deleting namespace from cache", zap.Error(err))
+			break
+		}
+	}
+	if err := s.finish(ctx, id, lock, err); err != nil {
+		logger.Error("error finishing job", zap.Error(err))
+	}
+}
+
+func (s *Service) deleteKeys(ctx context.Context, id, lock string, format KeyFormat, namespace, scope string) error {
+	match := keyPattern(format, namespace, scope, "")
+
+	var cursor string
+	for {
+		keys, next, err := s.cache.Scan(ctx, cursor, match, deleteBatchSize)
+		if err != nil {
+			return err
+		}
+
+		var matched []string
+		for _, key := range keys {
+			if _, _, ok := parseKey(format, key, namespace, scope, ""); ok {
+				matched = append(matched, key)
+			}
+		}
+
+		deleted, err := s.cache.Unlink(ctx, matched)
+		if err != nil {
+			return err
+		}
+		if err := s.progress(ctx, id, lock, deleted); err != nil {
+			s.logger.Warn("error updating job", zap.String("job", id), zap.Error(err))
+		}
+
+		if next == "" {
+			return nil
+		}
+		cursor = next
+	}
+}
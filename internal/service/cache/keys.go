@@ -0,0 +1,236 @@
+package cache
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/eclipse-xfsc/redis-cache-service/internal/clients/redis"
+)
+
+// KeyFormat specifies how key, namespace and scope are composed
+// into the key under which a value is stored in Redis.
+type KeyFormat string
+
+const (
+	// KeyFormatLegacy joins key, namespace and scope with commas.
+	// It is ambiguous when the parts contain commas and is kept
+	// for compatibility with already stored entries.
+	KeyFormatLegacy KeyFormat = "legacy"
+
+	// KeyFormatV2 composes keys as "v2:{namespace}:{scope}:{key}" where
+	// every part is escaped, so different inputs never collide.
+	KeyFormatV2 KeyFormat = "v2"
+)
+
+const keyV2Prefix = "v2:"
+
+// keyPartEscaper escapes the separator and the escape character itself,
+// so that every v2 key can be decoded back to its parts.
+var keyPartEscaper = strings.NewReplacer("%", "%25", ":", "%3A")
+
+// ParseKeyFormat returns the KeyFormat for the given configuration value.
+func ParseKeyFormat(format string) (KeyFormat, error) {
+	switch f := KeyFormat(strings.ToLower(format)); f {
+	case "", KeyFormatLegacy:
+		return KeyFormatLegacy, nil
+	case KeyFormatV2:
+		return f, nil
+	default:
+		return "", fmt.Errorf("unknown cache key format: %q", format)
+	}
+}
+
+// WithKeyFormat sets the format of the storage keys. If legacyFallback is true
+// and the format is not legacy, lookups which don't find a value under the new key
+// are retried with the legacy key, so entries written before the switch are still found.
+func WithKeyFormat(format KeyFormat, legacyFallback bool) Option {
+	return func(s *Service) {
+		s.keyFormat = format
+		s.legacyFallback = legacyFallback
+	}
+}
+
+// cacheKey creates the storage key from the input fields.
+func (s *Service) cacheKey(key string, namespace, scope *string) string {
+	if s.keyFormat == KeyFormatV2 {
+		return makeCacheKeyV2(key, namespace, scope)
+	}
+	return makeCacheKey(key, namespace, scope)
+}
+
+// lookupKeys returns the storage keys under which a value may be found,
+// in the order in which they should be tried.
+func (s *Service) lookupKeys(key string, namespace, scope *string) []string {
+	keys := []string{s.cacheKey(key, namespace, scope)}
+	if s.legacyFallback && s.keyFormat != KeyFormatLegacy {
+		keys = append(keys, makeCacheKey(key, namespace, scope))
+	}
+	return keys
+}
+
+// obsoleteKey returns the legacy key of an entry in migration mode, which is
+// removed when the entry is written with the new key, so that lookups don't
+// fall back to its stale value once the new entry expires or is invalidated.
+// Otherwise it returns an empty string.
+func (s *Service) obsoleteKey(key string, namespace, scope *string) string {
+	if s.legacyFallback && s.keyFormat != KeyFormatLegacy {
+		return makeCacheKey(key, namespace, scope)
+	}
+	return ""
+}
+
+func makeCacheKey(key string, namespace, scope *string) string {
+	k := key
+	if namespace != nil && *namespace != "" {
+		k += "," + *namespace
+	}
+	if scope != nil && *scope != "" {
+		k += "," + *scope
+	}
+	return k
+}
+
+// stringValue returns the value of an optional request field.
+func stringValue(s *string) string {
+	if s == nil {
+		return ""
+	}
+	return *s
+}
+
+func makeCacheKeyV2(key string, namespace, scope *string) string {
+	var ns, sc string
+	if namespace != nil {
+		ns = *namespace
+	}
+	if scope != nil {
+		sc = *scope
+	}
+	return keyV2Prefix + keyPartEscaper.Replace(ns) + ":" + keyPartEscaper.Replace(sc) + ":" + keyPartEscaper.Replace(key)
+}
+
+// keyFormats returns the formats in which entries may be stored.
+func (s *Service) keyFormats() []KeyFormat {
+	formats := []KeyFormat{s.keyFormat}
+	if s.legacyFallback && s.keyFormat != KeyFormatLegacy {
+		formats = append(formats, KeyFormatLegacy)
+	}
+	return formats
+}
+
+// globEscaper escapes the special characters of Redis glob-style patterns.
+var globEscaper = strings.NewReplacer(`\`, `\\`, "*", `\*`, "?", `\?`, "[", `\[`, "]", `\]`)
+
+// keyPartUnescaper reverses keyPartEscaper.
+var keyPartUnescaper = strings.NewReplacer("%3A", ":", "%25", "%")
+
+// keyPattern returns the SCAN pattern for storage keys of the namespace,
+// optionally restricted to the scope and a key prefix. The pattern only
+// narrows the scan, matched keys must still be checked with parseKey.
+func keyPattern(format KeyFormat, namespace, scope, prefix string) string {
+	if format == KeyFormatV2 {
+		pattern := keyV2Prefix + globEscaper.Replace(keyPartEscaper.Replace(namespace)) + ":"
+		if scope != "" {
+			pattern += globEscaper.Replace(keyPartEscaper.Replace(scope)) + ":"
+		} else {
+			pattern += "*:"
+		}
+		return pattern + globEscaper.Replace(keyPartEscaper.Replace(prefix)) + "*"
+	}
+
+	pattern := globEscaper.Replace(prefix) + "*," + globEscaper.Replace(namespace)
+	if scope != "" {
+		return pattern + "," + globEscaper.Replace(scope)
+	}
+	return pattern + "*"
+}
+
+// parseKey returns the key and scope of a storage key if it has been composed
+// for the namespace and the optional scope and its key starts with prefix.
+func parseKey(format KeyFormat, storageKey, namespace, scope, prefix string) (key, keyScope string, ok bool) {
+	// internal keys such as the tag index of an entry may match legacy patterns
+	if redis.IsInternalKey(storageKey) {
+		return "", "", false
+	}
+	if format == KeyFormatV2 {
+		key, keyScope, ok = parseCacheKeyV2(storageKey, namespace, scope)
+	} else {
+		key, keyScope, ok = parseCacheKey(storageKey, namespace, scope)
+	}
+	if !ok || key == "" || !strings.HasPrefix(key, prefix) {
+		return "", "", false
+	}
+	return key, keyScope, true
+}
+
+// invalidKey returns why the key cannot be used as the key of an entry, or an
+// empty string if it can. Keys must not start with the prefix of an internal
+// key, as their legacy storage keys would be taken for it.
+func invalidKey(key string) string {
+	switch {
+	case key == "":
+		return "missing key"
+	case redis.IsInternalKey(key):
+		return "key starts with a reserved prefix"
+	}
+	return ""
+}
+
+// SplitKey splits a storage key of any format into key, namespace and scope.
+// Legacy keys are ambiguous, keys with two comma separated parts are taken
+// to consist of key and namespace, longer keys to end with namespace and scope.
+func SplitKey(storageKey string) (key, namespace, scope string) {
+	if rest, ok := strings.CutPrefix(storageKey, keyV2Prefix); ok {
+		if parts := strings.Split(rest, ":"); len(parts) == 3 {
+			return keyPartUnescaper.Replace(parts[2]), keyPartUnescaper.Replace(parts[0]), keyPartUnescaper.Replace(parts[1])
+		}
+	}
+
+	parts := strings.Split(storageKey, ",")
+	switch len(parts) {
+	case 1:
+		return storageKey, "", ""
+	case 2:
+		return parts[0], parts[1], ""
+	}
+	return strings.Join(parts[:len(parts)-2], ","), parts[len(parts)-2], parts[len(parts)-1]
+}
+
+// parseCacheKey splits a key composed by makeCacheKey. Legacy keys are ambiguous
+// if their parts contain commas, so keys with more than two comma separated parts
+// are taken to end with namespace and scope.
+func parseCacheKey(storageKey, namespace, scope string) (string, string, bool) {
+	if scope != "" {
+		suffix := "," + namespace + "," + scope
+		if !strings.HasSuffix(storageKey, suffix) {
+			return "", "", false
+		}
+		return strings.TrimSuffix(storageKey, suffix), scope, true
+	}
+
+	parts := strings.Split(storageKey, ",")
+	switch {
+	case len(parts) == 2 && parts[1] == namespace:
+		return parts[0], "", true
+	case len(parts) > 2 && parts[len(parts)-2] == namespace:
+		return strings.Join(parts[:len(parts)-2], ","), parts[len(parts)-1], true
+	}
+	return "", "", false
+}
+
+// parseCacheKeyV2 splits a key composed by makeCacheKeyV2.
+func parseCacheKeyV2(storageKey, namespace, scope string) (string, string, bool) {
+	rest, ok := strings.CutPrefix(storageKey, keyV2Prefix)
+	if !ok {
+		return "", "", false
+	}
+
+	parts := strings.Split(rest, ":")
+	if len(parts) != 3 || parts[0] != keyPartEscaper.Replace(namespace) {
+		return "", "", false
+	}
+	if scope != "" && parts[1] != keyPartEscaper.Replace(scope) {
+		return "", "", false
+	}
+	return keyPartUnescaper.Replace(parts[2]), keyPartUnescaper.Replace(parts[1]), true
+}
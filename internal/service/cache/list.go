@@ -0,0 +1,104 @@
+package cache
+
+import (
+	"context"
+	"strings"
+
+	"go.uber.org/zap"
+
+	errors "github.com/eclipse-xfsc/microservice-core-go/pkg/err"
+	"github.com/eclipse-xfsc/redis-cache-service/gen/cache"
+)
+
+// maxScansPerPage limits the SCAN calls for a single page, so that sparse
+// namespaces don't block a request until the whole keyspace has been iterated.
+// A page may therefore contain fewer keys than requested while a cursor is returned.
+const maxScansPerPage = 10
+
+// legacyCursorPrefix marks cursors which continue with the legacy keys in
+// migration mode. Cursors of the cache are base64 encoded without colons.
+const legacyCursorPrefix = "legacy:"
+
+// Keys lists the entries stored under a namespace. Storage keys are iterated with
+// SCAN and only returned if they have been composed exactly for the namespace and
+// the optional scope. In migration mode the keys of the configured format are
+// listed first and then the legacy keys, whose entries aren't stored under the
+// configured format as well. The limit is a hint: a page ends with the SCAN call
+// which reaches it, so it may contain more keys, or fewer if the scans are exhausted.
+func (s *Service) Keys(ctx context.Context, req *cache.CacheKeysRequest) (*cache.CacheKeysResult, error) {
+	logger := s.logger.With(zap.String("operation", "keys"))
+
+	var scope, prefix, cursor string
+	if req.Scope != nil {
+		scope = *req.Scope
+	}
+	if req.Prefix != nil {
+		prefix = *req.Prefix
+	}
+	if req.Cursor != nil {
+		cursor = *req.Cursor
+	}
+
+	// the index of the format which is currently scanned is part of the cursor
+	formats := s.keyFormats()
+	phase := 0
+	if rest, ok := strings.CutPrefix(cursor, legacyCursorPrefix); ok {
+		if len(formats) == 1 {
+			return nil, errors.New(errors.BadRequest, "invalid cursor: legacy keys are not listed")
+		}
+		phase, cursor = 1, rest
+	}
+
+	res := &cache.CacheKeysResult{Keys: []*cache.CacheKeysItem{}}
+	for i := 0; i < maxScansPerPage && phase < len(formats); i++ {
+		format := formats[phase]
+		match := keyPattern(format, req.Namespace, scope, prefix)
+		keys, next, err := s.cache.Scan(ctx, cursor, match, int64(req.Limit))
+		if err != nil {
+			if errors.Is(errors.BadRequest, err) {
+				return nil, err
+			}
+			logger.Error("error listing keys", zap.Error(err))
+			return nil, errors.New("error listing keys", err)
+		}
+
+		for _, storageKey := range keys {
+			key, keyScope, ok := parseKey(format, storageKey, req.Namespace, scope, prefix)
+			if !ok {
+				continue
+			}
+			if format != s.keyFormat {
+				// entries written since the migration are listed with the configured format
+				exists, err := s.cache.Exists(ctx, s.cacheKey(key, &req.Namespace, &keyScope))
+				if err != nil {
+					logger.Error("error checking key existence in cache", zap.Error(err))
+					return nil, errors.New("error listing keys", err)
+				}
+				if exists {
+					continue
+				}
+			}
+			item := &cache.CacheKeysItem{Key: key}
+			if keyScope != "" {
+				item.Scope = &keyScope
+			}
+			res.Keys = append(res.Keys, item)
+		}
+
+		cursor = next
+		if cursor == "" {
+			phase++
+		}
+		if len(res.Keys) >= req.Limit {
+			break
+		}
+	}
+
+	if phase < len(formats) {
+		if phase > 0 {
+			cursor = legacyCursorPrefix + cursor
+		}
+		res.Cursor = &cursor
+	}
+	return res, nil
+}
@@ -0,0 +1,111 @@
+package cache
+
+import (
+	"context"
+	"encoding/json"
+	stderrors "errors"
+	"mime"
+
+	jsonpatch "github.com/evanphx/json-patch/v5"
+	"go.uber.org/zap"
+
+	errors "github.com/eclipse-xfsc/microservice-core-go/pkg/err"
+	"github.com/eclipse-xfsc/redis-cache-service/gen/cache"
+	"github.com/eclipse-xfsc/redis-cache-service/internal/metrics"
+)
+
+// Media types of the supported patch formats.
+const (
+	mergePatchType = "application/merge-patch+json"
+	jsonPatchType  = "application/json-patch+json"
+)
+
+// Patch applies a JSON merge patch (RFC 7386) or a JSON patch (RFC 6902),
+// depending on the content type, to a stored JSON value and returns the
+// updated value. The value is updated atomically and keeps its TTL.
+func (s *Service) Patch(ctx context.Context, req *cache.CachePatchRequest) (*cache.CacheGetResult, error) {
+	logger := s.logger.With(zap.String("operation", "patch"))
+
+	if msg := invalidKey(req.Key); msg != "" {
+		logger.Error("bad request: " + msg)
+		return nil, errors.New(errors.BadRequest, msg)
+	}
+
+	patch, err := json.Marshal(req.Patch)
+	if err != nil {
+		logger.Error("error encode patch to json", zap.Error(err))
+		return nil, errors.New(errors.BadRequest, "cannot encode patch to json", err)
+	}
+
+	apply, err := patchFunc(req.ContentType, patch)
+	if err != nil {
+		logger.Error("bad request: invalid patch", zap.Error(err))
+		return nil, err
+	}
+
+	var data []byte
+	for _, key := range s.lookupKeys(req.Key, req.Namespace, req.Scope) {
+		data, err = s.cache.Update(ctx, key, apply)
+		if err == nil || !errors.Is(errors.NotFound, err) {
+			break
+		}
+	}
+	if err != nil {
+		switch {
+		case errors.Is(errors.NotFound, err):
+			return nil, errors.New(errors.NotFound, "key not found in cache", err)
+		case errors.Is(errors.BadRequest, err), errors.Is(errors.Exist, err):
+			return nil, err
+		}
+		logger.Error("error patching value in cache", zap.Error(err))
+		return nil, errors.New("error patching value in cache", err)
+	}
+	metrics.ObserveValueSize(req.Namespace, "patch", len(data))
+
+	decodedValue, err := unmarshalCacheData(data)
+	if err != nil {
+		logger.Error("error decoding patched value", zap.Error(err))
+		return nil, errors.New("cannot decode json value from cache", err)
+	}
+
+	etag := makeETag(data)
+	return &cache.CacheGetResult{Data: decodedValue, Etag: &etag}, nil
+}
+
+// patchFunc returns the function which applies the patch to a JSON document.
+func patchFunc(contentType *string, patch []byte) (func([]byte) ([]byte, error), error) {
+	var mediaType string
+	if contentType != nil {
+		mediaType, _, _ = mime.ParseMediaType(*contentType)
+	}
+
+	switch mediaType {
+	case mergePatchType:
+		return func(doc []byte) ([]byte, error) {
+			patched, err := jsonpatch.MergePatch(doc, patch)
+			if err != nil {
+				return nil, errors.New(errors.BadRequest, "cannot apply merge patch", err)
+			}
+			return patched, nil
+		}, nil
+
+	case jsonPatchType:
+		operations, err := jsonpatch.DecodePatch(patch)
+		if err != nil {
+			return nil, errors.New(errors.BadRequest, "invalid json patch", err)
+		}
+		return func(doc []byte) ([]byte, error) {
+			patched, err := operations.Apply(doc)
+			if err != nil {
+				// a failed test operation means the document is not in the expected state
+				if stderrors.Is(err, jsonpatch.ErrTestFailed) {
+					return nil, errors.New(errors.Exist, "json patch test failed", err)
+				}
+				return nil, errors.New(errors.BadRequest, "cannot apply json patch", err)
+			}
+			return patched, nil
+		}, nil
+	}
+
+	return nil, errors.New(errors.BadRequest, "unsupported patch content type, expected "+mergePatchType+" or "+jsonPatchType)
+}
@@ -0,0 +1,113 @@
+package cache
+
+import (
+	"fmt"
+	"os"
+	"time"
+
+	"gopkg.in/yaml.v3"
+
+	errors "github.com/eclipse-xfsc/microservice-core-go/pkg/err"
+)
+
+// anyNamespace is the key of the policy which applies to all
+// namespaces without their own policy.
+const anyNamespace = "*"
+
+// TTLPolicy restricts the TTL of the entries of a namespace.
+type TTLPolicy struct {
+	// DefaultTTL is applied to entries which are set without TTL.
+	DefaultTTL time.Duration `yaml:"defaultTTL"`
+	// MaxTTL is the maximum TTL, longer TTLs are reduced to it.
+	MaxTTL time.Duration `yaml:"maxTTL"`
+	// TTLRequired rejects entries which are set without TTL.
+	TTLRequired bool `yaml:"ttlRequired"`
+}
+
+// TTLPolicies maps namespaces to their TTL policy. The policy of "*"
+// applies to all namespaces without their own policy.
+type TTLPolicies map[string]TTLPolicy
+
+type ttlPolicyFile struct {
+	Namespaces TTLPolicies `yaml:"namespaces"`
+}
+
+// LoadTTLPolicies reads the TTL policies from a YAML file of the form:
+//
+//	namespaces:
+//	  Login:
+//	    defaultTTL: 30m
+//	    maxTTL: 24h
+//	    ttlRequired: false
+func LoadTTLPolicies(path string) (TTLPolicies, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+
+	var file ttlPolicyFile
+	if err := yaml.Unmarshal(data, &file); err != nil {
+		return nil, fmt.Errorf("cannot parse ttl policy file: %w", err)
+	}
+
+	for namespace, policy := range file.Namespaces {
+		if policy.DefaultTTL < 0 || policy.MaxTTL < 0 {
+			return nil, fmt.Errorf("ttl policy of namespace %q: negative ttl", namespace)
+		}
+		if policy.MaxTTL > 0 && policy.DefaultTTL > policy.MaxTTL {
+			return nil, fmt.Errorf("ttl policy of namespace %q: defaultTTL exceeds maxTTL", namespace)
+		}
+	}
+	return file.Namespaces, nil
+}
+
+// WithTTLPolicies enforces the TTL policies of the namespaces when entries are set.
+func WithTTLPolicies(policies TTLPolicies) Option {
+	return func(s *Service) {
+		s.ttlPolicies = policies
+	}
+}
+
+// ttlPolicy returns the policy of the namespace and whether there is one.
+func (s *Service) ttlPolicy(namespace *string) (TTLPolicy, bool) {
+	var ns string
+	if namespace != nil {
+		ns = *namespace
+	}
+	if policy, ok := s.ttlPolicies[ns]; ok {
+		return policy, true
+	}
+	policy, ok := s.ttlPolicies[anyNamespace]
+	return policy, ok
+}
+
+// applyTTLPolicy returns the TTL with which an entry of the namespace is stored.
+// A zero ttl means that none has been requested. Without default TTL entries
+// of a namespace with maximum TTL are stored with the maximum TTL, so that no
+// entry of the namespace lives forever. Negative TTLs are rejected with or
+// without policy, so that they cannot bypass it.
+func (s *Service) applyTTLPolicy(namespace *string, ttl time.Duration) (time.Duration, error) {
+	if ttl < 0 {
+		return 0, errors.New(errors.BadRequest, "ttl policy violation: negative ttl, the ttl must not be negative")
+	}
+
+	policy, ok := s.ttlPolicy(namespace)
+	if !ok {
+		return ttl, nil
+	}
+
+	if ttl == 0 {
+		switch {
+		case policy.TTLRequired:
+			return 0, errors.New(errors.BadRequest, "ttl policy violation: ttlRequired, the namespace requires a ttl")
+		case policy.DefaultTTL > 0:
+			return policy.DefaultTTL, nil
+		}
+		return policy.MaxTTL, nil
+	}
+
+	if policy.MaxTTL > 0 && ttl > policy.MaxTTL {
+		return policy.MaxTTL, nil
+	}
+	return ttl, nil
+}
@@ -0,0 +1,124 @@
+package cache
+
+import (
+	"fmt"
+	"strings"
+
+	errors "github.com/eclipse-xfsc/microservice-core-go/pkg/err"
+	"github.com/ohler55/ojg/jp"
+)
+
+// projection selects the parts of a decoded value returned by Get.
+type projection func(value interface{}) interface{}
+
+// parseProjection parses the x-cache-fields header. Expressions starting
+// with $ are JSONPath and return the list of matched values. Otherwise
+// the header is a comma separated list of dotted field paths like
+// "name,address.city", which returns an object with only these fields.
+// Paths are applied to every element of arrays on the way.
+func parseProjection(fields *string) (projection, error) {
+	if fields == nil {
+		return nil, nil
+	}
+
+	expr := strings.TrimSpace(*fields)
+	if expr == "" {
+		return nil, errors.New(errors.BadRequest, "invalid fields: empty expression")
+	}
+
+	if strings.HasPrefix(expr, "$") {
+		path, err := jp.ParseString(expr)
+		if err != nil {
+			return nil, errors.New(errors.BadRequest, fmt.Sprintf("invalid fields: JSONPath expression %q: %v", expr, err))
+		}
+		return func(value interface{}) interface{} {
+			matches := path.Get(generic(value))
+			if matches == nil {
+				return []interface{}{}
+			}
+			return matches
+		}, nil
+	}
+
+	tree := fieldTree{}
+	for _, field := range strings.Split(expr, ",") {
+		field = strings.TrimSpace(field)
+		path := strings.Split(field, ".")
+		for _, name := range path {
+			if name == "" {
+				return nil, errors.New(errors.BadRequest, fmt.Sprintf("invalid fields: field path %q has an empty name", field))
+			}
+		}
+		tree.add(path)
+	}
+
+	return func(value interface{}) interface{} {
+		if projected, ok := tree.project(generic(value)); ok {
+			return projected
+		}
+		return map[string]interface{}{}
+	}, nil
+}
+
+// fieldTree holds the selected field paths by name. A nil subtree
+// selects the whole value of the field.
+type fieldTree map[string]fieldTree
+
+func (t fieldTree) add(path []string) {
+	sub, ok := t[path[0]]
+	if ok && sub == nil {
+		return // the whole value is already selected
+	}
+	if len(path) == 1 {
+		t[path[0]] = nil
+		return
+	}
+	if !ok {
+		sub = fieldTree{}
+		t[path[0]] = sub
+	}
+	sub.add(path[1:])
+}
+
+// project returns the selected fields of value. The result is false if
+// value has no fields.
+func (t fieldTree) project(value interface{}) (interface{}, bool) {
+	switch v := value.(type) {
+	case map[string]interface{}:
+		result := make(map[string]interface{}, len(t))
+		for name, sub := range t {
+			field, ok := v[name]
+			if !ok {
+				continue
+			}
+			if sub == nil {
+				result[name] = field
+			} else if projected, ok := sub.project(field); ok {
+				result[name] = projected
+			}
+		}
+		return result, true
+	case []interface{}:
+		result := make([]interface{}, 0, len(v))
+		for _, elem := range v {
+			if projected, ok := t.project(elem); ok {
+				result = append(result, projected)
+			}
+		}
+		return result, true
+	}
+	return nil, false
+}
+
+// generic converts the list of objects returned by unmarshalCacheData
+// into the generic JSON representation.
+func generic(value interface{}) interface{} {
+	if list, ok := value.([]map[string]interface{}); ok {
+		values := make([]interface{}, len(list))
+		for i, v := range list {
+			values[i] = v
+		}
+		return values
+	}
+	return value
+}
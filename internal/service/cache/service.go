@@ -3,7 +3,10 @@ package cache
 import (
 	"context"
 	"encoding/json"
+	stderrors "errors"
 	"fmt"
+	"math"
+	"sort"
 	"strings"
 	"time"
 
@@ -11,6 +14,8 @@ import (
 
 	errors "github.com/eclipse-xfsc/microservice-core-go/pkg/err"
 	"github.com/eclipse-xfsc/redis-cache-service/gen/cache"
+	"github.com/eclipse-xfsc/redis-cache-service/internal/clients/event"
+	"github.com/eclipse-xfsc/redis-cache-service/internal/metrics"
 )
 
 //go:generate counterfeiter . Cache
@@ -18,33 +23,75 @@ import (
 
 type Cache interface {
 	Get(ctx context.Context, key string) ([]byte, error)
-	Set(ctx context.Context, key string, value []byte, ttl time.Duration) error
+	GetEx(ctx context.Context, key string, ttl time.Duration) ([]byte, error)
+	Set(ctx context.Context, key string, value []byte, ttl time.Duration, condition string, tags []string, obsolete string) error
+	CompareAndSet(ctx context.Context, key string, value []byte, ttl time.Duration, hash string) error
+	SetWithEvent(ctx context.Context, key string, value []byte, ttl time.Duration, condition, hash string, event []byte) error
+	Update(ctx context.Context, key string, fn func([]byte) ([]byte, error)) ([]byte, error)
+	Delete(ctx context.Context, key string) error
+	DeleteWithEvent(ctx context.Context, key string, event []byte) error
+	Exists(ctx context.Context, key string) (bool, error)
+	TTL(ctx context.Context, key string) (time.Duration, error)
+	Size(ctx context.Context, key string) (int64, error)
+	GetMany(ctx context.Context, keys []string) ([][]byte, error)
+	GetManyEx(ctx context.Context, keys []string, ttl time.Duration) ([][]byte, error)
+	SetMany(ctx context.Context, keys []string, values [][]byte, ttls []time.Duration, obsolete []string) []error
+	Scan(ctx context.Context, cursor, match string, count int64) ([]string, string, error)
+	Unlink(ctx context.Context, keys []string) (int64, error)
+	SetTags(ctx context.Context, key string, tags []string, ttl time.Duration) error
+	InvalidateTag(ctx context.Context, tag string) (int64, error)
+	StartJob(ctx context.Context, id, lock string, fields map[string]string, ttl time.Duration) (string, error)
+	UpdateJob(ctx context.Context, id, lock string, fields map[string]string, deleted int64, ttl time.Duration) error
+	FinishJob(ctx context.Context, id, lock string, fields map[string]string, ttl time.Duration) error
+	GetJob(ctx context.Context, id string) (map[string]string, error)
 }
 
+// Conditions for setting a cache entry.
+const (
+	conditionIfAbsent  = "nx"
+	conditionIfPresent = "xx"
+)
+
 type Events interface {
-	Send(ctx context.Context, key string) error
+	NewSetEvent(ctx context.Context, entry event.Entry) ([]byte, error)
+	NewDeleteEvent(ctx context.Context, entry event.Entry) ([]byte, error)
 }
 
 type Service struct {
 	cache  Cache
 	events Events
 	logger *zap.Logger
+
+	keyFormat      KeyFormat
+	legacyFallback bool
+	slidingTTL     map[string]time.Duration
+	ttlPolicies    TTLPolicies
 }
 
-func New(cache Cache, events Events, logger *zap.Logger) *Service {
-	return &Service{
-		cache:  cache,
-		events: events,
-		logger: logger,
+// Option configures optional behaviour of the Service.
+type Option func(*Service)
+
+func New(cache Cache, events Events, logger *zap.Logger, opts ...Option) *Service {
+	s := &Service{
+		cache:     cache,
+		events:    events,
+		logger:    logger,
+		keyFormat: KeyFormatLegacy,
+	}
+	for _, opt := range opts {
+		opt(s)
 	}
+	return s
 }
 
-func (s *Service) Get(ctx context.Context, req *cache.CacheGetRequest) (interface{}, error) {
+// Get returns a JSON value from the cache. Values of a single scope are returned
+// with their ETag and a not_modified error if the ETag matches If-None-Match.
+func (s *Service) Get(ctx context.Context, req *cache.CacheGetRequest) (*cache.CacheGetResult, error) {
 	logger := s.logger.With(zap.String("operation", "get"))
 
-	if req.Key == "" {
-		logger.Error("bad request: missing key")
-		return nil, errors.New(errors.BadRequest, "missing key")
+	if msg := invalidKey(req.Key); msg != "" {
+		logger.Error("bad request: " + msg)
+		return nil, errors.New(errors.BadRequest, msg)
 	}
 
 	var scopes []string
@@ -52,29 +99,70 @@ func (s *Service) Get(ctx context.Context, req *cache.CacheGetRequest) (interfac
 		scopes = strings.Split(*req.Scope, ",")
 	}
 
+	if err := validateStrategy(req.Strategy, req.Arrays); err != nil {
+		logger.Error("bad request: invalid flatten strategy", zap.Error(err))
+		return nil, err
+	}
+
+	project, err := parseProjection(req.Fields)
+	if err != nil {
+		logger.Error("bad request: invalid fields", zap.Error(err))
+		return nil, err
+	}
+
+	touch := s.touchTTL(req)
+
 	if len(scopes) > 1 {
-		return s.getWithMultipleScopes(ctx, req, scopes)
+		result, err := s.getWithMultipleScopes(ctx, req, scopes, touch)
+		if err != nil {
+			return nil, err
+		}
+		if project != nil {
+			result = project(result)
+		}
+		return &cache.CacheGetResult{Data: result}, nil
 	}
 
-	decodedValue, err := s.get(ctx, req.Key, req.Namespace, req.Scope)
+	data, err := s.read(ctx, req.Key, req.Namespace, req.Scope, touch)
 	if err != nil {
 		logger.Error("error getting value from cache", zap.Error(err))
 		return nil, err
 	}
 
-	return decodedValue, nil
+	etag := makeETag(data)
+	if req.IfNoneMatch != nil && matchETag(*req.IfNoneMatch, etag) {
+		return nil, &cache.CacheNotModified{Etag: etag}
+	}
+
+	decodedValue, err := unmarshalCacheData(data)
+	if err != nil {
+		logger.Error("error getting value from cache", zap.Error(err))
+		return nil, errors.New("cannot decode json value from cache", err)
+	}
+	if project != nil {
+		decodedValue = project(decodedValue)
+	}
+
+	return &cache.CacheGetResult{Data: decodedValue, Etag: &etag}, nil
 }
 
 func (s *Service) Set(ctx context.Context, req *cache.CacheSetRequest) error {
+	return s.set(ctx, req, false)
+}
+
+// set stores the value of the request. If withEvent is true, a cache_set_event
+// for the entry is stored in the outbox in the same transaction.
+func (s *Service) set(ctx context.Context, req *cache.CacheSetRequest, withEvent bool) error {
 	logger := s.logger.With(zap.String("operation", "set"))
 
-	if req.Key == "" {
-		logger.Error("bad request: missing key")
-		return errors.New(errors.BadRequest, "missing key")
+	if msg := invalidKey(req.Key); msg != "" {
+		logger.Error("bad request: " + msg)
+		return errors.New(errors.BadRequest, msg)
 	}
 
 	// create key from the input fields
-	key := makeCacheKey(req.Key, req.Namespace, req.Scope)
+	key := s.cacheKey(req.Key, req.Namespace, req.Scope)
+	obsolete := s.obsoleteKey(req.Key, req.Namespace, req.Scope)
 	// encode payload to json bytes for storing in cache
 	value, err := json.Marshal(req.Data)
 	if err != nil {
@@ -87,86 +175,357 @@ func (s *Service) Set(ctx context.Context, req *cache.CacheSetRequest) error {
 	if req.TTL != nil {
 		ttl = time.Duration(*req.TTL) * time.Second
 	}
+	ttl, err = s.applyTTLPolicy(req.Namespace, ttl)
+	if err != nil {
+		logger.Error("bad request: ttl policy violation", zap.Error(err))
+		return err
+	}
+
+	tags, err := parseTags(req.Tags)
+	if err != nil {
+		logger.Error("bad request: invalid tags", zap.Error(err))
+		return err
+	}
+
+	condition, err := s.setCondition(ctx, req)
+	if err != nil {
+		logger.Error("error evaluating set condition", zap.Error(err))
+		return err
+	}
 
-	if err := s.cache.Set(ctx, key, value, ttl); err != nil {
+	var hash string
+	if req.IfMatch != nil && *req.IfMatch != "" && *req.IfMatch != "*" {
+		// the value is only replaced if it's unchanged since it was read by the client
+		hash = etagHash(*req.IfMatch)
+		hash, err = s.matchLegacy(ctx, key, obsolete, hash)
+		if err != nil {
+			logger.Error("error comparing legacy entry", zap.Error(err))
+			return err
+		}
+	}
+
+	if withEvent {
+		entry := event.Entry{
+			Key:       req.Key,
+			Namespace: stringValue(req.Namespace),
+			Scope:     stringValue(req.Scope),
+			TTL:       ttl,
+			StoredAt:  time.Now(),
+			Value:     value,
+		}
+		var evt []byte
+		evt, err = s.events.NewSetEvent(ctx, entry)
+		if stderrors.Is(err, event.ErrDisabled) {
+			return errors.New(errors.ServiceUnavailable, "external input requires an events transport", err)
+		}
+		if err != nil {
+			logger.Error("error creating an event for the entry", zap.Error(err))
+			return errors.New("error creating an event for the entry", err)
+		}
+		err = s.cache.SetWithEvent(ctx, key, value, ttl, condition, hash, evt)
+	} else if hash != "" {
+		err = s.cache.CompareAndSet(ctx, key, value, ttl, hash)
+	} else {
+		// tags are also replaced without new ones, so that the overwritten
+		// entry is no longer invalidated by its previous tags
+		err = s.cache.Set(ctx, key, value, ttl, condition, tags, obsolete)
+	}
+	if err != nil {
+		if errors.Is(errors.Exist, err) {
+			if hash != "" {
+				return errors.New(errors.Exist, "cache entry has been modified", err)
+			}
+			return conditionError(condition, err)
+		}
 		logger.Error("error storing value in cache", zap.Error(err))
 		return errors.New("error storing value in cache", err)
 	}
+	metrics.ObserveValueSize(req.Namespace, "set", len(value))
+
+	// the scripts of compare-and-set and external writes only access the entry key,
+	// so their tags are replaced and the legacy key is removed in a separate round trip
+	if withEvent || hash != "" {
+		if err := s.cache.SetTags(ctx, key, tags, ttl); err != nil {
+			logger.Error("error storing tags in cache", zap.Error(err))
+			return errors.New("error storing tags in cache", err)
+		}
+		if obsolete != "" {
+			if _, err := s.cache.Unlink(ctx, []string{obsolete}); err != nil {
+				logger.Error("error removing legacy key from cache", zap.Error(err))
+				return errors.New("error removing legacy key from cache", err)
+			}
+		}
+	}
 
 	return nil
 }
 
 // SetExternal sets an external JSON value in the cache and provide an event for the input.
+// The event is stored in the outbox together with the value, so it is published by the
+// outbox relay even if the message broker is not available.
 func (s *Service) SetExternal(ctx context.Context, req *cache.CacheSetRequest) error {
 	logger := s.logger.With(zap.String("operation", "setExternal"))
 
-	// set value in cache
-	if err := s.Set(ctx, req); err != nil {
+	// set value and event in cache
+	if err := s.set(ctx, req, true); err != nil {
 		logger.Error("error setting external input in cache", zap.Error(err))
 		return errors.New("error setting external input in cache", err)
 	}
 
-	// create key from the input fields
-	key := makeCacheKey(req.Key, req.Namespace, req.Scope)
+	return nil
+}
 
-	// send an event for the input
-	if err := s.events.Send(ctx, key); err != nil {
-		logger.Error("error sending an event for external input", zap.Error(err))
-		return errors.New("error sending an event for external input", err)
+// setCondition returns the condition which is passed to the cache when the
+// entry of the request is set. In migration mode an entry may only exist under
+// its legacy key, which is not visible to the condition of the cache, so the
+// legacy key is checked beforehand.
+func (s *Service) setCondition(ctx context.Context, req *cache.CacheSetRequest) (string, error) {
+	var condition string
+	if req.Condition != nil {
+		condition = *req.Condition
 	}
 
-	return nil
+	if req.IfMatch != nil && *req.IfMatch != "" {
+		if condition != "" {
+			return "", errors.New(errors.BadRequest, "If-Match cannot be combined with a set condition")
+		}
+		// If-Match: * only requires the entry to exist
+		if *req.IfMatch == "*" {
+			condition = conditionIfPresent
+		}
+	}
+
+	if condition == "" {
+		return "", nil
+	}
+
+	if condition != conditionIfAbsent && condition != conditionIfPresent {
+		return "", errors.New(errors.BadRequest, "invalid condition: "+condition)
+	}
+
+	keys := s.lookupKeys(req.Key, req.Namespace, req.Scope)
+	if len(keys) == 1 {
+		return condition, nil
+	}
+
+	exists, err := s.cache.Exists(ctx, keys[1])
+	if err != nil {
+		return "", errors.New("error checking key existence in cache", err)
+	}
+	if !exists {
+		return condition, nil
+	}
+
+	if condition == conditionIfAbsent {
+		return "", conditionError(condition, nil)
+	}
+
+	// the entry exists under the legacy key and is now written to the new one
+	return "", nil
 }
 
-func makeCacheKey(key string, namespace, scope *string) string {
-	k := key
-	if namespace != nil && *namespace != "" {
-		k += "," + *namespace
+// matchLegacy returns the hash which is compared with the current value when
+// the entry is set with If-Match. In migration mode an entry may only exist
+// under its legacy key, which is not visible to the compare-and-set of the
+// cache, so the legacy value is compared beforehand and the entry is then
+// written to the new key without a hash, which also removes the legacy key.
+func (s *Service) matchLegacy(ctx context.Context, key, legacyKey, hash string) (string, error) {
+	if legacyKey == "" {
+		return hash, nil
+	}
+
+	exists, err := s.cache.Exists(ctx, key)
+	if err != nil {
+		return "", errors.New("error checking key existence in cache", err)
+	}
+	if exists {
+		return hash, nil
+	}
+
+	value, err := s.cache.Get(ctx, legacyKey)
+	if errors.Is(errors.NotFound, err) {
+		return hash, nil
 	}
-	if scope != nil && *scope != "" {
-		k += "," + *scope
+	if err != nil {
+		return "", errors.New("error getting value from cache", err)
 	}
-	return k
+	if etagHash(makeETag(value)) != hash {
+		return "", errors.New(errors.Exist, "cache entry has been modified")
+	}
+	return "", nil
 }
 
-func (s *Service) getWithMultipleScopes(ctx context.Context, req *cache.CacheGetRequest, scopes []string) (map[string]interface{}, error) {
-	keyValues := map[string][]interface{}{}
-	result := map[string]interface{}{}
+func conditionError(condition string, err error) error {
+	msg := "cache entry does not exist"
+	if condition == conditionIfAbsent {
+		msg = "cache entry already exists"
+	}
+	if err == nil {
+		return errors.New(errors.Exist, msg)
+	}
+	return errors.New(errors.Exist, msg, err)
+}
+
+// Delete removes a value from the cache and provides an event for it.
+func (s *Service) Delete(ctx context.Context, req *cache.CacheDeleteRequest) error {
+	logger := s.logger.With(zap.String("operation", "delete"))
+
+	if msg := invalidKey(req.Key); msg != "" {
+		logger.Error("bad request: " + msg)
+		return errors.New(errors.BadRequest, msg)
+	}
+
+	// the event is written to the outbox together with the deletion, so it is
+	// published after the events of previous writes and not lost if the broker
+	// is unavailable. Without events transport only the value is deleted.
+	entry := event.Entry{
+		Key:       req.Key,
+		Namespace: stringValue(req.Namespace),
+		Scope:     stringValue(req.Scope),
+	}
+	evt, err := s.events.NewDeleteEvent(ctx, entry)
+	if err != nil && !stderrors.Is(err, event.ErrDisabled) {
+		logger.Error("error creating an event for deleted entry", zap.Error(err))
+		return errors.New("error creating an event for deleted entry", err)
+	}
 
-	for _, scope := range scopes {
-		scope := strings.TrimSpace(scope)
-		decodedValue, err := s.get(ctx, req.Key, req.Namespace, &scope)
+	// delete the value under all keys it may be stored, otherwise
+	// a legacy entry would be found again by subsequent lookups
+	var deleted bool
+	for _, key := range s.lookupKeys(req.Key, req.Namespace, req.Scope) {
+		var err error
+		if evt != nil && !deleted {
+			// a single event is written for all keys of the entry
+			err = s.cache.DeleteWithEvent(ctx, key, evt)
+		} else {
+			err = s.cache.Delete(ctx, key)
+		}
 		if err != nil {
 			if errors.Is(errors.NotFound, err) {
-				s.logger.Warn(err.Error())
 				continue
 			}
-			return nil, err
+			logger.Error("error deleting value from cache", zap.Error(err))
+			return errors.New("error deleting value from cache", err)
+		}
+		deleted = true
+	}
+
+	if !deleted {
+		return errors.New(errors.NotFound, "key not found in cache")
+	}
+	return nil
+}
+
+// Meta returns metadata of a cache entry without transferring its value.
+func (s *Service) Meta(ctx context.Context, req *cache.CacheMetaRequest) (*cache.CacheMetaResponse, error) {
+	logger := s.logger.With(zap.String("operation", "meta"))
+
+	if msg := invalidKey(req.Key); msg != "" {
+		logger.Error("bad request: " + msg)
+		return nil, errors.New(errors.BadRequest, msg)
+	}
+
+	keys := s.lookupKeys(req.Key, req.Namespace, req.Scope)
+	for _, key := range keys {
+		exists, err := s.cache.Exists(ctx, key)
+		if err != nil {
+			logger.Error("error checking key existence in cache", zap.Error(err))
+			return nil, errors.New("error checking key existence in cache", err)
+		}
+		if !exists {
+			continue
 		}
 
-		switch d := decodedValue.(type) {
-		case map[string]interface{}:
-			addValue(d, keyValues)
-		case []map[string]interface{}:
-			for _, data := range d {
-				addValue(data, keyValues)
+		ttl, err := s.cache.TTL(ctx, key)
+		if err != nil {
+			if errors.Is(errors.NotFound, err) {
+				// the entry expired in the meantime
+				continue
 			}
-		default:
-			s.logger.Warn("decode value is of unknown type")
+			logger.Error("error getting key ttl from cache", zap.Error(err))
+			return nil, errors.New("error getting key ttl from cache", err)
+		}
+
+		size, err := s.cache.Size(ctx, key)
+		if err != nil {
+			logger.Error("error getting value size from cache", zap.Error(err))
+			return nil, errors.New("error getting value size from cache", err)
+		}
+
+		res := &cache.CacheMetaResponse{
+			Exists: true,
+			Key:    key,
+			Size:   &size,
+		}
+		if ttl >= 0 {
+			seconds := int64(math.Ceil(ttl.Seconds()))
+			res.TTL = &seconds
+		}
+		return res, nil
+	}
+
+	return &cache.CacheMetaResponse{
+		Exists: false,
+		Key:    keys[0],
+	}, nil
+}
+
+func (s *Service) getWithMultipleScopes(ctx context.Context, req *cache.CacheGetRequest, scopes []string, touch time.Duration) (interface{}, error) {
+	strategy := strategyMerge
+	if req.Strategy != nil {
+		strategy = *req.Strategy
+	}
+
+	// all scopes are read at once in the order of their precedence,
+	// which is kept by all strategies
+	scopes = orderScopes(scopes, req.ScopePriority)
+	refs := make([]entryRef, len(scopes))
+	for i := range scopes {
+		refs[i] = entryRef{key: req.Key, namespace: req.Namespace, scope: &scopes[i]}
+	}
+
+	data, err := s.readMany(ctx, refs, touch)
+	if err != nil {
+		return nil, errors.New("error getting value from cache", err)
+	}
+
+	var values []scopeValue
+	for i, d := range data {
+		if d == nil {
+			s.logger.Warn("key not found in cache", zap.String("scope", *refs[i].scope))
 			continue
 		}
+		decodedValue, err := unmarshalCacheData(d)
+		if err != nil {
+			return nil, errors.New("cannot decode json value from cache", err)
+		}
+		values = append(values, scopeValue{scope: *refs[i].scope, value: decodedValue})
+	}
+
+	switch strategy {
+	case strategyDeep:
+		return deepMergeAll(objects(values), req.Arrays != nil && *req.Arrays == arrayMergeDedupe), nil
+	case strategyScoped:
+		return scopedValues(values), nil
+	}
+
+	keyValues := map[string][]interface{}{}
+	result := map[string]interface{}{}
+
+	for _, object := range objects(values) {
+		addValue(object, keyValues)
 	}
 
-	switch {
-	case req.Strategy == nil || *req.Strategy == "merge":
+	switch strategy {
+	case strategyMerge:
 		result = mergeAll(keyValues)
 
-	case *req.Strategy == "first":
+	case strategyFirst:
 		for key, value := range keyValues {
 			result[key] = value[0]
 		}
 
-	case *req.Strategy == "last":
+	case strategyLast:
 		for key, value := range keyValues {
 			result[key] = value[len(value)-1]
 		}
@@ -188,52 +547,89 @@ func addValue(decodedValue map[string]interface{}, des map[string][]interface{})
 }
 
 // mergeAll merges all values for a key if more than one value is available.
+// Colliding keys are renamed to key_1, key_2, ... in sorted key order, skipping
+// names which are already taken, so that the result is deterministic and no
+// value is overwritten.
 func mergeAll(data map[string][]interface{}) map[string]interface{} {
+	keys := make([]string, 0, len(data))
 	result := map[string]interface{}{}
 	for key, value := range data {
+		keys = append(keys, key)
 		if len(value) == 1 {
 			result[key] = value[0]
+		}
+	}
+	sort.Strings(keys)
+
+	for _, key := range keys {
+		value := data[key]
+		if len(value) == 1 {
 			continue
 		}
 
-		for i, v := range value {
-			index := fmt.Sprintf("%s_%d", key, i+1)
+		n := 0
+		for _, v := range value {
+			var index string
+			for {
+				n++
+				index = fmt.Sprintf("%s_%d", key, n)
+				if _, taken := result[index]; !taken {
+					break
+				}
+			}
 			result[index] = v
 		}
 	}
 	return result
 }
 
-func (s *Service) get(ctx context.Context, key string, namespace *string, scope *string) (interface{}, error) {
-	// create key from the input fields
-	cacheKey := makeCacheKey(key, namespace, scope)
-	data, err := s.cache.Get(ctx, cacheKey)
+// read returns the stored bytes of a cache entry. If touch is positive,
+// the TTL of the entry is reset to it.
+func (s *Service) read(ctx context.Context, key string, namespace *string, scope *string, touch time.Duration) ([]byte, error) {
+	var (
+		data []byte
+		err  error
+	)
+	for _, cacheKey := range s.lookupKeys(key, namespace, scope) {
+		if touch > 0 {
+			data, err = s.cache.GetEx(ctx, cacheKey, touch)
+		} else {
+			data, err = s.cache.Get(ctx, cacheKey)
+		}
+		if err == nil || !errors.Is(errors.NotFound, err) {
+			break
+		}
+	}
 	if err != nil {
 		if errors.Is(errors.NotFound, err) {
+			metrics.ObserveLookup(namespace, false)
 			return nil, errors.New(errors.NotFound, "key not found in cache", err)
 		}
 		return nil, errors.New("error getting value from cache", err)
 	}
+	metrics.ObserveLookup(namespace, true)
+	metrics.ObserveValueSize(namespace, "get", len(data))
 
-	decodedValue, err := unmarshalCacheData(data)
-	if err != nil {
-		return nil, errors.New("cannot decode json value from cache", err)
-	}
-
-	return decodedValue, nil
+	return data, nil
 }
 
+// unmarshalCacheData decodes a stored JSON value. Objects and arrays of objects
+// are returned with their specific types, which are flattened by the strategies
+// of multiple scopes. All other JSON values are returned as decoded by encoding/json.
 func unmarshalCacheData(data []byte) (interface{}, error) {
-	var keyValueArray []map[string]interface{}
 	var keyValue map[string]interface{}
+	if err := json.Unmarshal(data, &keyValue); err == nil {
+		return keyValue, nil
+	}
 
-	err := json.Unmarshal(data, &keyValue)
-	if err != nil {
-		err := json.Unmarshal(data, &keyValueArray)
-		if err != nil {
-			return nil, err
-		}
+	var keyValueArray []map[string]interface{}
+	if err := json.Unmarshal(data, &keyValueArray); err == nil {
 		return keyValueArray, nil
 	}
-	return keyValue, nil
+
+	var value interface{}
+	if err := json.Unmarshal(data, &value); err != nil {
+		return nil, err
+	}
+	return value, nil
 }
@@ -2,7 +2,13 @@ package cache_test
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"sync"
 	"testing"
 	"time"
 
@@ -13,6 +19,7 @@ import (
 	errors "github.com/eclipse-xfsc/microservice-core-go/pkg/err"
 	ptr "github.com/eclipse-xfsc/microservice-core-go/pkg/ptr"
 	goacache "github.com/eclipse-xfsc/redis-cache-service/gen/cache"
+	"github.com/eclipse-xfsc/redis-cache-service/internal/clients/event"
 	"github.com/eclipse-xfsc/redis-cache-service/internal/service/cache"
 	"github.com/eclipse-xfsc/redis-cache-service/internal/service/cache/cachefakes"
 )
@@ -42,6 +49,12 @@ func TestService_Get(t *testing.T) {
 			errkind: errors.BadRequest,
 			errtext: "missing key",
 		},
+		{
+			name:    "cache key with reserved prefix",
+			req:     &goacache.CacheGetRequest{Key: "cache:tags:key", Namespace: ptr.String("Login")},
+			errkind: errors.BadRequest,
+			errtext: "key starts with a reserved prefix",
+		},
 		{
 			name: "error getting value from cache",
 			req: &goacache.CacheGetRequest{
@@ -111,12 +124,12 @@ func TestService_Get(t *testing.T) {
 				Strategy:  ptr.String("last"),
 			},
 			cache: &cachefakes.FakeCache{
-				GetStub: func(ctx context.Context, key string) ([]byte, error) {
+				GetManyStub: getMany(func(ctx context.Context, key string) ([]byte, error) {
 					if key == key1 {
 						return nil, fmt.Errorf("some error")
 					}
 					return []byte(`{"test":"value2"}`), nil
-				},
+				}),
 			},
 			errtext: "error getting value from cache",
 		},
@@ -129,12 +142,12 @@ func TestService_Get(t *testing.T) {
 				Strategy:  ptr.String("merge"),
 			},
 			cache: &cachefakes.FakeCache{
-				GetStub: func(ctx context.Context, key string) ([]byte, error) {
+				GetManyStub: getMany(func(ctx context.Context, key string) ([]byte, error) {
 					if key == key1 {
 						return []byte(`{"test":"value"}`), nil
 					}
 					return []byte(`{"test":"value2"}`), nil
-				},
+				}),
 			},
 			res:     map[string]interface{}{"test_1": "value", "test_2": "value2"},
 			errtext: "",
@@ -148,12 +161,12 @@ func TestService_Get(t *testing.T) {
 				Strategy:  ptr.String("first"),
 			},
 			cache: &cachefakes.FakeCache{
-				GetStub: func(ctx context.Context, key string) ([]byte, error) {
+				GetManyStub: getMany(func(ctx context.Context, key string) ([]byte, error) {
 					if key == key1 {
 						return []byte(`{"test":"value"}`), nil
 					}
 					return []byte(`{"test":"value2"}`), nil
-				},
+				}),
 			},
 			res:     map[string]interface{}{"test": "value"},
 			errtext: "",
@@ -167,12 +180,12 @@ func TestService_Get(t *testing.T) {
 				Strategy:  ptr.String("last"),
 			},
 			cache: &cachefakes.FakeCache{
-				GetStub: func(ctx context.Context, key string) ([]byte, error) {
+				GetManyStub: getMany(func(ctx context.Context, key string) ([]byte, error) {
 					if key == key1 {
 						return []byte(`{"test":"value"}`), nil
 					}
 					return []byte(`{"test":"value2"}`), nil
-				},
+				}),
 			},
 			res:     map[string]interface{}{"test": "value2"},
 			errtext: "",
@@ -186,12 +199,12 @@ func TestService_Get(t *testing.T) {
 				Strategy:  ptr.String("last"),
 			},
 			cache: &cachefakes.FakeCache{
-				GetStub: func(ctx context.Context, key string) ([]byte, error) {
+				GetManyStub: getMany(func(ctx context.Context, key string) ([]byte, error) {
 					if key == key1 {
 						return []byte(`{"test":"value"}`), nil
 					}
 					return []byte(`{"test":"value2"}`), nil
-				},
+				}),
 			},
 			res:     map[string]interface{}{"test": "value2"},
 			errtext: "",
@@ -205,7 +218,7 @@ func TestService_Get(t *testing.T) {
 				Strategy:  ptr.String("last"),
 			},
 			cache: &cachefakes.FakeCache{
-				GetStub: func(ctx context.Context, key string) ([]byte, error) {
+				GetManyStub: getMany(func(ctx context.Context, key string) ([]byte, error) {
 					if key == key1 {
 						return []byte(`{"test":"value"}`), nil
 					}
@@ -213,7 +226,7 @@ func TestService_Get(t *testing.T) {
 						return []byte(`{"test":"value2"}`), nil
 					}
 					return nil, errors.New(errors.NotFound, "some error")
-				},
+				}),
 			},
 			res:        map[string]interface{}{"test": "value2"},
 			loggerText: "key not found in cache",
@@ -234,7 +247,7 @@ func TestService_Get(t *testing.T) {
 
 			if err == nil {
 				assert.Empty(t, test.errtext)
-				assert.Equal(t, test.res, res)
+				assert.Equal(t, test.res, res.Data)
 			} else {
 				assert.Nil(t, res)
 				assert.Error(t, err)
@@ -264,6 +277,12 @@ func TestService_Set(t *testing.T) {
 			errkind: errors.BadRequest,
 			errtext: "missing key",
 		},
+		{
+			name:    "cache key with reserved prefix",
+			req:     &goacache.CacheSetRequest{Key: "cache:tag:key", Namespace: ptr.String("Login")},
+			errkind: errors.BadRequest,
+			errtext: "key starts with a reserved prefix",
+		},
 		{
 			name: "error setting value in cache",
 			req: &goacache.CacheSetRequest{
@@ -273,7 +292,7 @@ func TestService_Set(t *testing.T) {
 				Data:      map[string]interface{}{"test": "value"},
 			},
 			cache: &cachefakes.FakeCache{
-				SetStub: func(ctx context.Context, key string, value []byte, ttl time.Duration) error {
+				SetStub: func(ctx context.Context, key string, value []byte, ttl time.Duration, condition string, tags []string, obsolete string) error {
 					return errors.New(errors.Timeout, "some error")
 				},
 			},
@@ -289,7 +308,7 @@ func TestService_Set(t *testing.T) {
 				Data:      map[string]interface{}{"test": "value"},
 			},
 			cache: &cachefakes.FakeCache{
-				SetStub: func(ctx context.Context, key string, value []byte, ttl time.Duration) error {
+				SetStub: func(ctx context.Context, key string, value []byte, ttl time.Duration, condition string, tags []string, obsolete string) error {
 					return nil
 				},
 			},
@@ -305,7 +324,7 @@ func TestService_Set(t *testing.T) {
 				TTL:       ptr.Int(60),
 			},
 			cache: &cachefakes.FakeCache{
-				SetStub: func(ctx context.Context, key string, value []byte, ttl time.Duration) error {
+				SetStub: func(ctx context.Context, key string, value []byte, ttl time.Duration, condition string, tags []string, obsolete string) error {
 					return nil
 				},
 			},
@@ -331,6 +350,10 @@ func TestService_Set(t *testing.T) {
 }
 
 func TestService_SetExternal(t *testing.T) {
+	newEvent := func(ctx context.Context, entry event.Entry) ([]byte, error) {
+		return []byte(`{"id":"1"}`), nil
+	}
+
 	tests := []struct {
 		name   string
 		cache  *cachefakes.FakeCache
@@ -350,34 +373,64 @@ func TestService_SetExternal(t *testing.T) {
 				Data:      map[string]interface{}{"test": "value"},
 			},
 			cache: &cachefakes.FakeCache{
-				SetStub: func(ctx context.Context, key string, value []byte, ttl time.Duration) error {
+				SetWithEventStub: func(ctx context.Context, key string, value []byte, ttl time.Duration, condition, hash string, event []byte) error {
 					return errors.New(errors.Timeout, "some error")
 				},
 			},
+			events:  &cachefakes.FakeEvents{NewSetEventStub: newEvent},
 			errkind: errors.Timeout,
 			errtext: "some error",
 		},
 		{
-			name: "error sending an event for external input",
+			name: "error creating an event for external input",
+			req: &goacache.CacheSetRequest{
+				Key:       "key",
+				Namespace: ptr.String("namespace"),
+				Scope:     ptr.String("scope"),
+				Data:      map[string]interface{}{"test": "value"},
+			},
+			cache: &cachefakes.FakeCache{},
+			events: &cachefakes.FakeEvents{NewSetEventStub: func(ctx context.Context, entry event.Entry) ([]byte, error) {
+				return nil, errors.New(errors.Unknown, "failed to create event")
+			}},
+			errkind: errors.Unknown,
+			errtext: "failed to create event",
+		},
+		{
+			name: "events are disabled",
+			req: &goacache.CacheSetRequest{
+				Key:       "key",
+				Namespace: ptr.String("namespace"),
+				Scope:     ptr.String("scope"),
+				Data:      map[string]interface{}{"test": "value"},
+			},
+			cache: &cachefakes.FakeCache{},
+			events: &cachefakes.FakeEvents{NewSetEventStub: func(ctx context.Context, entry event.Entry) ([]byte, error) {
+				return nil, event.ErrDisabled
+			}},
+			errkind: errors.ServiceUnavailable,
+			errtext: "external input requires an events transport",
+		},
+		{
+			name: "set condition not met",
 			req: &goacache.CacheSetRequest{
 				Key:       "key",
 				Namespace: ptr.String("namespace"),
 				Scope:     ptr.String("scope"),
 				Data:      map[string]interface{}{"test": "value"},
+				Condition: ptr.String("nx"),
 			},
 			cache: &cachefakes.FakeCache{
-				SetStub: func(ctx context.Context, key string, value []byte, ttl time.Duration) error {
-					return nil
+				SetWithEventStub: func(ctx context.Context, key string, value []byte, ttl time.Duration, condition, hash string, event []byte) error {
+					return errors.New(errors.Exist, "set condition not met")
 				},
 			},
-			events: &cachefakes.FakeEvents{SendStub: func(ctx context.Context, s string) error {
-				return errors.New(errors.Unknown, "failed to send event")
-			}},
-			errkind: errors.Unknown,
-			errtext: "failed to send event",
+			events:  &cachefakes.FakeEvents{NewSetEventStub: newEvent},
+			errkind: errors.Exist,
+			errtext: "cache entry already exists",
 		},
 		{
-			name: "successfully set value in cache and send an event to events",
+			name: "successfully set value and event in cache",
 			req: &goacache.CacheSetRequest{
 				Key:       "key",
 				Namespace: ptr.String("namespace"),
@@ -385,32 +438,35 @@ func TestService_SetExternal(t *testing.T) {
 				Data:      map[string]interface{}{"test": "value"},
 			},
 			cache: &cachefakes.FakeCache{
-				SetStub: func(ctx context.Context, key string, value []byte, ttl time.Duration) error {
+				SetWithEventStub: func(ctx context.Context, key string, value []byte, ttl time.Duration, condition, hash string, event []byte) error {
+					if key != "key,namespace,scope" || string(event) != `{"id":"1"}` {
+						return errors.New(errors.Unknown, "unexpected key or event")
+					}
 					return nil
 				},
 			},
-			events: &cachefakes.FakeEvents{SendStub: func(ctx context.Context, s string) error {
-				return nil
-			}},
+			events:  &cachefakes.FakeEvents{NewSetEventStub: newEvent},
 			errtext: "",
 		},
 		{
-			name: "successfully set value in cache with TTL provided in request and send an event to events",
+			name: "successfully set value and event in cache with TTL and ETag provided in request",
 			req: &goacache.CacheSetRequest{
 				Key:       "key",
 				Namespace: ptr.String("namespace"),
 				Scope:     ptr.String("scope"),
 				Data:      map[string]interface{}{"test": "value"},
 				TTL:       ptr.Int(60),
+				IfMatch:   ptr.String(`"abc"`),
 			},
 			cache: &cachefakes.FakeCache{
-				SetStub: func(ctx context.Context, key string, value []byte, ttl time.Duration) error {
+				SetWithEventStub: func(ctx context.Context, key string, value []byte, ttl time.Duration, condition, hash string, event []byte) error {
+					if ttl != time.Minute || hash != "abc" {
+						return errors.New(errors.Unknown, "unexpected ttl or hash")
+					}
 					return nil
 				},
 			},
-			events: &cachefakes.FakeEvents{SendStub: func(ctx context.Context, s string) error {
-				return nil
-			}},
+			events:  &cachefakes.FakeEvents{NewSetEventStub: newEvent},
 			errtext: "",
 		},
 	}
@@ -421,6 +477,140 @@ func TestService_SetExternal(t *testing.T) {
 			err := svc.SetExternal(context.Background(), test.req)
 			if err == nil {
 				assert.Empty(t, test.errtext)
+				// the value is only stored together with the event
+				assert.Equal(t, 0, test.cache.SetCallCount())
+				assert.Equal(t, 0, test.cache.CompareAndSetCallCount())
+			} else {
+				assert.Error(t, err)
+				e, ok := err.(*errors.Error)
+				assert.True(t, ok)
+				assert.Equal(t, test.errkind, e.Kind)
+				assert.Contains(t, e.Error(), test.errtext)
+			}
+		})
+	}
+}
+
+func TestService_Delete(t *testing.T) {
+	newEvent := func(ctx context.Context, entry event.Entry) ([]byte, error) {
+		if entry.Key != "key" || entry.Namespace != "namespace" || entry.Scope != "scope" {
+			return nil, errors.New(errors.Unknown, "unexpected entry")
+		}
+		return []byte("event"), nil
+	}
+
+	tests := []struct {
+		name   string
+		cache  *cachefakes.FakeCache
+		events *cachefakes.FakeEvents
+		req    *goacache.CacheDeleteRequest
+
+		withEvent bool
+		errkind   errors.Kind
+		errtext   string
+	}{
+		{
+			name:    "missing cache key",
+			req:     &goacache.CacheDeleteRequest{},
+			cache:   &cachefakes.FakeCache{},
+			events:  &cachefakes.FakeEvents{},
+			errkind: errors.BadRequest,
+			errtext: "missing key",
+		},
+		{
+			name:    "cache key with reserved prefix",
+			req:     &goacache.CacheDeleteRequest{Key: "cache:outbox", Namespace: ptr.String("Login")},
+			cache:   &cachefakes.FakeCache{},
+			events:  &cachefakes.FakeEvents{},
+			errkind: errors.BadRequest,
+			errtext: "key starts with a reserved prefix",
+		},
+		{
+			name: "key not found in cache",
+			req: &goacache.CacheDeleteRequest{
+				Key:       "key",
+				Namespace: ptr.String("namespace"),
+				Scope:     ptr.String("scope"),
+			},
+			cache: &cachefakes.FakeCache{
+				DeleteWithEventStub: func(ctx context.Context, key string, event []byte) error {
+					return errors.New(errors.NotFound)
+				},
+			},
+			events:    &cachefakes.FakeEvents{NewDeleteEventStub: newEvent},
+			withEvent: true,
+			errkind:   errors.NotFound,
+			errtext:   "key not found in cache",
+		},
+		{
+			name: "error deleting value from cache",
+			req: &goacache.CacheDeleteRequest{
+				Key:       "key",
+				Namespace: ptr.String("namespace"),
+				Scope:     ptr.String("scope"),
+			},
+			cache: &cachefakes.FakeCache{
+				DeleteWithEventStub: func(ctx context.Context, key string, event []byte) error {
+					return errors.New(errors.Timeout, "some error")
+				},
+			},
+			events:    &cachefakes.FakeEvents{NewDeleteEventStub: newEvent},
+			withEvent: true,
+			errkind:   errors.Timeout,
+			errtext:   "some error",
+		},
+		{
+			name: "successfully delete value from cache",
+			req: &goacache.CacheDeleteRequest{
+				Key:       "key",
+				Namespace: ptr.String("namespace"),
+				Scope:     ptr.String("scope"),
+			},
+			cache: &cachefakes.FakeCache{
+				DeleteWithEventStub: func(ctx context.Context, key string, event []byte) error {
+					if key != "key,namespace,scope" || string(event) != "event" {
+						return errors.New(errors.NotFound)
+					}
+					return nil
+				},
+			},
+			events:    &cachefakes.FakeEvents{NewDeleteEventStub: newEvent},
+			withEvent: true,
+		},
+		{
+			name: "value is deleted without events transport",
+			req: &goacache.CacheDeleteRequest{
+				Key:       "key",
+				Namespace: ptr.String("namespace"),
+				Scope:     ptr.String("scope"),
+			},
+			cache: &cachefakes.FakeCache{},
+			events: &cachefakes.FakeEvents{NewDeleteEventStub: func(ctx context.Context, entry event.Entry) ([]byte, error) {
+				return nil, event.ErrDisabled
+			}},
+		},
+		{
+			name: "error creating an event for deleted entry",
+			req: &goacache.CacheDeleteRequest{
+				Key:       "key",
+				Namespace: ptr.String("namespace"),
+				Scope:     ptr.String("scope"),
+			},
+			cache: &cachefakes.FakeCache{},
+			events: &cachefakes.FakeEvents{NewDeleteEventStub: func(ctx context.Context, entry event.Entry) ([]byte, error) {
+				return nil, errors.New(errors.Unknown, "failed to encode event")
+			}},
+			errkind: errors.Unknown,
+			errtext: "failed to encode event",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			svc := cache.New(test.cache, test.events, zap.NewNop())
+			err := svc.Delete(context.Background(), test.req)
+			if test.errtext == "" {
+				assert.NoError(t, err)
 			} else {
 				assert.Error(t, err)
 				e, ok := err.(*errors.Error)
@@ -428,6 +618,2398 @@ func TestService_SetExternal(t *testing.T) {
 				assert.Equal(t, test.errkind, e.Kind)
 				assert.Contains(t, e.Error(), test.errtext)
 			}
+
+			// the event is only written together with the deletion
+			if test.withEvent {
+				assert.Equal(t, 1, test.cache.DeleteWithEventCallCount())
+				assert.Equal(t, 0, test.cache.DeleteCallCount())
+			} else {
+				assert.Equal(t, 0, test.cache.DeleteWithEventCallCount())
+			}
+		})
+	}
+}
+
+func TestService_SetExternalEvent(t *testing.T) {
+	events := &cachefakes.FakeEvents{}
+	svc := cache.New(&cachefakes.FakeCache{}, events, zap.NewNop(), cache.WithTTLPolicies(cache.TTLPolicies{
+		"namespace": {MaxTTL: time.Minute},
+	}))
+
+	before := time.Now()
+	err := svc.SetExternal(context.Background(), &goacache.CacheSetRequest{
+		Key:       "key",
+		Namespace: ptr.String("namespace"),
+		Scope:     ptr.String("scope"),
+		Data:      map[string]interface{}{"test": "value"},
+		TTL:       ptr.Int(3600),
+	})
+	assert.NoError(t, err)
+
+	// the event describes the stored entry
+	assert.Equal(t, 1, events.NewSetEventCallCount())
+	_, entry := events.NewSetEventArgsForCall(0)
+	assert.Equal(t, "key", entry.Key)
+	assert.Equal(t, "namespace", entry.Namespace)
+	assert.Equal(t, "scope", entry.Scope)
+	assert.Equal(t, time.Minute, entry.TTL)
+	assert.False(t, entry.StoredAt.Before(before))
+	assert.JSONEq(t, `{"test":"value"}`, string(entry.Value))
+}
+
+func TestSplitKey(t *testing.T) {
+	tests := []struct {
+		storageKey string
+
+		key       string
+		namespace string
+		scope     string
+	}{
+		{storageKey: "key", key: "key"},
+		{storageKey: "key,namespace", key: "key", namespace: "namespace"},
+		{storageKey: "key,namespace,scope", key: "key", namespace: "namespace", scope: "scope"},
+		{storageKey: "a,b,namespace,scope", key: "a,b", namespace: "namespace", scope: "scope"},
+		{storageKey: "v2:namespace:scope:key", key: "key", namespace: "namespace", scope: "scope"},
+		{storageKey: "v2:name%3Aspace::a%25b%3Ac", key: "a%b:c", namespace: "name:space"},
+	}
+
+	for _, test := range tests {
+		t.Run(test.storageKey, func(t *testing.T) {
+			key, namespace, scope := cache.SplitKey(test.storageKey)
+			assert.Equal(t, test.key, key)
+			assert.Equal(t, test.namespace, namespace)
+			assert.Equal(t, test.scope, scope)
+		})
+	}
+}
+
+func TestParseKeyFormat(t *testing.T) {
+	format, err := cache.ParseKeyFormat("")
+	assert.NoError(t, err)
+	assert.Equal(t, cache.KeyFormatLegacy, format)
+
+	format, err = cache.ParseKeyFormat("V2")
+	assert.NoError(t, err)
+	assert.Equal(t, cache.KeyFormatV2, format)
+
+	_, err = cache.ParseKeyFormat("v3")
+	assert.Error(t, err)
+}
+
+func TestService_KeyFormat(t *testing.T) {
+	tests := []struct {
+		name      string
+		format    cache.KeyFormat
+		key       string
+		namespace *string
+		scope     *string
+
+		storageKey string
+	}{
+		{
+			name:       "legacy key format",
+			format:     cache.KeyFormatLegacy,
+			key:        "a,b",
+			storageKey: "a,b",
+		},
+		{
+			name:       "legacy key format with namespace and scope",
+			format:     cache.KeyFormatLegacy,
+			key:        "a",
+			namespace:  ptr.String("b"),
+			scope:      ptr.String("c"),
+			storageKey: "a,b,c",
+		},
+		{
+			name:       "v2 key format keeps commas in key",
+			format:     cache.KeyFormatV2,
+			key:        "a,b",
+			storageKey: "v2:::a,b",
+		},
+		{
+			name:       "v2 key format does not collide with namespace",
+			format:     cache.KeyFormatV2,
+			key:        "a",
+			namespace:  ptr.String("b"),
+			storageKey: "v2:b::a",
+		},
+		{
+			name:       "v2 key format escapes separators",
+			format:     cache.KeyFormatV2,
+			key:        "did:web:example.com",
+			namespace:  ptr.String("Login%"),
+			scope:      ptr.String("user"),
+			storageKey: "v2:Login%25:user:did%3Aweb%3Aexample.com",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			fake := &cachefakes.FakeCache{}
+			svc := cache.New(fake, nil, zap.NewNop(), cache.WithKeyFormat(test.format, false))
+			err := svc.Set(context.Background(), &goacache.CacheSetRequest{
+				Key:       test.key,
+				Namespace: test.namespace,
+				Scope:     test.scope,
+				Data:      map[string]interface{}{"test": "value"},
+			})
+			assert.NoError(t, err)
+			assert.Equal(t, 1, fake.SetCallCount())
+			_, key, _, _, _, _, _ := fake.SetArgsForCall(0)
+			assert.Equal(t, test.storageKey, key)
+		})
+	}
+}
+
+func TestService_LegacyKeyFallback(t *testing.T) {
+	const legacyKey = "key,namespace,scope"
+	const v2Key = "v2:namespace:scope:key"
+
+	req := &goacache.CacheGetRequest{
+		Key:       "key",
+		Namespace: ptr.String("namespace"),
+		Scope:     ptr.String("scope"),
+	}
+
+	t.Run("legacy entry is found in migration mode", func(t *testing.T) {
+		fake := &cachefakes.FakeCache{
+			GetStub: func(ctx context.Context, key string) ([]byte, error) {
+				if key == legacyKey {
+					return []byte(`{"test":"legacy"}`), nil
+				}
+				return nil, errors.New(errors.NotFound)
+			},
+		}
+		svc := cache.New(fake, nil, zap.NewNop(), cache.WithKeyFormat(cache.KeyFormatV2, true))
+		res, err := svc.Get(context.Background(), req)
+		assert.NoError(t, err)
+		assert.Equal(t, map[string]interface{}{"test": "legacy"}, res.Data)
+		assert.Equal(t, 2, fake.GetCallCount())
+	})
+
+	t.Run("v2 entry takes precedence over legacy entry", func(t *testing.T) {
+		fake := &cachefakes.FakeCache{
+			GetStub: func(ctx context.Context, key string) ([]byte, error) {
+				if key == v2Key {
+					return []byte(`{"test":"v2"}`), nil
+				}
+				return []byte(`{"test":"legacy"}`), nil
+			},
+		}
+		svc := cache.New(fake, nil, zap.NewNop(), cache.WithKeyFormat(cache.KeyFormatV2, true))
+		res, err := svc.Get(context.Background(), req)
+		assert.NoError(t, err)
+		assert.Equal(t, map[string]interface{}{"test": "v2"}, res.Data)
+		assert.Equal(t, 1, fake.GetCallCount())
+	})
+
+	t.Run("legacy entry is not found without migration mode", func(t *testing.T) {
+		fake := &cachefakes.FakeCache{
+			GetStub: func(ctx context.Context, key string) ([]byte, error) {
+				if key == legacyKey {
+					return []byte(`{"test":"legacy"}`), nil
+				}
+				return nil, errors.New(errors.NotFound)
+			},
+		}
+		svc := cache.New(fake, nil, zap.NewNop(), cache.WithKeyFormat(cache.KeyFormatV2, false))
+		_, err := svc.Get(context.Background(), req)
+		assert.True(t, errors.Is(errors.NotFound, err))
+	})
+
+	setReq := &goacache.CacheSetRequest{
+		Key:       req.Key,
+		Namespace: req.Namespace,
+		Scope:     req.Scope,
+		Data:      map[string]interface{}{"test": "v2"},
+	}
+
+	t.Run("set removes the legacy entry in migration mode", func(t *testing.T) {
+		fake := &cachefakes.FakeCache{}
+		svc := cache.New(fake, nil, zap.NewNop(), cache.WithKeyFormat(cache.KeyFormatV2, true))
+		assert.NoError(t, svc.Set(context.Background(), setReq))
+		assert.Equal(t, 1, fake.SetCallCount())
+		_, key, _, _, _, _, obsolete := fake.SetArgsForCall(0)
+		assert.Equal(t, v2Key, key)
+		assert.Equal(t, legacyKey, obsolete)
+
+		svc = cache.New(fake, nil, zap.NewNop(), cache.WithKeyFormat(cache.KeyFormatV2, false))
+		assert.NoError(t, svc.Set(context.Background(), setReq))
+		_, _, _, _, _, _, obsolete = fake.SetArgsForCall(1)
+		assert.Empty(t, obsolete)
+	})
+
+	t.Run("external set removes the legacy entry in migration mode", func(t *testing.T) {
+		fake := &cachefakes.FakeCache{}
+		events := &cachefakes.FakeEvents{}
+		events.NewSetEventReturns([]byte("event"), nil)
+		svc := cache.New(fake, events, zap.NewNop(), cache.WithKeyFormat(cache.KeyFormatV2, true))
+		assert.NoError(t, svc.SetExternal(context.Background(), setReq))
+		assert.Equal(t, 1, fake.SetWithEventCallCount())
+		assert.Equal(t, 1, fake.UnlinkCallCount())
+		_, keys := fake.UnlinkArgsForCall(0)
+		assert.Equal(t, []string{legacyKey}, keys)
+	})
+
+	t.Run("batch set removes the legacy entries in migration mode", func(t *testing.T) {
+		fake := &cachefakes.FakeCache{}
+		fake.SetManyReturns([]error{nil})
+		svc := cache.New(fake, nil, zap.NewNop(), cache.WithKeyFormat(cache.KeyFormatV2, true))
+		_, err := svc.BatchSet(context.Background(), &goacache.CacheBatchSetRequest{Items: []*goacache.CacheBatchSetItem{
+			{Key: req.Key, Namespace: req.Namespace, Scope: req.Scope, Data: "v2"},
+		}})
+		assert.NoError(t, err)
+		assert.Equal(t, 1, fake.SetManyCallCount())
+		_, keys, _, _, obsolete := fake.SetManyArgsForCall(0)
+		assert.Equal(t, []string{v2Key}, keys)
+		assert.Equal(t, []string{legacyKey}, obsolete)
+	})
+
+	t.Run("etag of a legacy entry matches in migration mode", func(t *testing.T) {
+		legacy := &cachefakes.FakeCache{
+			GetStub: func(ctx context.Context, key string) ([]byte, error) {
+				if key == legacyKey {
+					return []byte(`{"test":"legacy"}`), nil
+				}
+				return nil, errors.New(errors.NotFound)
+			},
+		}
+		svc := cache.New(legacy, nil, zap.NewNop(), cache.WithKeyFormat(cache.KeyFormatV2, true))
+		res, err := svc.Get(context.Background(), req)
+		assert.NoError(t, err)
+		etag := *res.Etag
+
+		// the legacy entry is compared and migrated to the v2 key
+		ifMatch := *setReq
+		ifMatch.IfMatch = &etag
+		assert.NoError(t, svc.Set(context.Background(), &ifMatch))
+		assert.Equal(t, 0, legacy.CompareAndSetCallCount())
+		assert.Equal(t, 1, legacy.SetCallCount())
+		_, key, _, _, condition, _, obsolete := legacy.SetArgsForCall(0)
+		assert.Equal(t, v2Key, key)
+		assert.Empty(t, condition)
+		assert.Equal(t, legacyKey, obsolete)
+
+		// a modified legacy entry is not overwritten
+		ifMatch.IfMatch = ptr.String(`"0b8b8de8a3b5b3e2f4e56a48b0ec9d2d0a2e1bd4"`)
+		err = svc.Set(context.Background(), &ifMatch)
+		assert.True(t, errors.Is(errors.Exist, err))
+		assert.Contains(t, err.Error(), "cache entry has been modified")
+		assert.Equal(t, 1, legacy.SetCallCount())
+
+		// the v2 entry takes precedence over the legacy entry
+		v2 := &cachefakes.FakeCache{}
+		v2.ExistsReturns(true, nil)
+		svc = cache.New(v2, nil, zap.NewNop(), cache.WithKeyFormat(cache.KeyFormatV2, true))
+		ifMatch.IfMatch = &etag
+		assert.NoError(t, svc.Set(context.Background(), &ifMatch))
+		assert.Equal(t, 0, v2.GetCallCount())
+		assert.Equal(t, 1, v2.CompareAndSetCallCount())
+		_, key, _, _, hash := v2.CompareAndSetArgsForCall(0)
+		assert.Equal(t, v2Key, key)
+		assert.Equal(t, strings.Trim(etag, `"`), hash)
+	})
+
+	t.Run("delete removes both v2 and legacy entries", func(t *testing.T) {
+		fake := &cachefakes.FakeCache{}
+		events := &cachefakes.FakeEvents{}
+		events.NewDeleteEventReturns([]byte("event"), nil)
+		svc := cache.New(fake, events, zap.NewNop(), cache.WithKeyFormat(cache.KeyFormatV2, true))
+		err := svc.Delete(context.Background(), &goacache.CacheDeleteRequest{
+			Key:       req.Key,
+			Namespace: req.Namespace,
+			Scope:     req.Scope,
+		})
+		assert.NoError(t, err)
+		assert.Equal(t, 1, events.NewDeleteEventCallCount())
+		_, entry := events.NewDeleteEventArgsForCall(0)
+		assert.Equal(t, req.Key, entry.Key)
+
+		// a single event is written with the first deleted entry
+		assert.Equal(t, 1, fake.DeleteWithEventCallCount())
+		_, key, _ := fake.DeleteWithEventArgsForCall(0)
+		assert.Equal(t, v2Key, key)
+		assert.Equal(t, 1, fake.DeleteCallCount())
+		_, key = fake.DeleteArgsForCall(0)
+		assert.Equal(t, legacyKey, key)
+	})
+
+	t.Run("delete writes the event with the legacy entry", func(t *testing.T) {
+		fake := &cachefakes.FakeCache{
+			DeleteWithEventStub: func(ctx context.Context, key string, event []byte) error {
+				if key != legacyKey {
+					return errors.New(errors.NotFound)
+				}
+				return nil
+			},
+		}
+		events := &cachefakes.FakeEvents{}
+		events.NewDeleteEventReturns([]byte("event"), nil)
+		svc := cache.New(fake, events, zap.NewNop(), cache.WithKeyFormat(cache.KeyFormatV2, true))
+		err := svc.Delete(context.Background(), &goacache.CacheDeleteRequest{
+			Key:       req.Key,
+			Namespace: req.Namespace,
+			Scope:     req.Scope,
+		})
+		assert.NoError(t, err)
+		assert.Equal(t, 2, fake.DeleteWithEventCallCount())
+		assert.Equal(t, 0, fake.DeleteCallCount())
+	})
+}
+
+func TestService_Meta(t *testing.T) {
+	tests := []struct {
+		name  string
+		cache *cachefakes.FakeCache
+		req   *goacache.CacheMetaRequest
+		opts  []cache.Option
+
+		res     *goacache.CacheMetaResponse
+		errkind errors.Kind
+		errtext string
+	}{
+		{
+			name:    "missing cache key",
+			req:     &goacache.CacheMetaRequest{},
+			errkind: errors.BadRequest,
+			errtext: "missing key",
+		},
+		{
+			name: "error checking key existence",
+			req:  &goacache.CacheMetaRequest{Key: "key"},
+			cache: &cachefakes.FakeCache{
+				ExistsStub: func(ctx context.Context, key string) (bool, error) {
+					return false, errors.New(errors.Timeout, "some error")
+				},
+			},
+			errkind: errors.Timeout,
+			errtext: "some error",
+		},
+		{
+			name: "key does not exist",
+			req: &goacache.CacheMetaRequest{
+				Key:       "key",
+				Namespace: ptr.String("namespace"),
+				Scope:     ptr.String("scope"),
+			},
+			cache: &cachefakes.FakeCache{},
+			res: &goacache.CacheMetaResponse{
+				Exists: false,
+				Key:    "key,namespace,scope",
+			},
+		},
+		{
+			name: "key without expiration",
+			req: &goacache.CacheMetaRequest{
+				Key:       "key",
+				Namespace: ptr.String("namespace"),
+				Scope:     ptr.String("scope"),
+			},
+			cache: &cachefakes.FakeCache{
+				ExistsStub: func(ctx context.Context, key string) (bool, error) {
+					return true, nil
+				},
+				TTLStub: func(ctx context.Context, key string) (time.Duration, error) {
+					return -1, nil
+				},
+				SizeStub: func(ctx context.Context, key string) (int64, error) {
+					return 16, nil
+				},
+			},
+			res: &goacache.CacheMetaResponse{
+				Exists: true,
+				Key:    "key,namespace,scope",
+				Size:   ptr.Int64(16),
+			},
+		},
+		{
+			name: "legacy key is resolved in migration mode",
+			req: &goacache.CacheMetaRequest{
+				Key:       "key",
+				Namespace: ptr.String("namespace"),
+				Scope:     ptr.String("scope"),
+			},
+			opts: []cache.Option{cache.WithKeyFormat(cache.KeyFormatV2, true)},
+			cache: &cachefakes.FakeCache{
+				ExistsStub: func(ctx context.Context, key string) (bool, error) {
+					return key == "key,namespace,scope", nil
+				},
+				TTLStub: func(ctx context.Context, key string) (time.Duration, error) {
+					return 1500 * time.Millisecond, nil
+				},
+				SizeStub: func(ctx context.Context, key string) (int64, error) {
+					return 16, nil
+				},
+			},
+			res: &goacache.CacheMetaResponse{
+				Exists: true,
+				Key:    "key,namespace,scope",
+				TTL:    ptr.Int64(2),
+				Size:   ptr.Int64(16),
+			},
+		},
+		{
+			name: "key expired while reading metadata",
+			req:  &goacache.CacheMetaRequest{Key: "key"},
+			cache: &cachefakes.FakeCache{
+				ExistsStub: func(ctx context.Context, key string) (bool, error) {
+					return true, nil
+				},
+				TTLStub: func(ctx context.Context, key string) (time.Duration, error) {
+					return 0, errors.New(errors.NotFound)
+				},
+			},
+			res: &goacache.CacheMetaResponse{
+				Exists: false,
+				Key:    "key",
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			svc := cache.New(test.cache, nil, zap.NewNop(), test.opts...)
+			res, err := svc.Meta(context.Background(), test.req)
+			if err == nil {
+				assert.Empty(t, test.errtext)
+				assert.Equal(t, test.res, res)
+			} else {
+				assert.Nil(t, res)
+				e, ok := err.(*errors.Error)
+				assert.True(t, ok)
+				assert.Equal(t, test.errkind, e.Kind)
+				assert.Contains(t, e.Error(), test.errtext)
+			}
+		})
+	}
+}
+
+func TestService_SetCondition(t *testing.T) {
+	const legacyKey = "key,namespace,scope"
+
+	tests := []struct {
+		name      string
+		condition *string
+		opts      []cache.Option
+		cache     *cachefakes.FakeCache
+
+		setCondition string
+		setCalls     int
+		errkind      errors.Kind
+		errtext      string
+	}{
+		{
+			name:     "no condition",
+			cache:    &cachefakes.FakeCache{},
+			setCalls: 1,
+		},
+		{
+			name:      "invalid condition",
+			condition: ptr.String("always"),
+			cache:     &cachefakes.FakeCache{},
+			errkind:   errors.BadRequest,
+			errtext:   "invalid condition",
+		},
+		{
+			name:         "set if absent",
+			condition:    ptr.String("nx"),
+			cache:        &cachefakes.FakeCache{},
+			setCondition: "nx",
+			setCalls:     1,
+		},
+		{
+			name:      "set if absent fails because entry exists",
+			condition: ptr.String("nx"),
+			cache: &cachefakes.FakeCache{
+				SetStub: func(ctx context.Context, key string, value []byte, ttl time.Duration, condition string, tags []string, obsolete string) error {
+					return errors.New(errors.Exist, "set condition not met")
+				},
+			},
+			setCondition: "nx",
+			setCalls:     1,
+			errkind:      errors.Exist,
+			errtext:      "cache entry already exists",
+		},
+		{
+			name:      "set if present fails because entry does not exist",
+			condition: ptr.String("xx"),
+			cache: &cachefakes.FakeCache{
+				SetStub: func(ctx context.Context, key string, value []byte, ttl time.Duration, condition string, tags []string, obsolete string) error {
+					return errors.New(errors.Exist, "set condition not met")
+				},
+			},
+			setCondition: "xx",
+			setCalls:     1,
+			errkind:      errors.Exist,
+			errtext:      "cache entry does not exist",
+		},
+		{
+			name:      "set if absent fails because legacy entry exists in migration mode",
+			condition: ptr.String("nx"),
+			opts:      []cache.Option{cache.WithKeyFormat(cache.KeyFormatV2, true)},
+			cache: &cachefakes.FakeCache{
+				ExistsStub: func(ctx context.Context, key string) (bool, error) {
+					return key == legacyKey, nil
+				},
+			},
+			errkind: errors.Exist,
+			errtext: "cache entry already exists",
+		},
+		{
+			name:      "set if present succeeds because legacy entry exists in migration mode",
+			condition: ptr.String("xx"),
+			opts:      []cache.Option{cache.WithKeyFormat(cache.KeyFormatV2, true)},
+			cache: &cachefakes.FakeCache{
+				ExistsStub: func(ctx context.Context, key string) (bool, error) {
+					return key == legacyKey, nil
+				},
+			},
+			setCondition: "",
+			setCalls:     1,
+		},
+		{
+			name:         "set if present in migration mode without legacy entry",
+			condition:    ptr.String("xx"),
+			opts:         []cache.Option{cache.WithKeyFormat(cache.KeyFormatV2, true)},
+			cache:        &cachefakes.FakeCache{},
+			setCondition: "xx",
+			setCalls:     1,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			svc := cache.New(test.cache, nil, zap.NewNop(), test.opts...)
+			err := svc.Set(context.Background(), &goacache.CacheSetRequest{
+				Key:       "key",
+				Namespace: ptr.String("namespace"),
+				Scope:     ptr.String("scope"),
+				Data:      map[string]interface{}{"test": "value"},
+				Condition: test.condition,
+			})
+
+			assert.Equal(t, test.setCalls, test.cache.SetCallCount())
+			if test.setCalls > 0 {
+				_, _, _, _, condition, _, _ := test.cache.SetArgsForCall(0)
+				assert.Equal(t, test.setCondition, condition)
+			}
+
+			if err == nil {
+				assert.Empty(t, test.errtext)
+			} else {
+				e, ok := err.(*errors.Error)
+				assert.True(t, ok)
+				assert.Equal(t, test.errkind, e.Kind)
+				assert.Contains(t, e.Error(), test.errtext)
+			}
+		})
+	}
+}
+
+func TestService_ETag(t *testing.T) {
+	const value = `{"test":"value"}`
+	const otherETag = `"0b8b8de8a3b5b3e2f4e56a48b0ec9d2d0a2e1bd4"`
+
+	fake := &cachefakes.FakeCache{
+		GetStub: func(ctx context.Context, key string) ([]byte, error) {
+			return []byte(value), nil
+		},
+	}
+	svc := cache.New(fake, nil, zap.NewNop())
+
+	res, err := svc.Get(context.Background(), &goacache.CacheGetRequest{Key: "key"})
+	assert.NoError(t, err)
+	assert.NotNil(t, res.Etag)
+	assert.Len(t, *res.Etag, 42)
+	current := *res.Etag
+
+	t.Run("get returns the same etag for the same value", func(t *testing.T) {
+		res, err := svc.Get(context.Background(), &goacache.CacheGetRequest{Key: "key"})
+		assert.NoError(t, err)
+		assert.Equal(t, current, *res.Etag)
+	})
+
+	t.Run("get returns not modified if etag matches", func(t *testing.T) {
+		for _, header := range []string{current, "W/" + current, `"other", ` + current, "*"} {
+			res, err := svc.Get(context.Background(), &goacache.CacheGetRequest{
+				Key:         "key",
+				IfNoneMatch: ptr.String(header),
+			})
+			assert.Nil(t, res)
+			e, ok := err.(*goacache.CacheNotModified)
+			assert.True(t, ok, header)
+			assert.Equal(t, current, e.Etag)
+		}
+	})
+
+	t.Run("get returns value if etag does not match", func(t *testing.T) {
+		res, err := svc.Get(context.Background(), &goacache.CacheGetRequest{
+			Key:         "key",
+			IfNoneMatch: ptr.String(otherETag),
+		})
+		assert.NoError(t, err)
+		assert.Equal(t, map[string]interface{}{"test": "value"}, res.Data)
+	})
+
+	t.Run("multi scope get has no etag", func(t *testing.T) {
+		res, err := svc.Get(context.Background(), &goacache.CacheGetRequest{
+			Key:   "key",
+			Scope: ptr.String("scope,scope2"),
+		})
+		assert.NoError(t, err)
+		assert.Nil(t, res.Etag)
+	})
+}
+
+func TestService_SetIfMatch(t *testing.T) {
+	tests := []struct {
+		name      string
+		ifMatch   string
+		condition *string
+		cache     *cachefakes.FakeCache
+
+		casHash      string
+		casCalls     int
+		setCalls     int
+		setCondition string
+		errkind      errors.Kind
+		errtext      string
+	}{
+		{
+			name:     "value is set if etag matches",
+			ifMatch:  `"abc"`,
+			cache:    &cachefakes.FakeCache{},
+			casHash:  "abc",
+			casCalls: 1,
+		},
+		{
+			name:     "weak etag is compared by its hash",
+			ifMatch:  `W/"abc"`,
+			cache:    &cachefakes.FakeCache{},
+			casHash:  "abc",
+			casCalls: 1,
+		},
+		{
+			name:    "value has been modified",
+			ifMatch: `"abc"`,
+			cache: &cachefakes.FakeCache{
+				CompareAndSetStub: func(ctx context.Context, key string, value []byte, ttl time.Duration, hash string) error {
+					return errors.New(errors.Exist, "value has been modified")
+				},
+			},
+			casHash:  "abc",
+			casCalls: 1,
+			errkind:  errors.Exist,
+			errtext:  "cache entry has been modified",
+		},
+		{
+			name:         "any etag requires an existing entry",
+			ifMatch:      "*",
+			cache:        &cachefakes.FakeCache{},
+			setCalls:     1,
+			setCondition: "xx",
+		},
+		{
+			name:      "etag cannot be combined with a condition",
+			ifMatch:   `"abc"`,
+			condition: ptr.String("nx"),
+			cache:     &cachefakes.FakeCache{},
+			errkind:   errors.BadRequest,
+			errtext:   "If-Match cannot be combined with a set condition",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			svc := cache.New(test.cache, nil, zap.NewNop())
+			err := svc.Set(context.Background(), &goacache.CacheSetRequest{
+				Key:       "key",
+				Data:      map[string]interface{}{"test": "value"},
+				Condition: test.condition,
+				IfMatch:   ptr.String(test.ifMatch),
+			})
+
+			assert.Equal(t, test.casCalls, test.cache.CompareAndSetCallCount())
+			if test.casCalls > 0 {
+				_, _, _, _, hash := test.cache.CompareAndSetArgsForCall(0)
+				assert.Equal(t, test.casHash, hash)
+			}
+			assert.Equal(t, test.setCalls, test.cache.SetCallCount())
+			if test.setCalls > 0 {
+				_, _, _, _, condition, _, _ := test.cache.SetArgsForCall(0)
+				assert.Equal(t, test.setCondition, condition)
+			}
+
+			if err == nil {
+				assert.Empty(t, test.errtext)
+			} else {
+				e, ok := err.(*errors.Error)
+				assert.True(t, ok)
+				assert.Equal(t, test.errkind, e.Kind)
+				assert.Contains(t, e.Error(), test.errtext)
+			}
+		})
+	}
+}
+
+func TestService_BatchGet(t *testing.T) {
+	tests := []struct {
+		name  string
+		cache *cachefakes.FakeCache
+		opts  []cache.Option
+		req   *goacache.CacheBatchGetRequest
+
+		res     []*goacache.CacheBatchGetResult
+		errkind errors.Kind
+		errtext string
+	}{
+		{
+			name: "error getting values from cache",
+			req: &goacache.CacheBatchGetRequest{Items: []*goacache.CacheBatchGetItem{
+				{Key: "key"},
+			}},
+			cache: &cachefakes.FakeCache{
+				GetManyStub: func(ctx context.Context, keys []string) ([][]byte, error) {
+					return nil, errors.New(errors.Timeout, "some error")
+				},
+			},
+			errkind: errors.Timeout,
+			errtext: "some error",
+		},
+		{
+			name: "items have their own status",
+			req: &goacache.CacheBatchGetRequest{Items: []*goacache.CacheBatchGetItem{
+				{Key: "key", Namespace: ptr.String("namespace"), Scope: ptr.String("scope")},
+				{Key: ""},
+				{Key: "cache:job:1"},
+				{Key: "missing"},
+				{Key: "invalid"},
+			}},
+			cache: &cachefakes.FakeCache{
+				GetManyStub: func(ctx context.Context, keys []string) ([][]byte, error) {
+					assert.Equal(t, []string{"key,namespace,scope", "missing", "invalid"}, keys)
+					return [][]byte{[]byte(`{"test":"value"}`), nil, []byte("boom")}, nil
+				},
+			},
+			res: []*goacache.CacheBatchGetResult{
+				{Key: "key", Namespace: ptr.String("namespace"), Scope: ptr.String("scope"), Status: 200, Data: map[string]interface{}{"test": "value"}},
+				{Key: "", Status: 400, Error: ptr.String("missing key")},
+				{Key: "cache:job:1", Status: 400, Error: ptr.String("key starts with a reserved prefix")},
+				{Key: "missing", Status: 404, Error: ptr.String("key not found in cache")},
+				{Key: "invalid", Status: 500, Error: ptr.String("cannot decode json value from cache")},
+			},
+		},
+		{
+			name: "missing entries are read with legacy keys in migration mode",
+			req: &goacache.CacheBatchGetRequest{Items: []*goacache.CacheBatchGetItem{
+				{Key: "key", Namespace: ptr.String("namespace")},
+				{Key: "legacy", Namespace: ptr.String("namespace")},
+			}},
+			opts: []cache.Option{cache.WithKeyFormat(cache.KeyFormatV2, true)},
+			cache: &cachefakes.FakeCache{
+				GetManyStub: func(ctx context.Context, keys []string) ([][]byte, error) {
+					if len(keys) == 2 {
+						assert.Equal(t, []string{"v2:namespace::key", "v2:namespace::legacy"}, keys)
+						return [][]byte{[]byte(`{"test":"v2"}`), nil}, nil
+					}
+					assert.Equal(t, []string{"legacy,namespace"}, keys)
+					return [][]byte{[]byte(`{"test":"legacy"}`)}, nil
+				},
+			},
+			res: []*goacache.CacheBatchGetResult{
+				{Key: "key", Namespace: ptr.String("namespace"), Status: 200, Data: map[string]interface{}{"test": "v2"}},
+				{Key: "legacy", Namespace: ptr.String("namespace"), Status: 200, Data: map[string]interface{}{"test": "legacy"}},
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			svc := cache.New(test.cache, nil, zap.NewNop(), test.opts...)
+			res, err := svc.BatchGet(context.Background(), test.req)
+			if err == nil {
+				assert.Empty(t, test.errtext)
+				assert.Equal(t, test.res, res)
+			} else {
+				assert.Nil(t, res)
+				e, ok := err.(*errors.Error)
+				assert.True(t, ok)
+				assert.Equal(t, test.errkind, e.Kind)
+				assert.Contains(t, e.Error(), test.errtext)
+			}
+		})
+	}
+}
+
+func TestService_BatchSet(t *testing.T) {
+	fake := &cachefakes.FakeCache{
+		SetManyStub: func(ctx context.Context, keys []string, values [][]byte, ttls []time.Duration, obsolete []string) []error {
+			assert.Equal(t, []string{"key,namespace,scope", "failing"}, keys)
+			assert.Equal(t, [][]byte{[]byte(`{"test":"value"}`), []byte(`"value"`)}, values)
+			assert.Equal(t, []time.Duration{time.Minute, 0}, ttls)
+			return []error{nil, errors.New("some error")}
+		},
+	}
+
+	svc := cache.New(fake, nil, zap.NewNop())
+	res, err := svc.BatchSet(context.Background(), &goacache.CacheBatchSetRequest{Items: []*goacache.CacheBatchSetItem{
+		{Key: "key", Namespace: ptr.String("namespace"), Scope: ptr.String("scope"), Data: map[string]interface{}{"test": "value"}, TTL: ptr.Int(60)},
+		{Key: "", Data: "value"},
+		{Key: "failing", Data: "value"},
+		{Key: "invalid", Data: make(chan int)},
+	}})
+	assert.NoError(t, err)
+	assert.Equal(t, 1, fake.SetManyCallCount())
+	assert.Equal(t, []*goacache.CacheBatchSetResult{
+		{Key: "key", Namespace: ptr.String("namespace"), Scope: ptr.String("scope"), Status: 201},
+		{Key: "", Status: 400, Error: ptr.String("missing key")},
+		{Key: "failing", Status: 500, Error: ptr.String("error storing value in cache")},
+		{Key: "invalid", Status: 400, Error: ptr.String("cannot encode payload to json")},
+	}, res)
+}
+
+func TestService_Keys(t *testing.T) {
+	tests := []struct {
+		name   string
+		format cache.KeyFormat
+		req    *goacache.CacheKeysRequest
+		scans  [][]string
+
+		match   string
+		res     *goacache.CacheKeysResult
+		errkind errors.Kind
+		errtext string
+	}{
+		{
+			name:   "legacy keys of a namespace",
+			format: cache.KeyFormatLegacy,
+			req:    &goacache.CacheKeysRequest{Namespace: "Login", Limit: 10},
+			scans: [][]string{{
+				"a,Login",
+				"b,Login,admin",
+				"c,Login2",
+				"d,Login2,admin",
+				"Login",
+				"e,Other,Login",
+			}},
+			match: "*,Login*",
+			res: &goacache.CacheKeysResult{Keys: []*goacache.CacheKeysItem{
+				{Key: "a"},
+				{Key: "b", Scope: ptr.String("admin")},
+			}},
+		},
+		{
+			name:   "skips index keys of tagged legacy entries",
+			format: cache.KeyFormatLegacy,
+			req:    &goacache.CacheKeysRequest{Namespace: "Login", Limit: 10},
+			scans:  [][]string{{"a,Login", "cache:tags:a,Login", "b,Login,admin", "cache:tags:b,Login,admin"}},
+			match:  "*,Login*",
+			res: &goacache.CacheKeysResult{Keys: []*goacache.CacheKeysItem{
+				{Key: "a"},
+				{Key: "b", Scope: ptr.String("admin")},
+			}},
+		},
+		{
+			name:   "skips expiry claims of legacy entries",
+			format: cache.KeyFormatLegacy,
+			req:    &goacache.CacheKeysRequest{Namespace: "Login", Limit: 10},
+			scans:  [][]string{{"cache:expired:a,Login", "b,Login", "cache:expired:c,Login,admin"}},
+			match:  "*,Login*",
+			res:    &goacache.CacheKeysResult{Keys: []*goacache.CacheKeysItem{{Key: "b"}}},
+		},
+		{
+			name:   "legacy keys of a scope with prefix",
+			format: cache.KeyFormatLegacy,
+			req:    &goacache.CacheKeysRequest{Namespace: "Login", Scope: ptr.String("admin"), Prefix: ptr.String("did:*"), Limit: 10},
+			scans:  [][]string{{"did:*1,Login,admin", "did:*2,Login,admin2", "did:*3,Login"}},
+			match:  `did:\**,Login,admin`,
+			res: &goacache.CacheKeysResult{Keys: []*goacache.CacheKeysItem{
+				{Key: "did:*1", Scope: ptr.String("admin")},
+			}},
+		},
+		{
+			name:   "v2 keys of a namespace",
+			format: cache.KeyFormatV2,
+			req:    &goacache.CacheKeysRequest{Namespace: "Lo:gin", Prefix: ptr.String("did:"), Limit: 10},
+			scans: [][]string{{
+				"v2:Lo%3Agin::did%3Aweb",
+				"v2:Lo%3Agin:admin:did%3Akey",
+				"v2:Lo%3Agin2::did%3Aweb",
+				"v2:Lo%3Agin:admin:other",
+			}},
+			match: "v2:Lo%3Agin:*:did%3A*",
+			res: &goacache.CacheKeysResult{Keys: []*goacache.CacheKeysItem{
+				{Key: "did:web"},
+				{Key: "did:key", Scope: ptr.String("admin")},
+			}},
+		},
+		{
+			name:   "v2 keys of a scope",
+			format: cache.KeyFormatV2,
+			req:    &goacache.CacheKeysRequest{Namespace: "Login", Scope: ptr.String("admin"), Limit: 10},
+			scans:  [][]string{{"v2:Login:admin:key", "v2:Login:admin2:key"}},
+			match:  "v2:Login:admin:*",
+			res: &goacache.CacheKeysResult{Keys: []*goacache.CacheKeysItem{
+				{Key: "key", Scope: ptr.String("admin")},
+			}},
+		},
+		{
+			name:   "scans until the page is full",
+			format: cache.KeyFormatLegacy,
+			req:    &goacache.CacheKeysRequest{Namespace: "Login", Cursor: ptr.String("start"), Limit: 2},
+			scans:  [][]string{{"a,Login"}, {}, {"b,Login", "c,Login"}, {"d,Login"}},
+			match:  "*,Login*",
+			res: &goacache.CacheKeysResult{
+				Keys:   []*goacache.CacheKeysItem{{Key: "a"}, {Key: "b"}, {Key: "c"}},
+				Cursor: ptr.String("cursor-3"),
+			},
+		},
+		{
+			name:   "invalid cursor",
+			format: cache.KeyFormatLegacy,
+			req:    &goacache.CacheKeysRequest{Namespace: "Login", Cursor: ptr.String("invalid"), Limit: 10},
+			match:  "*,Login*",
+
+			errkind: errors.BadRequest,
+			errtext: "invalid cursor",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			cursor := "start"
+			if test.req.Cursor == nil {
+				cursor = ""
+			}
+			fake := &cachefakes.FakeCache{
+				ScanStub: func(ctx context.Context, c, match string, count int64) ([]string, string, error) {
+					if c == "invalid" {
+						return nil, "", errors.New(errors.BadRequest, "invalid cursor")
+					}
+					assert.Equal(t, cursor, c)
+					assert.Equal(t, test.match, match)
+					assert.Equal(t, int64(test.req.Limit), count)
+
+					n := 0
+					if c != "" && c != "start" {
+						_, _ = fmt.Sscanf(c, "cursor-%d", &n)
+					}
+					keys := test.scans[n]
+					next := ""
+					if n+1 < len(test.scans) {
+						next = fmt.Sprintf("cursor-%d", n+1)
+					}
+					cursor = next
+					return keys, next, nil
+				},
+			}
+
+			svc := cache.New(fake, nil, zap.NewNop(), cache.WithKeyFormat(test.format, false))
+			res, err := svc.Keys(context.Background(), test.req)
+			if err == nil {
+				assert.Empty(t, test.errtext)
+				assert.Equal(t, test.res, res)
+			} else {
+				assert.Nil(t, res)
+				e, ok := err.(*errors.Error)
+				assert.True(t, ok)
+				assert.Equal(t, test.errkind, e.Kind)
+				assert.Contains(t, e.Error(), test.errtext)
+			}
+		})
+	}
+}
+
+func TestService_KeysMigration(t *testing.T) {
+	scans := map[string][][]string{
+		"v2:Login:*:*": {{"v2:Login::a"}, {"v2:Login:admin:b"}},
+		"*,Login*":     {{"a,Login", "c,Login"}, {"d,Login,admin"}},
+	}
+	fake := &cachefakes.FakeCache{
+		ScanStub: func(ctx context.Context, cursor, match string, count int64) ([]string, string, error) {
+			n := 0
+			if cursor != "" {
+				_, _ = fmt.Sscanf(cursor, "cursor-%d", &n)
+			}
+			next := ""
+			if n+1 < len(scans[match]) {
+				next = fmt.Sprintf("cursor-%d", n+1)
+			}
+			return scans[match][n], next, nil
+		},
+		ExistsStub: func(ctx context.Context, key string) (bool, error) {
+			// a is stored under both formats
+			return key == "v2:Login::a", nil
+		},
+	}
+	svc := cache.New(fake, nil, zap.NewNop(), cache.WithKeyFormat(cache.KeyFormatV2, true))
+
+	// v2 keys are listed first, then legacy keys which weren't migrated
+	var keys []string
+	var cursors []string
+	req := &goacache.CacheKeysRequest{Namespace: "Login", Limit: 1}
+	for {
+		res, err := svc.Keys(context.Background(), req)
+		if !assert.NoError(t, err) {
+			return
+		}
+		for _, item := range res.Keys {
+			keys = append(keys, item.Key)
+		}
+		if res.Cursor == nil {
+			break
+		}
+		cursors = append(cursors, *res.Cursor)
+		req.Cursor = res.Cursor
+	}
+	assert.Equal(t, []string{"a", "b", "c", "d"}, keys)
+	assert.Equal(t, []string{"cursor-1", "legacy:", "legacy:cursor-1"}, cursors)
+
+	// legacy cursors are only valid in migration mode
+	svc = cache.New(fake, nil, zap.NewNop(), cache.WithKeyFormat(cache.KeyFormatV2, false))
+	_, err := svc.Keys(context.Background(), &goacache.CacheKeysRequest{Namespace: "Login", Cursor: ptr.String("legacy:"), Limit: 1})
+	assert.True(t, errors.Is(errors.BadRequest, err))
+}
+
+func TestService_DeleteNamespace(t *testing.T) {
+	tests := []struct {
+		name    string
+		opts    []cache.Option
+		req     *goacache.CacheDeleteNamespaceRequest
+		scans   map[string][]string
+		scanErr error
+
+		unlinked []string
+		status   string
+		deleted  int64
+		errtext  string
+	}{
+		{
+			name: "deletes entries of a namespace",
+			req:  &goacache.CacheDeleteNamespaceRequest{Namespace: "Login"},
+			scans: map[string][]string{
+				"*,Login*": {"a,Login", "b,Login,admin", "c,Login2"},
+			},
+			unlinked: []string{"a,Login", "b,Login,admin"},
+			status:   "completed",
+			deleted:  2,
+		},
+		{
+			name: "skips index keys of tagged entries",
+			req:  &goacache.CacheDeleteNamespaceRequest{Namespace: "Login"},
+			scans: map[string][]string{
+				"*,Login*": {"a,Login", "cache:tags:a,Login", "b,Login,admin", "cache:tags:b,Login,admin"},
+			},
+			unlinked: []string{"a,Login", "b,Login,admin"},
+			status:   "completed",
+			deleted:  2,
+		},
+		{
+			name: "skips expiry claims of entries",
+			req:  &goacache.CacheDeleteNamespaceRequest{Namespace: "Login"},
+			scans: map[string][]string{
+				"*,Login*": {"cache:expired:a,Login", "b,Login", "cache:expired:c,Login,admin"},
+			},
+			unlinked: []string{"b,Login"},
+			status:   "completed",
+			deleted:  1,
+		},
+		{
+			name: "deletes entries of a scope",
+			req:  &goacache.CacheDeleteNamespaceRequest{Namespace: "Login", Scope: ptr.String("admin")},
+			scans: map[string][]string{
+				"*,Login,admin": {"a,Login,admin", "b,Login,x,Login,admin"},
+			},
+			unlinked: []string{"a,Login,admin", "b,Login,x,Login,admin"},
+			status:   "completed",
+			deleted:  2,
+		},
+		{
+			name: "deletes v2 and legacy entries in migration mode",
+			opts: []cache.Option{cache.WithKeyFormat(cache.KeyFormatV2, true)},
+			req:  &goacache.CacheDeleteNamespaceRequest{Namespace: "Login"},
+			scans: map[string][]string{
+				"v2:Login:*:*": {"v2:Login::a", "v2:Login:admin:b"},
+				"*,Login*":     {"c,Login"},
+			},
+			unlinked: []string{"v2:Login::a", "v2:Login:admin:b", "c,Login"},
+			status:   "completed",
+			deleted:  3,
+		},
+		{
+			name:    "error scanning keys",
+			req:     &goacache.CacheDeleteNamespaceRequest{Namespace: "Login"},
+			scanErr: errors.New("some error"),
+			status:  "failed",
+			errtext: "some error",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			var mu sync.Mutex
+			var unlinked []string
+			fake := &cachefakes.FakeCache{
+				ScanStub: func(ctx context.Context, cursor, match string, count int64) ([]string, string, error) {
+					if test.scanErr != nil {
+						return nil, "", test.scanErr
+					}
+					keys := test.scans[match]
+					// return one key per call to test the iteration
+					n := 0
+					if cursor != "" {
+						_, _ = fmt.Sscanf(cursor, "%d", &n)
+					}
+					if n >= len(keys) {
+						return nil, "", nil
+					}
+					return keys[n : n+1], fmt.Sprint(n + 1), nil
+				},
+				UnlinkStub: func(ctx context.Context, keys []string) (int64, error) {
+					mu.Lock()
+					defer mu.Unlock()
+					unlinked = append(unlinked, keys...)
+					return int64(len(keys)), nil
+				},
+			}
+			withJobs(fake)
+
+			svc := cache.New(fake, nil, zap.NewNop(), test.opts...)
+			job, err := svc.DeleteNamespace(context.Background(), test.req)
+			assert.NoError(t, err)
+			assert.NotEmpty(t, job.ID)
+			assert.Equal(t, test.req.Namespace, job.Namespace)
+			assert.Equal(t, test.req.Scope, job.Scope)
+
+			assert.Eventually(t, func() bool {
+				job, err = svc.Job(context.Background(), &goacache.CacheJobRequest{ID: job.ID})
+				return err == nil && job.Status != "running"
+			}, time.Second, time.Millisecond)
+
+			assert.Equal(t, test.status, job.Status)
+			assert.Equal(t, test.deleted, job.Deleted)
+			assert.NotNil(t, job.FinishedAt)
+			if test.errtext != "" {
+				assert.Contains(t, *job.Error, test.errtext)
+			} else {
+				assert.Nil(t, job.Error)
+			}
+
+			mu.Lock()
+			defer mu.Unlock()
+			assert.Equal(t, test.unlinked, unlinked)
+		})
+	}
+}
+
+func TestService_DeleteNamespaceRunning(t *testing.T) {
+	release := make(chan struct{})
+	fake := &cachefakes.FakeCache{
+		ScanStub: func(ctx context.Context, cursor, match string, count int64) ([]string, string, error) {
+			<-release
+			return nil, "", nil
+		},
+	}
+	withJobs(fake)
+
+	svc := cache.New(fake, nil, zap.NewNop())
+	job, err := svc.DeleteNamespace(context.Background(), &goacache.CacheDeleteNamespaceRequest{Namespace: "Login"})
+	assert.NoError(t, err)
+	assert.Equal(t, "running", job.Status)
+
+	// a running job for the same namespace and scope is returned
+	same, err := svc.DeleteNamespace(context.Background(), &goacache.CacheDeleteNamespaceRequest{Namespace: "Login"})
+	assert.NoError(t, err)
+	assert.Equal(t, job.ID, same.ID)
+
+	other, err := svc.DeleteNamespace(context.Background(), &goacache.CacheDeleteNamespaceRequest{Namespace: "Login", Scope: ptr.String("admin")})
+	assert.NoError(t, err)
+	assert.NotEqual(t, job.ID, other.ID)
+
+	close(release)
+}
+
+func TestService_Job(t *testing.T) {
+	fake := withJobs(&cachefakes.FakeCache{})
+	svc := cache.New(fake, nil, zap.NewNop())
+
+	job, err := svc.Job(context.Background(), &goacache.CacheJobRequest{ID: "unknown"})
+	assert.Nil(t, job)
+	assert.True(t, errors.Is(errors.NotFound, err))
+
+	// a running job without progress has been interrupted
+	stale := time.Now().Add(-time.Hour).UTC().Format(time.RFC3339)
+	_, _ = fake.StartJob(context.Background(), "stale", "lock", map[string]string{
+		"id": "stale", "namespace": "Login", "status": "running", "deleted": "5", "startedAt": stale, "updatedAt": stale,
+	}, time.Hour)
+	job, err = svc.Job(context.Background(), &goacache.CacheJobRequest{ID: "stale"})
+	if !assert.NoError(t, err) {
+		return
+	}
+	assert.Equal(t, "failed", job.Status)
+	assert.Equal(t, int64(5), job.Deleted)
+	assert.Equal(t, "job has been interrupted", *job.Error)
+
+	fake.GetJobReturns(nil, errors.New("some error"))
+	job, err = svc.Job(context.Background(), &goacache.CacheJobRequest{ID: "stale"})
+	assert.Nil(t, job)
+	assert.Contains(t, err.Error(), "some error")
+}
+
+// withJobs backs the job methods of the fake by a map, which is shared by
+// the jobs and their locks like the keys in Redis.
+func withJobs(fake *cachefakes.FakeCache) *cachefakes.FakeCache {
+	var mu sync.Mutex
+	jobs := map[string]map[string]string{}
+	locks := map[string]string{}
+
+	fake.StartJobStub = func(ctx context.Context, id, lock string, fields map[string]string, ttl time.Duration) (string, error) {
+		mu.Lock()
+		defer mu.Unlock()
+		if running, ok := locks[lock]; ok {
+			return running, nil
+		}
+		locks[lock] = id
+		jobs[id] = map[string]string{}
+		for k, v := range fields {
+			jobs[id][k] = v
+		}
+		return id, nil
+	}
+	fake.UpdateJobStub = func(ctx context.Context, id, lock string, fields map[string]string, deleted int64, ttl time.Duration) error {
+		mu.Lock()
+		defer mu.Unlock()
+		for k, v := range fields {
+			jobs[id][k] = v
+		}
+		n, _ := strconv.ParseInt(jobs[id]["deleted"], 10, 64)
+		jobs[id]["deleted"] = strconv.FormatInt(n+deleted, 10)
+		return nil
+	}
+	fake.FinishJobStub = func(ctx context.Context, id, lock string, fields map[string]string, ttl time.Duration) error {
+		mu.Lock()
+		defer mu.Unlock()
+		for k, v := range fields {
+			jobs[id][k] = v
+		}
+		if locks[lock] == id {
+			delete(locks, lock)
+		}
+		return nil
+	}
+	fake.GetJobStub = func(ctx context.Context, id string) (map[string]string, error) {
+		mu.Lock()
+		defer mu.Unlock()
+		fields, ok := jobs[id]
+		if !ok {
+			return nil, errors.New(errors.NotFound)
+		}
+		copied := map[string]string{}
+		for k, v := range fields {
+			copied[k] = v
+		}
+		return copied, nil
+	}
+	return fake
+}
+
+func TestService_SetTags(t *testing.T) {
+	tooManyTags := make([]string, 33)
+	for i := range tooManyTags {
+		tooManyTags[i] = fmt.Sprintf("tag%d", i)
+	}
+
+	tests := []struct {
+		name  string
+		req   *goacache.CacheSetRequest
+		cache *cachefakes.FakeCache
+
+		tags    []string
+		set     bool
+		setTags bool
+		errkind errors.Kind
+		errtext string
+	}{
+		{
+			name:  "entry without tags drops previous tags",
+			req:   &goacache.CacheSetRequest{Key: "key", Data: "value"},
+			cache: &cachefakes.FakeCache{},
+			set:   true,
+		},
+		{
+			name:  "tags are trimmed and deduplicated",
+			req:   &goacache.CacheSetRequest{Key: "key", Namespace: ptr.String("namespace"), Data: "value", TTL: ptr.Int(60), Tags: ptr.String(" issuer:did:web:foo, schema:v2,,issuer:did:web:foo")},
+			cache: &cachefakes.FakeCache{},
+			tags:  []string{"issuer:did:web:foo", "schema:v2"},
+			set:   true,
+		},
+		{
+			name:    "too many tags",
+			req:     &goacache.CacheSetRequest{Key: "key", Data: "value", Tags: ptr.String(strings.Join(tooManyTags, ","))},
+			cache:   &cachefakes.FakeCache{},
+			errkind: errors.BadRequest,
+			errtext: "too many tags",
+		},
+		{
+			name: "tags are not set if the entry is not stored",
+			req:  &goacache.CacheSetRequest{Key: "key", Data: "value", Condition: ptr.String("nx"), Tags: ptr.String("tag")},
+			cache: &cachefakes.FakeCache{
+				SetStub: func(ctx context.Context, key string, value []byte, ttl time.Duration, condition string, tags []string, obsolete string) error {
+					return errors.New(errors.Exist, "set condition not met")
+				},
+			},
+			tags:    []string{"tag"},
+			set:     true,
+			errkind: errors.Exist,
+			errtext: "cache entry already exists",
+		},
+		{
+			name:    "tags are set after compare-and-set",
+			req:     &goacache.CacheSetRequest{Key: "key", Data: "value", IfMatch: ptr.String(`"hash"`), TTL: ptr.Int(60), Tags: ptr.String("tag")},
+			cache:   &cachefakes.FakeCache{},
+			tags:    []string{"tag"},
+			setTags: true,
+		},
+		{
+			name: "error storing tags",
+			req:  &goacache.CacheSetRequest{Key: "key", Data: "value", IfMatch: ptr.String(`"hash"`), Tags: ptr.String("tag")},
+			cache: &cachefakes.FakeCache{
+				SetTagsStub: func(ctx context.Context, key string, tags []string, ttl time.Duration) error {
+					return errors.New("some error")
+				},
+			},
+			tags:    []string{"tag"},
+			setTags: true,
+			errkind: errors.Unknown,
+			errtext: "error storing tags in cache",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			svc := cache.New(test.cache, nil, zap.NewNop())
+			err := svc.Set(context.Background(), test.req)
+			if test.errtext == "" {
+				assert.NoError(t, err)
+			} else {
+				e, ok := err.(*errors.Error)
+				assert.True(t, ok)
+				assert.Equal(t, test.errkind, e.Kind)
+				assert.Contains(t, e.Error(), test.errtext)
+			}
+
+			// plain writes send their tags with the value
+			if test.set {
+				assert.Equal(t, 1, test.cache.SetCallCount())
+				_, _, _, _, _, tags, _ := test.cache.SetArgsForCall(0)
+				assert.Equal(t, test.tags, tags)
+			}
+
+			if !test.setTags {
+				assert.Equal(t, 0, test.cache.SetTagsCallCount())
+				return
+			}
+			assert.Equal(t, 1, test.cache.SetTagsCallCount())
+			_, key, tags, ttl := test.cache.SetTagsArgsForCall(0)
+			_, casKey, _, casTTL, _ := test.cache.CompareAndSetArgsForCall(0)
+			assert.Equal(t, casKey, key)
+			assert.Equal(t, casTTL, ttl)
+			assert.Equal(t, test.tags, tags)
+		})
+	}
+}
+
+func TestService_DeleteTag(t *testing.T) {
+	tests := []struct {
+		name  string
+		tag   string
+		cache *cachefakes.FakeCache
+
+		res     *goacache.CacheDeleteTagResult
+		errkind errors.Kind
+		errtext string
+	}{
+		{
+			name:    "missing tag",
+			tag:     " ",
+			cache:   &cachefakes.FakeCache{},
+			errkind: errors.BadRequest,
+			errtext: "missing tag",
+		},
+		{
+			name: "error deleting tagged entries",
+			tag:  "schema:v2",
+			cache: &cachefakes.FakeCache{
+				InvalidateTagStub: func(ctx context.Context, tag string) (int64, error) {
+					return 0, errors.New("some error")
+				},
+			},
+			errkind: errors.Unknown,
+			errtext: "some error",
+		},
+		{
+			name: "tagged entries are deleted",
+			tag:  "schema:v2",
+			cache: &cachefakes.FakeCache{
+				InvalidateTagStub: func(ctx context.Context, tag string) (int64, error) {
+					assert.Equal(t, "schema:v2", tag)
+					return 3, nil
+				},
+			},
+			res: &goacache.CacheDeleteTagResult{Deleted: 3},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			svc := cache.New(test.cache, nil, zap.NewNop())
+			res, err := svc.DeleteTag(context.Background(), &goacache.CacheDeleteTagRequest{Tag: test.tag})
+			if err == nil {
+				assert.Empty(t, test.errtext)
+				assert.Equal(t, test.res, res)
+			} else {
+				assert.Nil(t, res)
+				e, ok := err.(*errors.Error)
+				assert.True(t, ok)
+				assert.Equal(t, test.errkind, e.Kind)
+				assert.Contains(t, e.Error(), test.errtext)
+			}
+		})
+	}
+}
+
+func TestService_SlidingExpiration(t *testing.T) {
+	tests := []struct {
+		name    string
+		sliding map[string]time.Duration
+		req     *goacache.CacheGetRequest
+
+		touch   time.Duration
+		touched []string
+	}{
+		{
+			name: "ttl is kept without touch",
+			req:  &goacache.CacheGetRequest{Key: "key", Namespace: ptr.String("Login")},
+		},
+		{
+			name:    "touch header resets the ttl",
+			req:     &goacache.CacheGetRequest{Key: "key", Namespace: ptr.String("Login"), Touch: ptr.Int(30)},
+			touch:   30 * time.Second,
+			touched: []string{"key,Login"},
+		},
+		{
+			name:    "namespace default resets the ttl",
+			sliding: map[string]time.Duration{"Login": time.Hour},
+			req:     &goacache.CacheGetRequest{Key: "key", Namespace: ptr.String("Login")},
+			touch:   time.Hour,
+			touched: []string{"key,Login"},
+		},
+		{
+			name:    "namespace default of other namespace is not applied",
+			sliding: map[string]time.Duration{"Login": time.Hour},
+			req:     &goacache.CacheGetRequest{Key: "key", Namespace: ptr.String("Other")},
+		},
+		{
+			name:    "touch header overrides the namespace default",
+			sliding: map[string]time.Duration{"Login": time.Hour},
+			req:     &goacache.CacheGetRequest{Key: "key", Namespace: ptr.String("Login"), Touch: ptr.Int(60)},
+			touch:   time.Minute,
+			touched: []string{"key,Login"},
+		},
+		{
+			name:    "entries of all scopes are touched",
+			req:     &goacache.CacheGetRequest{Key: "key", Namespace: ptr.String("Login"), Scope: ptr.String("a,b"), Touch: ptr.Int(60)},
+			touch:   time.Minute,
+			touched: []string{"key,Login,a", "key,Login,b"},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			var touched []string
+			fake := &cachefakes.FakeCache{
+				GetStub: func(ctx context.Context, key string) ([]byte, error) {
+					return []byte(`{"test":"value"}`), nil
+				},
+				GetExStub: func(ctx context.Context, key string, ttl time.Duration) ([]byte, error) {
+					assert.Equal(t, test.touch, ttl)
+					touched = append(touched, key)
+					return []byte(`{"test":"value"}`), nil
+				},
+				GetManyExStub: func(ctx context.Context, keys []string, ttl time.Duration) ([][]byte, error) {
+					assert.Equal(t, test.touch, ttl)
+					touched = append(touched, keys...)
+					return [][]byte{[]byte(`{"test":"value"}`), []byte(`{"test":"value"}`)}, nil
+				},
+			}
+
+			svc := cache.New(fake, nil, zap.NewNop(), cache.WithSlidingTTL(test.sliding))
+			_, err := svc.Get(context.Background(), test.req)
+			assert.NoError(t, err)
+			assert.Equal(t, test.touched, touched)
+			if test.touched != nil {
+				assert.Equal(t, 0, fake.GetCallCount())
+			}
+		})
+	}
+}
+
+func TestLoadTTLPolicies(t *testing.T) {
+	tests := []struct {
+		name string
+		file string
+
+		policies cache.TTLPolicies
+		errtext  string
+	}{
+		{
+			name: "valid policies",
+			file: `
+namespaces:
+  Login:
+    defaultTTL: 30m
+    maxTTL: 24h
+    ttlRequired: false
+  "*":
+    ttlRequired: true
+`,
+			policies: cache.TTLPolicies{
+				"Login": {DefaultTTL: 30 * time.Minute, MaxTTL: 24 * time.Hour},
+				"*":     {TTLRequired: true},
+			},
+		},
+		{
+			name:    "invalid duration",
+			file:    "namespaces:\n  Login:\n    maxTTL: forever\n",
+			errtext: "cannot parse ttl policy file",
+		},
+		{
+			name:    "default exceeds maximum",
+			file:    "namespaces:\n  Login:\n    defaultTTL: 2h\n    maxTTL: 1h\n",
+			errtext: `namespace "Login": defaultTTL exceeds maxTTL`,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			path := filepath.Join(t.TempDir(), "policies.yaml")
+			assert.NoError(t, os.WriteFile(path, []byte(test.file), 0o600))
+
+			policies, err := cache.LoadTTLPolicies(path)
+			if test.errtext == "" {
+				assert.NoError(t, err)
+				assert.Equal(t, test.policies, policies)
+			} else {
+				assert.ErrorContains(t, err, test.errtext)
+			}
+		})
+	}
+}
+
+func TestService_TTLPolicy(t *testing.T) {
+	policies := cache.TTLPolicies{
+		"Login":   {DefaultTTL: 30 * time.Minute, MaxTTL: time.Hour},
+		"Session": {MaxTTL: time.Hour},
+		"*":       {TTLRequired: true},
+	}
+
+	tests := []struct {
+		name string
+		req  *goacache.CacheSetRequest
+
+		ttl     time.Duration
+		errtext string
+	}{
+		{
+			name: "default ttl is applied",
+			req:  &goacache.CacheSetRequest{Key: "key", Namespace: ptr.String("Login"), Data: "value"},
+			ttl:  30 * time.Minute,
+		},
+		{
+			name: "ttl within maximum is kept",
+			req:  &goacache.CacheSetRequest{Key: "key", Namespace: ptr.String("Login"), Data: "value", TTL: ptr.Int(60)},
+			ttl:  time.Minute,
+		},
+		{
+			name: "ttl is reduced to maximum",
+			req:  &goacache.CacheSetRequest{Key: "key", Namespace: ptr.String("Login"), Data: "value", TTL: ptr.Int(7200)},
+			ttl:  time.Hour,
+		},
+		{
+			name: "maximum ttl is applied without default",
+			req:  &goacache.CacheSetRequest{Key: "key", Namespace: ptr.String("Session"), Data: "value"},
+			ttl:  time.Hour,
+		},
+		{
+			name:    "ttl is required by fallback policy",
+			req:     &goacache.CacheSetRequest{Key: "key", Namespace: ptr.String("Other"), Data: "value"},
+			errtext: "ttl policy violation: ttlRequired",
+		},
+		{
+			name:    "ttl is required without namespace",
+			req:     &goacache.CacheSetRequest{Key: "key", Data: "value"},
+			errtext: "ttl policy violation: ttlRequired",
+		},
+		{
+			name: "required ttl is given",
+			req:  &goacache.CacheSetRequest{Key: "key", Namespace: ptr.String("Other"), Data: "value", TTL: ptr.Int(10)},
+			ttl:  10 * time.Second,
+		},
+		{
+			name:    "negative ttl does not bypass maximum",
+			req:     &goacache.CacheSetRequest{Key: "key", Namespace: ptr.String("Session"), Data: "value", TTL: ptr.Int(-1)},
+			errtext: "ttl policy violation: negative ttl",
+		},
+		{
+			name:    "negative ttl does not bypass required ttl",
+			req:     &goacache.CacheSetRequest{Key: "key", Namespace: ptr.String("Other"), Data: "value", TTL: ptr.Int(-1)},
+			errtext: "ttl policy violation: negative ttl",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			fake := &cachefakes.FakeCache{}
+			svc := cache.New(fake, nil, zap.NewNop(), cache.WithTTLPolicies(policies))
+
+			err := svc.Set(context.Background(), test.req)
+			if test.errtext != "" {
+				assert.True(t, errors.Is(errors.BadRequest, err))
+				assert.Contains(t, err.Error(), test.errtext)
+				assert.Equal(t, 0, fake.SetCallCount())
+				return
+			}
+
+			assert.NoError(t, err)
+			_, _, _, ttl, _, _, _ := fake.SetArgsForCall(0)
+			assert.Equal(t, test.ttl, ttl)
+		})
+	}
+}
+
+func TestService_TTLPolicyBatchSet(t *testing.T) {
+	fake := &cachefakes.FakeCache{
+		SetManyStub: func(ctx context.Context, keys []string, values [][]byte, ttls []time.Duration, obsolete []string) []error {
+			assert.Equal(t, []time.Duration{time.Hour}, ttls)
+			return []error{nil}
+		},
+	}
+	svc := cache.New(fake, nil, zap.NewNop(), cache.WithTTLPolicies(cache.TTLPolicies{
+		"Login": {MaxTTL: time.Hour, TTLRequired: true},
+	}))
+
+	res, err := svc.BatchSet(context.Background(), &goacache.CacheBatchSetRequest{Items: []*goacache.CacheBatchSetItem{
+		{Key: "a", Namespace: ptr.String("Login"), Data: "value", TTL: ptr.Int(86400)},
+		{Key: "b", Namespace: ptr.String("Login"), Data: "value"},
+		{Key: "c", Namespace: ptr.String("Login"), Data: "value", TTL: ptr.Int(-1)},
+	}})
+	assert.NoError(t, err)
+	assert.Equal(t, 201, res[0].Status)
+	assert.Equal(t, 400, res[1].Status)
+	assert.Contains(t, *res[1].Error, "ttlRequired")
+	assert.Equal(t, 400, res[2].Status)
+	assert.Contains(t, *res[2].Error, "negative ttl")
+}
+
+func TestService_TTLPolicyTouch(t *testing.T) {
+	fake := &cachefakes.FakeCache{
+		GetExStub: func(ctx context.Context, key string, ttl time.Duration) ([]byte, error) {
+			assert.Equal(t, time.Hour, ttl)
+			return []byte(`{}`), nil
+		},
+	}
+	svc := cache.New(fake, nil, zap.NewNop(), cache.WithTTLPolicies(cache.TTLPolicies{
+		"Login": {MaxTTL: time.Hour},
+	}))
+
+	_, err := svc.Get(context.Background(), &goacache.CacheGetRequest{Key: "key", Namespace: ptr.String("Login"), Touch: ptr.Int(86400)})
+	assert.NoError(t, err)
+	assert.Equal(t, 1, fake.GetExCallCount())
+}
+
+func TestService_Patch(t *testing.T) {
+	stored := []byte(`{"name":"Alice","claims":{"age":30,"role":"user"},"tags":["a"]}`)
+
+	tests := []struct {
+		name  string
+		req   *goacache.CachePatchRequest
+		cache *cachefakes.FakeCache
+
+		data    interface{}
+		errkind errors.Kind
+		errtext string
+	}{
+		{
+			name:    "missing key",
+			req:     &goacache.CachePatchRequest{Patch: map[string]interface{}{}},
+			errkind: errors.BadRequest,
+			errtext: "missing key",
+		},
+		{
+			name:    "unsupported content type",
+			req:     &goacache.CachePatchRequest{Key: "key", Patch: map[string]interface{}{}, ContentType: ptr.String("application/json")},
+			errkind: errors.BadRequest,
+			errtext: "unsupported patch content type",
+		},
+		{
+			name:    "invalid json patch",
+			req:     &goacache.CachePatchRequest{Key: "key", Patch: map[string]interface{}{"op": "add"}, ContentType: ptr.String("application/json-patch+json")},
+			errkind: errors.BadRequest,
+			errtext: "invalid json patch",
+		},
+		{
+			name: "entry not found",
+			req:  &goacache.CachePatchRequest{Key: "key", Patch: map[string]interface{}{}, ContentType: ptr.String("application/merge-patch+json")},
+			cache: &cachefakes.FakeCache{
+				UpdateStub: func(ctx context.Context, key string, fn func([]byte) ([]byte, error)) ([]byte, error) {
+					return nil, errors.New(errors.NotFound)
+				},
+			},
+			errkind: errors.NotFound,
+			errtext: "key not found in cache",
+		},
+		{
+			name: "error updating value",
+			req:  &goacache.CachePatchRequest{Key: "key", Patch: map[string]interface{}{}, ContentType: ptr.String("application/merge-patch+json")},
+			cache: &cachefakes.FakeCache{
+				UpdateStub: func(ctx context.Context, key string, fn func([]byte) ([]byte, error)) ([]byte, error) {
+					return nil, errors.New("some error")
+				},
+			},
+			errkind: errors.Unknown,
+			errtext: "error patching value in cache",
+		},
+		{
+			name: "merge patch",
+			req: &goacache.CachePatchRequest{
+				Key:         "key",
+				Namespace:   ptr.String("namespace"),
+				ContentType: ptr.String("application/merge-patch+json; charset=utf-8"),
+				Patch: map[string]interface{}{
+					"claims": map[string]interface{}{"age": 31, "role": nil},
+					"tags":   []interface{}{"b"},
+				},
+			},
+			data: map[string]interface{}{
+				"name":   "Alice",
+				"claims": map[string]interface{}{"age": 31.0},
+				"tags":   []interface{}{"b"},
+			},
+		},
+		{
+			name: "json patch",
+			req: &goacache.CachePatchRequest{
+				Key:         "key",
+				Namespace:   ptr.String("namespace"),
+				ContentType: ptr.String("application/json-patch+json"),
+				Patch: []interface{}{
+					map[string]interface{}{"op": "test", "path": "/claims/role", "value": "user"},
+					map[string]interface{}{"op": "replace", "path": "/claims/role", "value": "admin"},
+					map[string]interface{}{"op": "add", "path": "/tags/-", "value": "b"},
+				},
+			},
+			data: map[string]interface{}{
+				"name":   "Alice",
+				"claims": map[string]interface{}{"age": 30.0, "role": "admin"},
+				"tags":   []interface{}{"a", "b"},
+			},
+		},
+		{
+			name: "failed json patch test",
+			req: &goacache.CachePatchRequest{
+				Key:         "key",
+				Namespace:   ptr.String("namespace"),
+				ContentType: ptr.String("application/json-patch+json"),
+				Patch: []interface{}{
+					map[string]interface{}{"op": "test", "path": "/claims/role", "value": "admin"},
+				},
+			},
+			errkind: errors.Exist,
+			errtext: "json patch test failed",
+		},
+		{
+			name: "json patch of missing path",
+			req: &goacache.CachePatchRequest{
+				Key:         "key",
+				Namespace:   ptr.String("namespace"),
+				ContentType: ptr.String("application/json-patch+json"),
+				Patch: []interface{}{
+					map[string]interface{}{"op": "remove", "path": "/missing"},
+				},
+			},
+			errkind: errors.BadRequest,
+			errtext: "cannot apply json patch",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			if test.cache == nil {
+				test.cache = &cachefakes.FakeCache{
+					UpdateStub: func(ctx context.Context, key string, fn func([]byte) ([]byte, error)) ([]byte, error) {
+						assert.Equal(t, "key,namespace", key)
+						return fn(stored)
+					},
+				}
+			}
+
+			svc := cache.New(test.cache, nil, zap.NewNop())
+			res, err := svc.Patch(context.Background(), test.req)
+			if err == nil {
+				assert.Empty(t, test.errtext)
+				assert.Equal(t, test.data, res.Data)
+				assert.NotNil(t, res.Etag)
+			} else {
+				assert.Nil(t, res)
+				e, ok := err.(*errors.Error)
+				assert.True(t, ok)
+				assert.Equal(t, test.errkind, e.Kind)
+				assert.Contains(t, e.Error(), test.errtext)
+			}
+		})
+	}
+}
+
+func TestService_GetStrategies(t *testing.T) {
+	stored := map[string]string{
+		"key,namespace,a": `{"name":"a","claims":{"age":30,"roles":["user"]},"only":"a"}`,
+		"key,namespace,b": `{"name":"b","claims":{"roles":["user","admin"],"email":"b@example.com"}}`,
+		"key,namespace,c": `[{"id":1}]`,
+	}
+
+	tests := []struct {
+		name     string
+		scope    string
+		strategy *string
+		arrays   *string
+
+		data    interface{}
+		errtext string
+	}{
+		{
+			name:     "deep merge concatenates arrays",
+			scope:    "a,b",
+			strategy: ptr.String("deep"),
+			data: map[string]interface{}{
+				"name": "b",
+				"only": "a",
+				"claims": map[string]interface{}{
+					"age":   30.0,
+					"roles": []interface{}{"user", "user", "admin"},
+					"email": "b@example.com",
+				},
+			},
+		},
+		{
+			name:     "deep merge deduplicates arrays",
+			scope:    "a,b",
+			strategy: ptr.String("deep"),
+			arrays:   ptr.String("dedupe"),
+			data: map[string]interface{}{
+				"name": "b",
+				"only": "a",
+				"claims": map[string]interface{}{
+					"age":   30.0,
+					"roles": []interface{}{"user", "admin"},
+					"email": "b@example.com",
+				},
+			},
+		},
+		{
+			name:     "deep merge in scope order",
+			scope:    "b,a,missing",
+			strategy: ptr.String("deep"),
+			arrays:   ptr.String("dedupe"),
+			data: map[string]interface{}{
+				"name": "a",
+				"only": "a",
+				"claims": map[string]interface{}{
+					"age":   30.0,
+					"roles": []interface{}{"user", "admin"},
+					"email": "b@example.com",
+				},
+			},
+		},
+		{
+			name:     "scoped values",
+			scope:    "a,c,missing",
+			strategy: ptr.String("scoped"),
+			data: map[string]interface{}{
+				"a": map[string]interface{}{
+					"name":   "a",
+					"claims": map[string]interface{}{"age": 30.0, "roles": []interface{}{"user"}},
+					"only":   "a",
+				},
+				"c": []map[string]interface{}{{"id": 1.0}},
+			},
+		},
+		{
+			name:     "unknown strategy",
+			scope:    "a,b",
+			strategy: ptr.String("unknown"),
+			errtext:  "unknown flatten strategy: unknown",
+		},
+		{
+			name:     "unknown array merge",
+			scope:    "a,b",
+			strategy: ptr.String("deep"),
+			arrays:   ptr.String("union"),
+			errtext:  "unknown array merge: union",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			get := func(ctx context.Context, key string) ([]byte, error) {
+				if v, ok := stored[key]; ok {
+					return []byte(v), nil
+				}
+				return nil, errors.New(errors.NotFound)
+			}
+			fake := &cachefakes.FakeCache{
+				GetStub:     get,
+				GetManyStub: getMany(get),
+			}
+
+			svc := cache.New(fake, nil, zap.NewNop())
+			res, err := svc.Get(context.Background(), &goacache.CacheGetRequest{
+				Key:       "key",
+				Namespace: ptr.String("namespace"),
+				Scope:     &test.scope,
+				Strategy:  test.strategy,
+				Arrays:    test.arrays,
+			})
+			if test.errtext != "" {
+				assert.Nil(t, res)
+				assert.True(t, errors.Is(errors.BadRequest, err))
+				assert.Contains(t, err.Error(), test.errtext)
+				assert.Equal(t, 0, fake.GetManyCallCount())
+				return
+			}
+
+			assert.NoError(t, err)
+			assert.Equal(t, test.data, res.Data)
+		})
+	}
+}
+
+func TestService_GetNonObjectValues(t *testing.T) {
+	stored := map[string]string{
+		"key,namespace":         `[1,2,3]`,
+		"key,namespace,object":  `{"name":"object"}`,
+		"key,namespace,array":   `[{"id":1},{"id":2}]`,
+		"key,namespace,string":  `"value"`,
+		"key,namespace,number":  `42`,
+		"key,namespace,numbers": `[1,2]`,
+		"key,namespace,mixed":   `[{"id":3},"x"]`,
+	}
+
+	tests := []struct {
+		name     string
+		scope    *string
+		strategy *string
+
+		data interface{}
+	}{
+		{
+			name: "single scope array of numbers",
+			data: []interface{}{1.0, 2.0, 3.0},
+		},
+		{
+			name:  "single scope string",
+			scope: ptr.String("string"),
+			data:  "value",
+		},
+		{
+			name:  "single scope number",
+			scope: ptr.String("number"),
+			data:  42.0,
+		},
+		{
+			name:  "merge wraps non-object values by scope",
+			scope: ptr.String("object,string,numbers,mixed"),
+			data: map[string]interface{}{
+				"name":    "object",
+				"string":  "value",
+				"numbers": []interface{}{1.0, 2.0},
+				"mixed":   []interface{}{map[string]interface{}{"id": 3.0}, "x"},
+			},
+		},
+		{
+			name:  "merge flattens arrays of objects",
+			scope: ptr.String("object,array"),
+			data: map[string]interface{}{
+				"name": "object",
+				"id_1": 1.0,
+				"id_2": 2.0,
+			},
+		},
+		{
+			name:     "last with wrapped values",
+			scope:    ptr.String("string,number,array"),
+			strategy: ptr.String("last"),
+			data: map[string]interface{}{
+				"string": "value",
+				"number": 42.0,
+				"id":     2.0,
+			},
+		},
+		{
+			name:     "deep merge wraps non-object values by scope",
+			scope:    ptr.String("object,number,array"),
+			strategy: ptr.String("deep"),
+			data: map[string]interface{}{
+				"name":   "object",
+				"number": 42.0,
+				"id":     2.0,
+			},
+		},
+		{
+			name:     "scoped values are not wrapped",
+			scope:    ptr.String("string,numbers"),
+			strategy: ptr.String("scoped"),
+			data: map[string]interface{}{
+				"string":  "value",
+				"numbers": []interface{}{1.0, 2.0},
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			get := func(ctx context.Context, key string) ([]byte, error) {
+				if v, ok := stored[key]; ok {
+					return []byte(v), nil
+				}
+				return nil, errors.New(errors.NotFound)
+			}
+			fake := &cachefakes.FakeCache{
+				GetStub:     get,
+				GetManyStub: getMany(get),
+			}
+
+			svc := cache.New(fake, nil, zap.NewNop())
+			res, err := svc.Get(context.Background(), &goacache.CacheGetRequest{
+				Key:       "key",
+				Namespace: ptr.String("namespace"),
+				Scope:     test.scope,
+				Strategy:  test.strategy,
+			})
+			assert.NoError(t, err)
+			assert.Equal(t, test.data, res.Data)
+		})
+	}
+}
+
+// getMany returns a GetMany stub which gets the values of the keys with get.
+// Values of keys which are not found are nil.
+func getMany(get func(ctx context.Context, key string) ([]byte, error)) func(ctx context.Context, keys []string) ([][]byte, error) {
+	return func(ctx context.Context, keys []string) ([][]byte, error) {
+		values := make([][]byte, len(keys))
+		for i, key := range keys {
+			value, err := get(ctx, key)
+			if err != nil {
+				if errors.Is(errors.NotFound, err) {
+					continue
+				}
+				return nil, err
+			}
+			values[i] = value
+		}
+		return values, nil
+	}
+}
+
+func TestService_GetMultipleScopesReadOnce(t *testing.T) {
+	fake := &cachefakes.FakeCache{
+		GetManyStub: func(ctx context.Context, keys []string) ([][]byte, error) {
+			// keys are in the order of the scopes
+			assert.Equal(t, []string{"v2:namespace:c:key", "v2:namespace:a:key", "v2:namespace:b:key"}, keys)
+			return [][]byte{[]byte(`{"test":"c"}`), nil, []byte(`{"test":"b"}`)}, nil
+		},
+	}
+
+	svc := cache.New(fake, nil, zap.NewNop(), cache.WithKeyFormat(cache.KeyFormatV2, false))
+	for strategy, expected := range map[string]interface{}{
+		"first": map[string]interface{}{"test": "c"},
+		"last":  map[string]interface{}{"test": "b"},
+	} {
+		res, err := svc.Get(context.Background(), &goacache.CacheGetRequest{
+			Key:       "key",
+			Namespace: ptr.String("namespace"),
+			Scope:     ptr.String("c, a, b"),
+			Strategy:  ptr.String(strategy),
+		})
+		assert.NoError(t, err)
+		assert.Equal(t, expected, res.Data)
+	}
+
+	assert.Equal(t, 2, fake.GetManyCallCount())
+	assert.Equal(t, 0, fake.GetCallCount())
+}
+
+func TestService_ScopePrecedence(t *testing.T) {
+	stored := map[string]string{
+		"key,namespace,admin":  `{"role":"admin","a":1,"b":1,"c":1,"d":1,"e":1,"f":1}`,
+		"key,namespace,user":   `{"role":"user","a":2,"b":2,"c":2,"d":2,"e":2,"f":2}`,
+		"key,namespace,guest":  `[{"role":"guest1","a":3},{"role":"guest2","b":3}]`,
+		"key,namespace,string": `"value"`,
+		"key,namespace,suffix": `{"a":4,"a_1":4}`,
+	}
+
+	tests := []struct {
+		name     string
+		scope    string
+		priority *string
+		strategy string
+
+		data string
+	}{
+		{
+			name:     "first keeps the value of the first scope",
+			scope:    "admin,user",
+			strategy: "first",
+			data:     `{"a":1,"b":1,"c":1,"d":1,"e":1,"f":1,"role":"admin"}`,
+		},
+		{
+			name:     "last keeps the value of the last scope",
+			scope:    "admin,user",
+			strategy: "last",
+			data:     `{"a":2,"b":2,"c":2,"d":2,"e":2,"f":2,"role":"user"}`,
+		},
+		{
+			name:     "objects of an array keep their order",
+			scope:    "guest,admin",
+			strategy: "first",
+			data:     `{"a":3,"b":3,"c":1,"d":1,"e":1,"f":1,"role":"guest1"}`,
+		},
+		{
+			name:     "objects of an array keep their order with last",
+			scope:    "admin,guest",
+			strategy: "last",
+			data:     `{"a":3,"b":3,"c":1,"d":1,"e":1,"f":1,"role":"guest2"}`,
+		},
+		{
+			name:     "merge numbers colliding keys in scope order",
+			scope:    "user,guest",
+			strategy: "merge",
+			data:     `{"a_1":2,"a_2":3,"b_1":2,"b_2":3,"c":2,"d":2,"e":2,"f":2,"role_1":"user","role_2":"guest1","role_3":"guest2"}`,
+		},
+		{
+			name:     "merge skips names of existing keys",
+			scope:    "suffix,user",
+			strategy: "merge",
+			data:     `{"a_1":4,"a_2":4,"a_3":2,"b":2,"c":2,"d":2,"e":2,"f":2,"role":"user"}`,
+		},
+		{
+			name:     "duplicate scopes count with their first occurrence",
+			scope:    "user,admin,user",
+			strategy: "last",
+			data:     `{"a":1,"b":1,"c":1,"d":1,"e":1,"f":1,"role":"admin"}`,
+		},
+		{
+			name:     "priority overrides the scope order",
+			scope:    "admin,user",
+			priority: ptr.String("user"),
+			strategy: "first",
+			data:     `{"a":2,"b":2,"c":2,"d":2,"e":2,"f":2,"role":"user"}`,
+		},
+		{
+			name:     "prioritized scopes are followed by the remaining scopes",
+			scope:    "admin,guest,user",
+			priority: ptr.String("user, unknown"),
+			strategy: "last",
+			data:     `{"a":3,"b":3,"c":1,"d":1,"e":1,"f":1,"role":"guest2"}`,
+		},
+		{
+			name:     "priority orders deep merge",
+			scope:    "admin,user",
+			priority: ptr.String("user,admin"),
+			strategy: "deep",
+			data:     `{"a":1,"b":1,"c":1,"d":1,"e":1,"f":1,"role":"admin"}`,
+		},
+		{
+			name:     "wrapped values follow the scope order",
+			scope:    "string,admin",
+			priority: ptr.String("admin"),
+			strategy: "merge",
+			data:     `{"a":1,"b":1,"c":1,"d":1,"e":1,"f":1,"role":"admin","string":"value"}`,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			get := func(ctx context.Context, key string) ([]byte, error) {
+				if v, ok := stored[key]; ok {
+					return []byte(v), nil
+				}
+				return nil, errors.New(errors.NotFound)
+			}
+			svc := cache.New(&cachefakes.FakeCache{GetManyStub: getMany(get)}, nil, zap.NewNop())
+
+			// the output must not depend on the iteration order of maps
+			for i := 0; i < 50; i++ {
+				res, err := svc.Get(context.Background(), &goacache.CacheGetRequest{
+					Key:           "key",
+					Namespace:     ptr.String("namespace"),
+					Scope:         &test.scope,
+					Strategy:      &test.strategy,
+					ScopePriority: test.priority,
+				})
+				assert.NoError(t, err)
+
+				data, err := json.Marshal(res.Data)
+				assert.NoError(t, err)
+				assert.JSONEq(t, test.data, string(data))
+			}
+		})
+	}
+}
+
+func TestService_GetFields(t *testing.T) {
+	stored := map[string]string{
+		"key,namespace,user":  `{"name":"Alice","address":{"city":"Berlin","zip":"10115"},"items":[{"id":1,"price":5},{"id":2,"price":7}]}`,
+		"key,namespace,admin": `{"role":"admin","address":{"city":"Bonn"}}`,
+		"key,namespace,list":  `[{"id":1,"name":"a"},{"id":2,"name":"b"}]`,
+		"key,namespace,str":   `"value"`,
+	}
+
+	tests := []struct {
+		name     string
+		scope    string
+		strategy *string
+		fields   string
+
+		data    string
+		errkind errors.Kind
+		errtext string
+	}{
+		{
+			name:   "dotted fields",
+			scope:  "user",
+			fields: "name, address.city",
+			data:   `{"name":"Alice","address":{"city":"Berlin"}}`,
+		},
+		{
+			name:   "dotted fields of array elements",
+			scope:  "user",
+			fields: "items.id",
+			data:   `{"items":[{"id":1},{"id":2}]}`,
+		},
+		{
+			name:   "whole field takes precedence over its subfields",
+			scope:  "user",
+			fields: "address.city,address",
+			data:   `{"address":{"city":"Berlin","zip":"10115"}}`,
+		},
+		{
+			name:   "missing fields are omitted",
+			scope:  "user",
+			fields: "name.first,unknown",
+			data:   `{}`,
+		},
+		{
+			name:   "dotted fields of a list",
+			scope:  "list",
+			fields: "name",
+			data:   `[{"name":"a"},{"name":"b"}]`,
+		},
+		{
+			name:   "dotted fields of a non-object value",
+			scope:  "str",
+			fields: "name",
+			data:   `{}`,
+		},
+		{
+			name:   "JSONPath",
+			scope:  "user",
+			fields: "$.items[*].price",
+			data:   `[5,7]`,
+		},
+		{
+			name:   "JSONPath without matches",
+			scope:  "user",
+			fields: "$.unknown",
+			data:   `[]`,
+		},
+		{
+			name:   "JSONPath filter on a list",
+			scope:  "list",
+			fields: "$[?(@.id > 1)].name",
+			data:   `["b"]`,
+		},
+		{
+			name:     "projection after the multi-scope merge",
+			scope:    "user,admin",
+			strategy: ptr.String("deep"),
+			fields:   "role,address.city",
+			data:     `{"role":"admin","address":{"city":"Bonn"}}`,
+		},
+		{
+			name:     "JSONPath after the multi-scope merge",
+			scope:    "admin,user",
+			strategy: ptr.String("scoped"),
+			fields:   "$.user.address.city",
+			data:     `["Berlin"]`,
+		},
+		{
+			name:    "empty expression",
+			scope:   "user",
+			fields:  " ",
+			errkind: errors.BadRequest,
+			errtext: "invalid fields: empty expression",
+		},
+		{
+			name:    "empty field name",
+			scope:   "user",
+			fields:  "name,,address",
+			errkind: errors.BadRequest,
+			errtext: `invalid fields: field path "" has an empty name`,
+		},
+		{
+			name:    "invalid JSONPath",
+			scope:   "user",
+			fields:  "$.items[",
+			errkind: errors.BadRequest,
+			errtext: `invalid fields: JSONPath expression "$.items["`,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			get := func(ctx context.Context, key string) ([]byte, error) {
+				if v, ok := stored[key]; ok {
+					return []byte(v), nil
+				}
+				return nil, errors.New(errors.NotFound)
+			}
+			fake := &cachefakes.FakeCache{GetStub: get, GetManyStub: getMany(get)}
+			svc := cache.New(fake, nil, zap.NewNop())
+
+			res, err := svc.Get(context.Background(), &goacache.CacheGetRequest{
+				Key:       "key",
+				Namespace: ptr.String("namespace"),
+				Scope:     &test.scope,
+				Strategy:  test.strategy,
+				Fields:    &test.fields,
+			})
+			if test.errtext != "" {
+				assert.Nil(t, res)
+				assert.Error(t, err)
+				assert.Contains(t, err.Error(), test.errtext)
+				e, ok := err.(*errors.Error)
+				assert.True(t, ok)
+				assert.Equal(t, test.errkind, e.Kind)
+				// invalid expressions are rejected before reading
+				assert.Equal(t, 0, fake.GetCallCount())
+				assert.Equal(t, 0, fake.GetManyCallCount())
+				return
+			}
+
+			assert.NoError(t, err)
+			data, err := json.Marshal(res.Data)
+			assert.NoError(t, err)
+			assert.JSONEq(t, test.data, string(data))
 		})
 	}
 }
@@ -0,0 +1,39 @@
+package cache
+
+import (
+	"time"
+
+	"github.com/eclipse-xfsc/redis-cache-service/gen/cache"
+)
+
+// WithSlidingTTL enables sliding expiration for the namespaces of the map.
+// Entries of these namespaces get their TTL reset to the mapped duration
+// on every successful Get.
+func WithSlidingTTL(ttls map[string]time.Duration) Option {
+	return func(s *Service) {
+		s.slidingTTL = ttls
+	}
+}
+
+// touchTTL returns the TTL to which entries read by the request are reset.
+// The x-cache-touch header takes precedence over the namespace default,
+// and both are limited by the maximum TTL of the namespace policy.
+// Zero means the TTL is left unchanged.
+func (s *Service) touchTTL(req *cache.CacheGetRequest) time.Duration {
+	var ttl time.Duration
+	if req.Touch != nil {
+		ttl = time.Duration(*req.Touch) * time.Second
+	} else if req.Namespace != nil {
+		ttl = s.slidingTTL[*req.Namespace]
+	} else {
+		ttl = s.slidingTTL[""]
+	}
+	if ttl <= 0 {
+		return 0
+	}
+
+	if policy, ok := s.ttlPolicy(req.Namespace); ok && policy.MaxTTL > 0 && ttl > policy.MaxTTL {
+		return policy.MaxTTL
+	}
+	return ttl
+}
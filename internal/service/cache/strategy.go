@@ -0,0 +1,182 @@
+package cache
+
+import (
+	"encoding/json"
+	"strings"
+
+	errors "github.com/eclipse-xfsc/microservice-core-go/pkg/err"
+)
+
+// Flatten strategies of values of multiple scopes.
+const (
+	// strategyMerge flattens the top-level keys and renames
+	// colliding keys to key_1, key_2, ...
+	strategyMerge = "merge"
+	// strategyFirst flattens the top-level keys and keeps
+	// the value of the first scope of colliding keys.
+	strategyFirst = "first"
+	// strategyLast flattens the top-level keys and keeps
+	// the value of the last scope of colliding keys.
+	strategyLast = "last"
+	// strategyDeep recursively merges objects in scope order.
+	strategyDeep = "deep"
+	// strategyScoped returns the values by scope without flattening.
+	strategyScoped = "scoped"
+)
+
+// Array merges of the deep strategy.
+const (
+	arrayMergeConcat = "concat"
+	arrayMergeDedupe = "dedupe"
+)
+
+// scopeValue is the decoded value of a scope.
+type scopeValue struct {
+	scope string
+	value interface{}
+}
+
+// orderScopes returns the trimmed scopes in the order of their precedence.
+// The precedence is the order of the scopes in the request, unless scopes
+// are given priority: these come first in the order of the priority and
+// are followed by the remaining scopes in request order. Scopes which are
+// requested more than once only count with their first occurrence, and
+// prioritized scopes which are not requested are ignored. Within a scope
+// the objects of an array keep their order.
+//
+// The first strategy keeps the value of the scope with the highest
+// precedence, last the one with the lowest. merge numbers colliding keys
+// and deep merges values in this order.
+func orderScopes(scopes []string, priority *string) []string {
+	requested := make(map[string]bool, len(scopes))
+	var ordered []string
+	for _, scope := range scopes {
+		scope = strings.TrimSpace(scope)
+		if !requested[scope] {
+			requested[scope] = true
+			ordered = append(ordered, scope)
+		}
+	}
+	if priority == nil {
+		return ordered
+	}
+
+	result := make([]string, 0, len(ordered))
+	added := make(map[string]bool, len(ordered))
+	for _, scope := range strings.Split(*priority, ",") {
+		scope = strings.TrimSpace(scope)
+		if requested[scope] && !added[scope] {
+			added[scope] = true
+			result = append(result, scope)
+		}
+	}
+	for _, scope := range ordered {
+		if !added[scope] {
+			result = append(result, scope)
+		}
+	}
+	return result
+}
+
+func validateStrategy(strategy, arrays *string) error {
+	if strategy != nil {
+		switch *strategy {
+		case strategyMerge, strategyFirst, strategyLast, strategyDeep, strategyScoped:
+		default:
+			return errors.New(errors.BadRequest, "unknown flatten strategy: "+*strategy)
+		}
+	}
+	if arrays != nil && *arrays != arrayMergeConcat && *arrays != arrayMergeDedupe {
+		return errors.New(errors.BadRequest, "unknown array merge: "+*arrays)
+	}
+	return nil
+}
+
+// scopedValues returns the values by their scope.
+func scopedValues(values []scopeValue) map[string]interface{} {
+	result := make(map[string]interface{}, len(values))
+	for _, v := range values {
+		result[v.scope] = v.value
+	}
+	return result
+}
+
+// deepMergeAll merges the objects in order, so values
+// of later objects take precedence over earlier ones.
+func deepMergeAll(objects []map[string]interface{}, dedupe bool) interface{} {
+	var result interface{} = map[string]interface{}{}
+	for _, object := range objects {
+		result = deepMerge(result, object, dedupe)
+	}
+	return result
+}
+
+// deepMerge merges src into dst. Objects are merged recursively and arrays are
+// concatenated, without duplicates if dedupe is set. Otherwise src replaces dst.
+// The merged objects and arrays are copies, dst and src are not modified.
+func deepMerge(dst, src interface{}, dedupe bool) interface{} {
+	switch s := src.(type) {
+	case map[string]interface{}:
+		d, _ := dst.(map[string]interface{})
+		merged := make(map[string]interface{}, len(d)+len(s))
+		for k, v := range d {
+			merged[k] = v
+		}
+		for k, v := range s {
+			merged[k] = deepMerge(merged[k], v, dedupe)
+		}
+		return merged
+
+	case []interface{}:
+		d, _ := dst.([]interface{})
+		merged := make([]interface{}, 0, len(d)+len(s))
+		merged = append(merged, d...)
+		for _, v := range s {
+			merged = append(merged, deepMerge(nil, v, dedupe))
+		}
+		if dedupe {
+			return dedupeValues(merged)
+		}
+		return merged
+	}
+	return src
+}
+
+// dedupeValues removes later duplicates of JSON values from the array.
+func dedupeValues(values []interface{}) []interface{} {
+	seen := make(map[string]bool, len(values))
+	result := values[:0]
+	for _, v := range values {
+		// maps are encoded with sorted keys, so equal values have equal encodings
+		b, err := json.Marshal(v)
+		if err != nil {
+			result = append(result, v)
+			continue
+		}
+		if seen[string(b)] {
+			continue
+		}
+		seen[string(b)] = true
+		result = append(result, v)
+	}
+	return result
+}
+
+// objects returns the objects which are flattened by the merge, first, last
+// and deep strategies in scope order. Arrays of objects contribute all their
+// objects and other values are wrapped in an object with the scope as key,
+// e.g. the string "x" of the scope "user" as {"user": "x"}.
+func objects(values []scopeValue) []map[string]interface{} {
+	var result []map[string]interface{}
+	for _, v := range values {
+		switch d := v.value.(type) {
+		case map[string]interface{}:
+			result = append(result, d)
+		case []map[string]interface{}:
+			result = append(result, d...)
+		default:
+			result = append(result, map[string]interface{}{v.scope: d})
+		}
+	}
+	return result
+}
@@ -0,0 +1,57 @@
+package cache
+
+import (
+	"context"
+	"strings"
+
+	"go.uber.org/zap"
+
+	errors "github.com/eclipse-xfsc/microservice-core-go/pkg/err"
+	"github.com/eclipse-xfsc/redis-cache-service/gen/cache"
+)
+
+// maxTags is the maximum number of tags of a cache entry.
+const maxTags = 32
+
+// DeleteTag deletes all entries which have been set with the tag.
+func (s *Service) DeleteTag(ctx context.Context, req *cache.CacheDeleteTagRequest) (*cache.CacheDeleteTagResult, error) {
+	logger := s.logger.With(zap.String("operation", "deleteTag"))
+
+	tag := strings.TrimSpace(req.Tag)
+	if tag == "" {
+		logger.Error("bad request: missing tag")
+		return nil, errors.New(errors.BadRequest, "missing tag")
+	}
+
+	deleted, err := s.cache.InvalidateTag(ctx, tag)
+	if err != nil {
+		logger.Error("error deleting tagged entries from cache", zap.Error(err))
+		return nil, errors.New("error deleting tagged entries from cache", err)
+	}
+
+	return &cache.CacheDeleteTagResult{Deleted: deleted}, nil
+}
+
+// parseTags splits the comma separated tags of a set request.
+// Surrounding whitespace, empty and duplicate tags are removed.
+func parseTags(header *string) ([]string, error) {
+	if header == nil {
+		return nil, nil
+	}
+
+	var tags []string
+	seen := map[string]bool{}
+	for _, tag := range strings.Split(*header, ",") {
+		tag = strings.TrimSpace(tag)
+		if tag == "" || seen[tag] {
+			continue
+		}
+		seen[tag] = true
+		tags = append(tags, tag)
+	}
+
+	if len(tags) > maxTags {
+		return nil, errors.New(errors.BadRequest, "too many tags")
+	}
+	return tags, nil
+}
@@ -2,30 +2,117 @@ package health
 
 import (
 	"context"
+	"time"
+
+	"go.uber.org/zap"
 
 	"github.com/eclipse-xfsc/redis-cache-service/gen/health"
 )
 
+//go:generate counterfeiter . Checker
+
+const (
+	statusUp   = "up"
+	statusDown = "down"
+
+	// checkTimeout limits the time a single dependency check may take.
+	checkTimeout = 3 * time.Second
+)
+
+// Checker verifies that a service dependency is available.
+type Checker interface {
+	Check(ctx context.Context) error
+}
+
+// CheckerFunc adapts an ordinary function to the Checker interface.
+type CheckerFunc func(ctx context.Context) error
+
+// Check calls f(ctx).
+func (f CheckerFunc) Check(ctx context.Context) error {
+	return f(ctx)
+}
+
 type Service struct {
-	version string
+	version  string
+	checkers map[string]Checker
+	optional map[string]Checker
+	logger   *zap.Logger
 }
 
-func New(version string) *Service {
-	return &Service{version: version}
+// Option configures optional behaviour of the Service.
+type Option func(*Service)
+
+// WithOptionalCheckers adds dependency checkers, identified by name, whose
+// status is reported by Readiness without failing it. They are meant for
+// dependencies which the service can do without for a while.
+func WithOptionalCheckers(checkers map[string]Checker) Option {
+	return func(s *Service) {
+		s.optional = checkers
+	}
+}
+
+// New creates a health service. The readiness of the service is
+// determined by the given dependency checkers, identified by name.
+func New(version string, checkers map[string]Checker, logger *zap.Logger, opts ...Option) *Service {
+	s := &Service{
+		version:  version,
+		checkers: checkers,
+		logger:   logger,
+	}
+	for _, opt := range opts {
+		opt(s)
+	}
+	return s
 }
 
 func (s *Service) Liveness(_ context.Context) (*health.HealthResponse, error) {
 	return &health.HealthResponse{
 		Service: "cache",
-		Status:  "up",
+		Status:  statusUp,
 		Version: s.version,
 	}, nil
 }
 
-func (s *Service) Readiness(_ context.Context) (*health.HealthResponse, error) {
-	return &health.HealthResponse{
+// Readiness checks all service dependencies and returns a not_ready
+// error with the status of each one if any of them fails. Failed
+// optional dependencies are only reported.
+func (s *Service) Readiness(ctx context.Context) (*health.HealthResponse, error) {
+	res := &health.HealthResponse{
 		Service: "cache",
-		Status:  "up",
+		Status:  statusUp,
 		Version: s.version,
-	}, nil
+	}
+
+	if len(s.checkers) == 0 && len(s.optional) == 0 {
+		return res, nil
+	}
+
+	res.Checks = make(map[string]string, len(s.checkers)+len(s.optional))
+	for name, checker := range s.checkers {
+		res.Checks[name] = statusUp
+		if err := s.check(ctx, checker); err != nil {
+			s.logger.Error("dependency check failed", zap.String("dependency", name), zap.Error(err))
+			res.Checks[name] = statusDown
+			res.Status = statusDown
+		}
+	}
+	for name, checker := range s.optional {
+		res.Checks[name] = statusUp
+		if err := s.check(ctx, checker); err != nil {
+			s.logger.Warn("optional dependency check failed", zap.String("dependency", name), zap.Error(err))
+			res.Checks[name] = statusDown
+		}
+	}
+
+	if res.Status != statusUp {
+		return nil, res
+	}
+
+	return res, nil
+}
+
+func (s *Service) check(ctx context.Context, checker Checker) error {
+	ctx, cancel := context.WithTimeout(ctx, checkTimeout)
+	defer cancel()
+	return checker.Check(ctx)
 }
@@ -0,0 +1,105 @@
+package health_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/zap"
+
+	goahealth "github.com/eclipse-xfsc/redis-cache-service/gen/health"
+	"github.com/eclipse-xfsc/redis-cache-service/internal/service/health"
+	"github.com/eclipse-xfsc/redis-cache-service/internal/service/health/healthfakes"
+)
+
+func TestNew(t *testing.T) {
+	svc := health.New("1.0.0", nil, zap.NewNop())
+	assert.Implements(t, (*goahealth.Service)(nil), svc)
+}
+
+func TestService_Readiness(t *testing.T) {
+	up := &healthfakes.FakeChecker{}
+	down := &healthfakes.FakeChecker{CheckStub: func(ctx context.Context) error {
+		return fmt.Errorf("connection refused")
+	}}
+
+	tests := []struct {
+		name     string
+		checkers map[string]health.Checker
+		optional map[string]health.Checker
+
+		res     *goahealth.HealthResponse
+		errtext string
+	}{
+		{
+			name: "no dependency checkers",
+			res: &goahealth.HealthResponse{
+				Service: "cache",
+				Status:  "up",
+				Version: "1.0.0",
+			},
+		},
+		{
+			name:     "all dependencies are up",
+			checkers: map[string]health.Checker{"redis": up, "nats": up},
+			res: &goahealth.HealthResponse{
+				Service: "cache",
+				Status:  "up",
+				Version: "1.0.0",
+				Checks:  map[string]string{"redis": "up", "nats": "up"},
+			},
+		},
+		{
+			name:     "one dependency is down",
+			checkers: map[string]health.Checker{"redis": down, "nats": up},
+			res: &goahealth.HealthResponse{
+				Service: "cache",
+				Status:  "down",
+				Version: "1.0.0",
+				Checks:  map[string]string{"redis": "down", "nats": "up"},
+			},
+			errtext: "not_ready",
+		},
+		{
+			name:     "optional dependency is down",
+			checkers: map[string]health.Checker{"redis": up},
+			optional: map[string]health.Checker{"nats": down},
+			res: &goahealth.HealthResponse{
+				Service: "cache",
+				Status:  "up",
+				Version: "1.0.0",
+				Checks:  map[string]string{"redis": "up", "nats": "down"},
+			},
+		},
+		{
+			name:     "dependency is down with optional one up",
+			checkers: map[string]health.Checker{"redis": down},
+			optional: map[string]health.Checker{"nats": up},
+			res: &goahealth.HealthResponse{
+				Service: "cache",
+				Status:  "down",
+				Version: "1.0.0",
+				Checks:  map[string]string{"redis": "down", "nats": "up"},
+			},
+			errtext: "not_ready",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			svc := health.New("1.0.0", test.checkers, zap.NewNop(), health.WithOptionalCheckers(test.optional))
+			res, err := svc.Readiness(context.Background())
+			if test.errtext == "" {
+				assert.NoError(t, err)
+				assert.Equal(t, test.res, res)
+			} else {
+				assert.Nil(t, res)
+				e, ok := err.(*goahealth.HealthResponse)
+				assert.True(t, ok)
+				assert.Equal(t, test.errtext, e.GoaErrorName())
+				assert.Equal(t, test.res, e)
+			}
+		})
+	}
+}
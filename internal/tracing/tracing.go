@@ -0,0 +1,108 @@
+// Package tracing configures OpenTelemetry tracing of the cache service.
+package tracing
+
+import (
+	"context"
+	"fmt"
+	"os"
+
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/exporters/otlp/otlptrace/otlptracehttp"
+	"go.opentelemetry.io/otel/exporters/stdout/stdouttrace"
+	"go.opentelemetry.io/otel/propagation"
+	"go.opentelemetry.io/otel/sdk/resource"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	semconv "go.opentelemetry.io/otel/semconv/v1.26.0"
+	"go.opentelemetry.io/otel/trace"
+	goa "goa.design/goa/v3/pkg"
+)
+
+const (
+	// ExporterNone disables exporting of spans.
+	ExporterNone = "none"
+	// ExporterOTLP exports spans to an OTLP collector over HTTP.
+	ExporterOTLP = "otlp"
+	// ExporterStdout writes spans to stdout and is meant for local testing.
+	ExporterStdout = "stdout"
+)
+
+// ShutdownFunc flushes and stops the exporting of spans.
+type ShutdownFunc func(ctx context.Context) error
+
+// New configures the global tracer provider with the given exporter and the
+// global propagator with W3C trace context and baggage. The propagator is set
+// even if spans are not exported, so incoming trace context is forwarded.
+//
+// The OTLP exporter sends spans to endpoint (host:port). If endpoint is empty,
+// the standard OTEL_EXPORTER_OTLP_* environment variables are used.
+func New(ctx context.Context, exporter, endpoint string, insecure bool, sampleRatio float64, version string) (ShutdownFunc, error) {
+	otel.SetTextMapPropagator(propagation.NewCompositeTextMapPropagator(
+		propagation.TraceContext{},
+		propagation.Baggage{},
+	))
+
+	var spanExporter sdktrace.SpanExporter
+	switch exporter {
+	case "", ExporterNone:
+		return func(context.Context) error { return nil }, nil
+	case ExporterOTLP:
+		var opts []otlptracehttp.Option
+		if endpoint != "" {
+			opts = append(opts, otlptracehttp.WithEndpoint(endpoint))
+		}
+		if insecure {
+			opts = append(opts, otlptracehttp.WithInsecure())
+		}
+		exp, err := otlptracehttp.New(ctx, opts...)
+		if err != nil {
+			return nil, fmt.Errorf("cannot create otlp exporter: %w", err)
+		}
+		spanExporter = exp
+	case ExporterStdout:
+		exp, err := stdouttrace.New(stdouttrace.WithWriter(os.Stdout))
+		if err != nil {
+			return nil, fmt.Errorf("cannot create stdout exporter: %w", err)
+		}
+		spanExporter = exp
+	default:
+		return nil, fmt.Errorf("unknown tracing exporter: %q", exporter)
+	}
+
+	res, err := resource.Merge(resource.Default(), resource.NewWithAttributes(
+		semconv.SchemaURL,
+		semconv.ServiceName("cache"),
+		semconv.ServiceVersion(version),
+	))
+	if err != nil {
+		return nil, fmt.Errorf("cannot create tracing resource: %w", err)
+	}
+
+	provider := sdktrace.NewTracerProvider(
+		sdktrace.WithBatcher(spanExporter),
+		sdktrace.WithResource(res),
+		sdktrace.WithSampler(sdktrace.ParentBased(sdktrace.TraceIDRatioBased(sampleRatio))),
+	)
+	otel.SetTracerProvider(provider)
+
+	return provider.Shutdown, nil
+}
+
+// Endpoint is a goa endpoint middleware which names the current HTTP server
+// span after the invoked service method, e.g. "cache.Get", instead of the
+// request path which may contain user input.
+func Endpoint(e goa.Endpoint) goa.Endpoint {
+	return func(ctx context.Context, req interface{}) (interface{}, error) {
+		svc, _ := ctx.Value(goa.ServiceKey).(string)
+		method, _ := ctx.Value(goa.MethodKey).(string)
+
+		span := trace.SpanFromContext(ctx)
+		span.SetName(svc + "." + method)
+		span.SetAttributes(
+			attribute.String("rpc.service", svc),
+			attribute.String("rpc.method", method),
+		)
+
+		return e(ctx, req)
+	}
+}
@@ -0,0 +1,115 @@
+// Code generated by counterfeiter. DO NOT EDIT.
+package expiryfakes
+
+import (
+	"context"
+	"sync"
+
+	"github.com/eclipse-xfsc/redis-cache-service/internal/clients/event"
+	"github.com/eclipse-xfsc/redis-cache-service/internal/worker/expiry"
+)
+
+type FakeEvents struct {
+	SendExpiredStub        func(context.Context, event.Entry) error
+	sendExpiredMutex       sync.RWMutex
+	sendExpiredArgsForCall []struct {
+		arg1 context.Context
+		arg2 event.Entry
+	}
+	sendExpiredReturns struct {
+		result1 error
+	}
+	sendExpiredReturnsOnCall map[int]struct {
+		result1 error
+	}
+	invocations      map[string][][]interface{}
+	invocationsMutex sync.RWMutex
+}
+
+func (fake *FakeEvents) SendExpired(arg1 context.Context, arg2 event.Entry) error {
+	fake.sendExpiredMutex.Lock()
+	ret, specificReturn := fake.sendExpiredReturnsOnCall[len(fake.sendExpiredArgsForCall)]
+	fake.sendExpiredArgsForCall = append(fake.sendExpiredArgsForCall, struct {
+		arg1 context.Context
+		arg2 event.Entry
+	}{arg1, arg2})
+	stub := fake.SendExpiredStub
+	fakeReturns := fake.sendExpiredReturns
+	fake.recordInvocation("SendExpired", []interface{}{arg1, arg2})
+	fake.sendExpiredMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *FakeEvents) SendExpiredCallCount() int {
+	fake.sendExpiredMutex.RLock()
+	defer fake.sendExpiredMutex.RUnlock()
+	return len(fake.sendExpiredArgsForCall)
+}
+
+func (fake *FakeEvents) SendExpiredCalls(stub func(context.Context, event.Entry) error) {
+	fake.sendExpiredMutex.Lock()
+	defer fake.sendExpiredMutex.Unlock()
+	fake.SendExpiredStub = stub
+}
+
+func (fake *FakeEvents) SendExpiredArgsForCall(i int) (context.Context, event.Entry) {
+	fake.sendExpiredMutex.RLock()
+	defer fake.sendExpiredMutex.RUnlock()
+	argsForCall := fake.sendExpiredArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeEvents) SendExpiredReturns(result1 error) {
+	fake.sendExpiredMutex.Lock()
+	defer fake.sendExpiredMutex.Unlock()
+	fake.SendExpiredStub = nil
+	fake.sendExpiredReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeEvents) SendExpiredReturnsOnCall(i int, result1 error) {
+	fake.sendExpiredMutex.Lock()
+	defer fake.sendExpiredMutex.Unlock()
+	fake.SendExpiredStub = nil
+	if fake.sendExpiredReturnsOnCall == nil {
+		fake.sendExpiredReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.sendExpiredReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeEvents) Invocations() map[string][][]interface{} {
+	fake.invocationsMutex.RLock()
+	defer fake.invocationsMutex.RUnlock()
+	fake.sendExpiredMutex.RLock()
+	defer fake.sendExpiredMutex.RUnlock()
+	copiedInvocations := map[string][][]interface{}{}
+	for key, value := range fake.invocations {
+		copiedInvocations[key] = value
+	}
+	return copiedInvocations
+}
+
+func (fake *FakeEvents) recordInvocation(key string, args []interface{}) {
+	fake.invocationsMutex.Lock()
+	defer fake.invocationsMutex.Unlock()
+	if fake.invocations == nil {
+		fake.invocations = map[string][][]interface{}{}
+	}
+	if fake.invocations[key] == nil {
+		fake.invocations[key] = [][]interface{}{}
+	}
+	fake.invocations[key] = append(fake.invocations[key], args)
+}
+
+var _ expiry.Events = new(FakeEvents)
@@ -0,0 +1,274 @@
+// Code generated by counterfeiter. DO NOT EDIT.
+package expiryfakes
+
+import (
+	"context"
+	"sync"
+
+	"github.com/eclipse-xfsc/redis-cache-service/internal/worker/expiry"
+)
+
+type FakeNotifications struct {
+	ClaimExpiredStub        func(context.Context, string) (bool, error)
+	claimExpiredMutex       sync.RWMutex
+	claimExpiredArgsForCall []struct {
+		arg1 context.Context
+		arg2 string
+	}
+	claimExpiredReturns struct {
+		result1 bool
+		result2 error
+	}
+	claimExpiredReturnsOnCall map[int]struct {
+		result1 bool
+		result2 error
+	}
+	ExpiredNotificationsEnabledStub        func(context.Context) (bool, error)
+	expiredNotificationsEnabledMutex       sync.RWMutex
+	expiredNotificationsEnabledArgsForCall []struct {
+		arg1 context.Context
+	}
+	expiredNotificationsEnabledReturns struct {
+		result1 bool
+		result2 error
+	}
+	expiredNotificationsEnabledReturnsOnCall map[int]struct {
+		result1 bool
+		result2 error
+	}
+	SubscribeExpiredStub        func(context.Context, func(ctx context.Context, key string)) error
+	subscribeExpiredMutex       sync.RWMutex
+	subscribeExpiredArgsForCall []struct {
+		arg1 context.Context
+		arg2 func(ctx context.Context, key string)
+	}
+	subscribeExpiredReturns struct {
+		result1 error
+	}
+	subscribeExpiredReturnsOnCall map[int]struct {
+		result1 error
+	}
+	invocations      map[string][][]interface{}
+	invocationsMutex sync.RWMutex
+}
+
+func (fake *FakeNotifications) ClaimExpired(arg1 context.Context, arg2 string) (bool, error) {
+	fake.claimExpiredMutex.Lock()
+	ret, specificReturn := fake.claimExpiredReturnsOnCall[len(fake.claimExpiredArgsForCall)]
+	fake.claimExpiredArgsForCall = append(fake.claimExpiredArgsForCall, struct {
+		arg1 context.Context
+		arg2 string
+	}{arg1, arg2})
+	stub := fake.ClaimExpiredStub
+	fakeReturns := fake.claimExpiredReturns
+	fake.recordInvocation("ClaimExpired", []interface{}{arg1, arg2})
+	fake.claimExpiredMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeNotifications) ClaimExpiredCallCount() int {
+	fake.claimExpiredMutex.RLock()
+	defer fake.claimExpiredMutex.RUnlock()
+	return len(fake.claimExpiredArgsForCall)
+}
+
+func (fake *FakeNotifications) ClaimExpiredCalls(stub func(context.Context, string) (bool, error)) {
+	fake.claimExpiredMutex.Lock()
+	defer fake.claimExpiredMutex.Unlock()
+	fake.ClaimExpiredStub = stub
+}
+
+func (fake *FakeNotifications) ClaimExpiredArgsForCall(i int) (context.Context, string) {
+	fake.claimExpiredMutex.RLock()
+	defer fake.claimExpiredMutex.RUnlock()
+	argsForCall := fake.claimExpiredArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeNotifications) ClaimExpiredReturns(result1 bool, result2 error) {
+	fake.claimExpiredMutex.Lock()
+	defer fake.claimExpiredMutex.Unlock()
+	fake.ClaimExpiredStub = nil
+	fake.claimExpiredReturns = struct {
+		result1 bool
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeNotifications) ClaimExpiredReturnsOnCall(i int, result1 bool, result2 error) {
+	fake.claimExpiredMutex.Lock()
+	defer fake.claimExpiredMutex.Unlock()
+	fake.ClaimExpiredStub = nil
+	if fake.claimExpiredReturnsOnCall == nil {
+		fake.claimExpiredReturnsOnCall = make(map[int]struct {
+			result1 bool
+			result2 error
+		})
+	}
+	fake.claimExpiredReturnsOnCall[i] = struct {
+		result1 bool
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeNotifications) ExpiredNotificationsEnabled(arg1 context.Context) (bool, error) {
+	fake.expiredNotificationsEnabledMutex.Lock()
+	ret, specificReturn := fake.expiredNotificationsEnabledReturnsOnCall[len(fake.expiredNotificationsEnabledArgsForCall)]
+	fake.expiredNotificationsEnabledArgsForCall = append(fake.expiredNotificationsEnabledArgsForCall, struct {
+		arg1 context.Context
+	}{arg1})
+	stub := fake.ExpiredNotificationsEnabledStub
+	fakeReturns := fake.expiredNotificationsEnabledReturns
+	fake.recordInvocation("ExpiredNotificationsEnabled", []interface{}{arg1})
+	fake.expiredNotificationsEnabledMutex.Unlock()
+	if stub != nil {
+		return stub(arg1)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeNotifications) ExpiredNotificationsEnabledCallCount() int {
+	fake.expiredNotificationsEnabledMutex.RLock()
+	defer fake.expiredNotificationsEnabledMutex.RUnlock()
+	return len(fake.expiredNotificationsEnabledArgsForCall)
+}
+
+func (fake *FakeNotifications) ExpiredNotificationsEnabledCalls(stub func(context.Context) (bool, error)) {
+	fake.expiredNotificationsEnabledMutex.Lock()
+	defer fake.expiredNotificationsEnabledMutex.Unlock()
+	fake.ExpiredNotificationsEnabledStub = stub
+}
+
+func (fake *FakeNotifications) ExpiredNotificationsEnabledArgsForCall(i int) context.Context {
+	fake.expiredNotificationsEnabledMutex.RLock()
+	defer fake.expiredNotificationsEnabledMutex.RUnlock()
+	argsForCall := fake.expiredNotificationsEnabledArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeNotifications) ExpiredNotificationsEnabledReturns(result1 bool, result2 error) {
+	fake.expiredNotificationsEnabledMutex.Lock()
+	defer fake.expiredNotificationsEnabledMutex.Unlock()
+	fake.ExpiredNotificationsEnabledStub = nil
+	fake.expiredNotificationsEnabledReturns = struct {
+		result1 bool
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeNotifications) ExpiredNotificationsEnabledReturnsOnCall(i int, result1 bool, result2 error) {
+	fake.expiredNotificationsEnabledMutex.Lock()
+	defer fake.expiredNotificationsEnabledMutex.Unlock()
+	fake.ExpiredNotificationsEnabledStub = nil
+	if fake.expiredNotificationsEnabledReturnsOnCall == nil {
+		fake.expiredNotificationsEnabledReturnsOnCall = make(map[int]struct {
+			result1 bool
+			result2 error
+		})
+	}
+	fake.expiredNotificationsEnabledReturnsOnCall[i] = struct {
+		result1 bool
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeNotifications) SubscribeExpired(arg1 context.Context, arg2 func(ctx context.Context, key string)) error {
+	fake.subscribeExpiredMutex.Lock()
+	ret, specificReturn := fake.subscribeExpiredReturnsOnCall[len(fake.subscribeExpiredArgsForCall)]
+	fake.subscribeExpiredArgsForCall = append(fake.subscribeExpiredArgsForCall, struct {
+		arg1 context.Context
+		arg2 func(ctx context.Context, key string)
+	}{arg1, arg2})
+	stub := fake.SubscribeExpiredStub
+	fakeReturns := fake.subscribeExpiredReturns
+	fake.recordInvocation("SubscribeExpired", []interface{}{arg1, arg2})
+	fake.subscribeExpiredMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *FakeNotifications) SubscribeExpiredCallCount() int {
+	fake.subscribeExpiredMutex.RLock()
+	defer fake.subscribeExpiredMutex.RUnlock()
+	return len(fake.subscribeExpiredArgsForCall)
+}
+
+func (fake *FakeNotifications) SubscribeExpiredCalls(stub func(context.Context, func(ctx context.Context, key string)) error) {
+	fake.subscribeExpiredMutex.Lock()
+	defer fake.subscribeExpiredMutex.Unlock()
+	fake.SubscribeExpiredStub = stub
+}
+
+func (fake *FakeNotifications) SubscribeExpiredArgsForCall(i int) (context.Context, func(ctx context.Context, key string)) {
+	fake.subscribeExpiredMutex.RLock()
+	defer fake.subscribeExpiredMutex.RUnlock()
+	argsForCall := fake.subscribeExpiredArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeNotifications) SubscribeExpiredReturns(result1 error) {
+	fake.subscribeExpiredMutex.Lock()
+	defer fake.subscribeExpiredMutex.Unlock()
+	fake.SubscribeExpiredStub = nil
+	fake.subscribeExpiredReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeNotifications) SubscribeExpiredReturnsOnCall(i int, result1 error) {
+	fake.subscribeExpiredMutex.Lock()
+	defer fake.subscribeExpiredMutex.Unlock()
+	fake.SubscribeExpiredStub = nil
+	if fake.subscribeExpiredReturnsOnCall == nil {
+		fake.subscribeExpiredReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.subscribeExpiredReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeNotifications) Invocations() map[string][][]interface{} {
+	fake.invocationsMutex.RLock()
+	defer fake.invocationsMutex.RUnlock()
+	fake.claimExpiredMutex.RLock()
+	defer fake.claimExpiredMutex.RUnlock()
+	fake.expiredNotificationsEnabledMutex.RLock()
+	defer fake.expiredNotificationsEnabledMutex.RUnlock()
+	fake.subscribeExpiredMutex.RLock()
+	defer fake.subscribeExpiredMutex.RUnlock()
+	copiedInvocations := map[string][][]interface{}{}
+	for key, value := range fake.invocations {
+		copiedInvocations[key] = value
+	}
+	return copiedInvocations
+}
+
+func (fake *FakeNotifications) recordInvocation(key string, args []interface{}) {
+	fake.invocationsMutex.Lock()
+	defer fake.invocationsMutex.Unlock()
+	if fake.invocations == nil {
+		fake.invocations = map[string][][]interface{}{}
+	}
+	if fake.invocations[key] == nil {
+		fake.invocations[key] = [][]interface{}{}
+	}
+	fake.invocations[key] = append(fake.invocations[key], args)
+}
+
+var _ expiry.Notifications = new(FakeNotifications)
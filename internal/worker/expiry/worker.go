@@ -0,0 +1,73 @@
+package expiry
+
+import (
+	"context"
+
+	"go.uber.org/zap"
+
+	"github.com/eclipse-xfsc/redis-cache-service/internal/clients/event"
+	"github.com/eclipse-xfsc/redis-cache-service/internal/service/cache"
+)
+
+//go:generate counterfeiter . Notifications
+//go:generate counterfeiter . Events
+
+// Notifications provides the keys of entries which expire in the cache.
+type Notifications interface {
+	SubscribeExpired(ctx context.Context, fn func(ctx context.Context, key string)) error
+	ExpiredNotificationsEnabled(ctx context.Context) (bool, error)
+	ClaimExpired(ctx context.Context, key string) (bool, error)
+}
+
+type Events interface {
+	SendExpired(ctx context.Context, entry event.Entry) error
+}
+
+// Worker publishes a cache_expired_event for every entry
+// which expires in the cache. Every instance receives the
+// notifications, so an event is only published by the
+// instance which claims it first.
+type Worker struct {
+	notifications Notifications
+	events        Events
+	logger        *zap.Logger
+}
+
+func New(notifications Notifications, events Events, logger *zap.Logger) *Worker {
+	return &Worker{
+		notifications: notifications,
+		events:        events,
+		logger:        logger.With(zap.String("worker", "expiry")),
+	}
+}
+
+// Run publishes the events until ctx is done. Events which cannot be
+// sent are logged and dropped, as the entries are already gone.
+func (w *Worker) Run(ctx context.Context) error {
+	// Redis doesn't publish notifications of expired keys by default,
+	// but they may be enabled later, so the worker keeps subscribed.
+	enabled, err := w.notifications.ExpiredNotificationsEnabled(ctx)
+	if err != nil {
+		w.logger.Warn("cannot verify that expired keyspace notifications are enabled", zap.Error(err))
+	} else if !enabled {
+		w.logger.Warn("expired keyspace notifications are disabled, set notify-keyspace-events to Ex to receive them")
+	}
+
+	w.logger.Info("start publishing events for expired entries")
+	return w.notifications.SubscribeExpired(ctx, func(ctx context.Context, storageKey string) {
+		claimed, err := w.notifications.ClaimExpired(ctx, storageKey)
+		if err != nil {
+			// a duplicate event is preferred over a lost one
+			w.logger.Warn("error claiming event for expired entry", zap.String("key", storageKey), zap.Error(err))
+		} else if !claimed {
+			return
+		}
+
+		// the value of an expired entry is gone, so the event has its key only
+		key, namespace, scope := cache.SplitKey(storageKey)
+		entry := event.Entry{Key: key, Namespace: namespace, Scope: scope}
+		if err := w.events.SendExpired(ctx, entry); err != nil {
+			w.logger.Error("error sending an event for expired entry", zap.String("key", storageKey), zap.Error(err))
+		}
+	})
+}
@@ -0,0 +1,83 @@
+package expiry_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/zap"
+
+	"github.com/eclipse-xfsc/redis-cache-service/internal/clients/event"
+	"github.com/eclipse-xfsc/redis-cache-service/internal/worker/expiry"
+	"github.com/eclipse-xfsc/redis-cache-service/internal/worker/expiry/expiryfakes"
+)
+
+func TestWorker_Run(t *testing.T) {
+	tests := []struct {
+		name    string
+		enabled bool
+		err     error
+	}{
+		{name: "notifications enabled", enabled: true},
+		{name: "notifications disabled", enabled: false},
+		{name: "notification settings cannot be read", err: fmt.Errorf("unknown command 'CONFIG'")},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			notifications := &expiryfakes.FakeNotifications{
+				ExpiredNotificationsEnabledStub: func(ctx context.Context) (bool, error) {
+					return test.enabled, test.err
+				},
+				SubscribeExpiredStub: func(ctx context.Context, fn func(ctx context.Context, key string)) error {
+					fn(ctx, "key1,namespace,scope")
+					fn(ctx, "v2:namespace:scope:key2")
+					fn(ctx, "key3,namespace,scope")
+					return nil
+				},
+				ClaimExpiredStub: func(ctx context.Context, key string) (bool, error) {
+					switch key {
+					case "key1,namespace,scope":
+						return false, fmt.Errorf("connection refused")
+					case "key3,namespace,scope":
+						// claimed by another instance
+						return false, nil
+					}
+					return true, nil
+				},
+			}
+			events := &expiryfakes.FakeEvents{SendExpiredStub: func(ctx context.Context, entry event.Entry) error {
+				if entry.Key == "key1" {
+					return fmt.Errorf("failed to send event")
+				}
+				return nil
+			}}
+
+			err := expiry.New(notifications, events, zap.NewNop()).Run(context.Background())
+			assert.NoError(t, err)
+
+			// the worker keeps subscribed if notifications seem to be disabled
+			// and continues after events which cannot be sent. Events which
+			// cannot be claimed are sent, events claimed by another instance not.
+			assert.Equal(t, 1, notifications.SubscribeExpiredCallCount())
+			assert.Equal(t, 3, notifications.ClaimExpiredCallCount())
+			assert.Equal(t, 2, events.SendExpiredCallCount())
+			_, entry := events.SendExpiredArgsForCall(0)
+			assert.Equal(t, event.Entry{Key: "key1", Namespace: "namespace", Scope: "scope"}, entry)
+			_, entry = events.SendExpiredArgsForCall(1)
+			assert.Equal(t, event.Entry{Key: "key2", Namespace: "namespace", Scope: "scope"}, entry)
+		})
+	}
+}
+
+func TestWorker_RunError(t *testing.T) {
+	notifications := &expiryfakes.FakeNotifications{
+		SubscribeExpiredStub: func(ctx context.Context, fn func(ctx context.Context, key string)) error {
+			return fmt.Errorf("connection refused")
+		},
+	}
+
+	err := expiry.New(notifications, &expiryfakes.FakeEvents{}, zap.NewNop()).Run(context.Background())
+	assert.EqualError(t, err, "connection refused")
+}
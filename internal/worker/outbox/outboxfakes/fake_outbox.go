@@ -0,0 +1,200 @@
+// Code generated by counterfeiter. DO NOT EDIT.
+package outboxfakes
+
+import (
+	"context"
+	"sync"
+
+	"github.com/eclipse-xfsc/redis-cache-service/internal/worker/outbox"
+)
+
+type FakeOutbox struct {
+	OutboxBacklogStub        func(context.Context) (int64, error)
+	outboxBacklogMutex       sync.RWMutex
+	outboxBacklogArgsForCall []struct {
+		arg1 context.Context
+	}
+	outboxBacklogReturns struct {
+		result1 int64
+		result2 error
+	}
+	outboxBacklogReturnsOnCall map[int]struct {
+		result1 int64
+		result2 error
+	}
+	RelayOutboxStub        func(context.Context, bool, func(ctx context.Context, event []byte) error) (int, error)
+	relayOutboxMutex       sync.RWMutex
+	relayOutboxArgsForCall []struct {
+		arg1 context.Context
+		arg2 bool
+		arg3 func(ctx context.Context, event []byte) error
+	}
+	relayOutboxReturns struct {
+		result1 int
+		result2 error
+	}
+	relayOutboxReturnsOnCall map[int]struct {
+		result1 int
+		result2 error
+	}
+	invocations      map[string][][]interface{}
+	invocationsMutex sync.RWMutex
+}
+
+func (fake *FakeOutbox) OutboxBacklog(arg1 context.Context) (int64, error) {
+	fake.outboxBacklogMutex.Lock()
+	ret, specificReturn := fake.outboxBacklogReturnsOnCall[len(fake.outboxBacklogArgsForCall)]
+	fake.outboxBacklogArgsForCall = append(fake.outboxBacklogArgsForCall, struct {
+		arg1 context.Context
+	}{arg1})
+	stub := fake.OutboxBacklogStub
+	fakeReturns := fake.outboxBacklogReturns
+	fake.recordInvocation("OutboxBacklog", []interface{}{arg1})
+	fake.outboxBacklogMutex.Unlock()
+	if stub != nil {
+		return stub(arg1)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeOutbox) OutboxBacklogCallCount() int {
+	fake.outboxBacklogMutex.RLock()
+	defer fake.outboxBacklogMutex.RUnlock()
+	return len(fake.outboxBacklogArgsForCall)
+}
+
+func (fake *FakeOutbox) OutboxBacklogCalls(stub func(context.Context) (int64, error)) {
+	fake.outboxBacklogMutex.Lock()
+	defer fake.outboxBacklogMutex.Unlock()
+	fake.OutboxBacklogStub = stub
+}
+
+func (fake *FakeOutbox) OutboxBacklogArgsForCall(i int) context.Context {
+	fake.outboxBacklogMutex.RLock()
+	defer fake.outboxBacklogMutex.RUnlock()
+	argsForCall := fake.outboxBacklogArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeOutbox) OutboxBacklogReturns(result1 int64, result2 error) {
+	fake.outboxBacklogMutex.Lock()
+	defer fake.outboxBacklogMutex.Unlock()
+	fake.OutboxBacklogStub = nil
+	fake.outboxBacklogReturns = struct {
+		result1 int64
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeOutbox) OutboxBacklogReturnsOnCall(i int, result1 int64, result2 error) {
+	fake.outboxBacklogMutex.Lock()
+	defer fake.outboxBacklogMutex.Unlock()
+	fake.OutboxBacklogStub = nil
+	if fake.outboxBacklogReturnsOnCall == nil {
+		fake.outboxBacklogReturnsOnCall = make(map[int]struct {
+			result1 int64
+			result2 error
+		})
+	}
+	fake.outboxBacklogReturnsOnCall[i] = struct {
+		result1 int64
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeOutbox) RelayOutbox(arg1 context.Context, arg2 bool, arg3 func(ctx context.Context, event []byte) error) (int, error) {
+	fake.relayOutboxMutex.Lock()
+	ret, specificReturn := fake.relayOutboxReturnsOnCall[len(fake.relayOutboxArgsForCall)]
+	fake.relayOutboxArgsForCall = append(fake.relayOutboxArgsForCall, struct {
+		arg1 context.Context
+		arg2 bool
+		arg3 func(ctx context.Context, event []byte) error
+	}{arg1, arg2, arg3})
+	stub := fake.RelayOutboxStub
+	fakeReturns := fake.relayOutboxReturns
+	fake.recordInvocation("RelayOutbox", []interface{}{arg1, arg2, arg3})
+	fake.relayOutboxMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeOutbox) RelayOutboxCallCount() int {
+	fake.relayOutboxMutex.RLock()
+	defer fake.relayOutboxMutex.RUnlock()
+	return len(fake.relayOutboxArgsForCall)
+}
+
+func (fake *FakeOutbox) RelayOutboxCalls(stub func(context.Context, bool, func(ctx context.Context, event []byte) error) (int, error)) {
+	fake.relayOutboxMutex.Lock()
+	defer fake.relayOutboxMutex.Unlock()
+	fake.RelayOutboxStub = stub
+}
+
+func (fake *FakeOutbox) RelayOutboxArgsForCall(i int) (context.Context, bool, func(ctx context.Context, event []byte) error) {
+	fake.relayOutboxMutex.RLock()
+	defer fake.relayOutboxMutex.RUnlock()
+	argsForCall := fake.relayOutboxArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
+func (fake *FakeOutbox) RelayOutboxReturns(result1 int, result2 error) {
+	fake.relayOutboxMutex.Lock()
+	defer fake.relayOutboxMutex.Unlock()
+	fake.RelayOutboxStub = nil
+	fake.relayOutboxReturns = struct {
+		result1 int
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeOutbox) RelayOutboxReturnsOnCall(i int, result1 int, result2 error) {
+	fake.relayOutboxMutex.Lock()
+	defer fake.relayOutboxMutex.Unlock()
+	fake.RelayOutboxStub = nil
+	if fake.relayOutboxReturnsOnCall == nil {
+		fake.relayOutboxReturnsOnCall = make(map[int]struct {
+			result1 int
+			result2 error
+		})
+	}
+	fake.relayOutboxReturnsOnCall[i] = struct {
+		result1 int
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeOutbox) Invocations() map[string][][]interface{} {
+	fake.invocationsMutex.RLock()
+	defer fake.invocationsMutex.RUnlock()
+	fake.outboxBacklogMutex.RLock()
+	defer fake.outboxBacklogMutex.RUnlock()
+	fake.relayOutboxMutex.RLock()
+	defer fake.relayOutboxMutex.RUnlock()
+	copiedInvocations := map[string][][]interface{}{}
+	for key, value := range fake.invocations {
+		copiedInvocations[key] = value
+	}
+	return copiedInvocations
+}
+
+func (fake *FakeOutbox) recordInvocation(key string, args []interface{}) {
+	fake.invocationsMutex.Lock()
+	defer fake.invocationsMutex.Unlock()
+	if fake.invocations == nil {
+		fake.invocations = map[string][][]interface{}{}
+	}
+	if fake.invocations[key] == nil {
+		fake.invocations[key] = [][]interface{}{}
+	}
+	fake.invocations[key] = append(fake.invocations[key], args)
+}
+
+var _ outbox.Outbox = new(FakeOutbox)
@@ -0,0 +1,144 @@
+package outbox
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"go.uber.org/zap"
+
+	"github.com/eclipse-xfsc/redis-cache-service/internal/clients/event"
+	"github.com/eclipse-xfsc/redis-cache-service/internal/metrics"
+)
+
+//go:generate counterfeiter . Outbox
+//go:generate counterfeiter . Events
+
+// Outbox holds the events which are stored together with cache entries.
+type Outbox interface {
+	RelayOutbox(ctx context.Context, all bool, publish func(ctx context.Context, event []byte) error) (int, error)
+	OutboxBacklog(ctx context.Context) (int64, error)
+}
+
+type Events interface {
+	Publish(ctx context.Context, event []byte) error
+}
+
+// Config specifies how often the outbox is relayed.
+type Config struct {
+	// Interval is the time between two relays of the outbox.
+	Interval time.Duration
+	// SweepInterval is the time between two relays of all outbox
+	// streams, which also updates the backlog metric.
+	SweepInterval time.Duration
+	// MaxBackoff limits the time between relays after errors.
+	MaxBackoff time.Duration
+}
+
+const (
+	defaultInterval      = time.Second
+	defaultSweepInterval = 30 * time.Second
+	defaultMaxBackoff    = time.Minute
+)
+
+// Relay publishes the events of the outbox in the background.
+type Relay struct {
+	outbox Outbox
+	events Events
+	config Config
+	logger *zap.Logger
+}
+
+func New(outbox Outbox, events Events, config Config, logger *zap.Logger) *Relay {
+	if config.Interval <= 0 {
+		config.Interval = defaultInterval
+	}
+	if config.SweepInterval <= 0 {
+		config.SweepInterval = defaultSweepInterval
+	}
+	if config.MaxBackoff < config.Interval {
+		config.MaxBackoff = max(defaultMaxBackoff, config.Interval)
+	}
+
+	return &Relay{
+		outbox: outbox,
+		events: events,
+		config: config,
+		logger: logger.With(zap.String("worker", "outbox")),
+	}
+}
+
+// Run relays the outbox until ctx is done. After an error the relay is
+// retried with exponential backoff up to the configured maximum.
+func (r *Relay) Run(ctx context.Context) error {
+	r.logger.Info("start relaying events from the outbox")
+
+	var lastSweep time.Time
+	failures := 0
+	for {
+		// a failed sweep is repeated with the next relay
+		sweep := time.Since(lastSweep) >= r.config.SweepInterval
+		start := time.Now()
+
+		delay := r.config.Interval
+		if err := r.relay(ctx, sweep); err != nil {
+			if ctx.Err() != nil {
+				return nil
+			}
+			failures++
+			delay = r.backoff(failures)
+			r.logger.Warn("error relaying events from the outbox", zap.Int("failures", failures), zap.Duration("retryIn", delay), zap.Error(err))
+		} else {
+			failures = 0
+			if sweep {
+				lastSweep = start
+			}
+		}
+
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-time.After(delay):
+		}
+	}
+}
+
+// relay publishes the pending events once, of all streams if sweep is true.
+func (r *Relay) relay(ctx context.Context, sweep bool) error {
+	relayed, err := r.outbox.RelayOutbox(ctx, sweep, r.publish)
+	if relayed > 0 {
+		r.logger.Debug("relayed events from the outbox", zap.Int("events", relayed))
+	}
+	if err != nil {
+		return err
+	}
+
+	if sweep {
+		backlog, err := r.outbox.OutboxBacklog(ctx)
+		if err != nil {
+			return err
+		}
+		metrics.SetOutboxBacklog(backlog)
+	}
+	return nil
+}
+
+// publish publishes an event of the outbox. Malformed events can never
+// be published, so they are logged and dropped to not block the outbox.
+func (r *Relay) publish(ctx context.Context, encoded []byte) error {
+	err := r.events.Publish(ctx, encoded)
+	if errors.Is(err, event.ErrMalformedEvent) {
+		r.logger.Error("dropping malformed event from the outbox", zap.ByteString("event", encoded), zap.Error(err))
+		return nil
+	}
+	return err
+}
+
+// backoff returns the delay after the given number of consecutive failures.
+func (r *Relay) backoff(failures int) time.Duration {
+	delay := r.config.Interval
+	for i := 1; i < failures && delay < r.config.MaxBackoff; i++ {
+		delay *= 2
+	}
+	return min(delay, r.config.MaxBackoff)
+}
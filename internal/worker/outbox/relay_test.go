@@ -0,0 +1,91 @@
+package outbox_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/zap"
+
+	"github.com/eclipse-xfsc/redis-cache-service/internal/clients/event"
+	"github.com/eclipse-xfsc/redis-cache-service/internal/worker/outbox"
+	"github.com/eclipse-xfsc/redis-cache-service/internal/worker/outbox/outboxfakes"
+)
+
+func TestRelay_Run(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	var published []string
+	events := &outboxfakes.FakeEvents{PublishStub: func(ctx context.Context, encoded []byte) error {
+		switch string(encoded) {
+		case "malformed":
+			return fmt.Errorf("%w: unexpected end of JSON input", event.ErrMalformedEvent)
+		case "unavailable":
+			return fmt.Errorf("nats: no responders available for request")
+		}
+		published = append(published, string(encoded))
+		return nil
+	}}
+
+	calls := 0
+	store := &outboxfakes.FakeOutbox{
+		RelayOutboxStub: func(ctx context.Context, all bool, publish func(ctx context.Context, event []byte) error) (int, error) {
+			calls++
+			switch calls {
+			case 1:
+				// the relay is retried after the publish error
+				return 0, publish(ctx, []byte("unavailable"))
+			case 2:
+				// malformed events are dropped
+				for _, e := range []string{"event1", "malformed", "event2"} {
+					if err := publish(ctx, []byte(e)); err != nil {
+						return 0, err
+					}
+				}
+				return 3, nil
+			default:
+				cancel()
+				return 0, nil
+			}
+		},
+	}
+
+	relay := outbox.New(store, events, outbox.Config{
+		Interval:      time.Millisecond,
+		SweepInterval: time.Hour,
+		MaxBackoff:    10 * time.Millisecond,
+	}, zap.NewNop())
+	assert.NoError(t, relay.Run(ctx))
+
+	assert.Equal(t, 3, store.RelayOutboxCallCount())
+	assert.Equal(t, []string{"event1", "event2"}, published)
+
+	// all streams are relayed at the start, until the first sweep succeeded,
+	// and then after every sweep interval
+	_, all, _ := store.RelayOutboxArgsForCall(0)
+	assert.True(t, all)
+	_, all, _ = store.RelayOutboxArgsForCall(1)
+	assert.True(t, all)
+	_, all, _ = store.RelayOutboxArgsForCall(2)
+	assert.False(t, all)
+	assert.Equal(t, 1, store.OutboxBacklogCallCount())
+}
+
+func TestRelay_RunBacklogError(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	store := &outboxfakes.FakeOutbox{
+		OutboxBacklogStub: func(ctx context.Context) (int64, error) {
+			cancel()
+			return 0, fmt.Errorf("connection refused")
+		},
+	}
+
+	relay := outbox.New(store, &outboxfakes.FakeEvents{}, outbox.Config{Interval: time.Millisecond}, zap.NewNop())
+	assert.NoError(t, relay.Run(ctx))
+	assert.Equal(t, 1, store.RelayOutboxCallCount())
+}